@@ -29,6 +29,18 @@ type ConfigData struct {
 	AwsRegion                 string                       `json:"aws_region" binding:"required"`
 	AwsBucket                 string                       `json:"aws_bucket" binding:"required"`
 	RedisPasswordKey          string                       `json:"redis_password_key" binding:"required"`
+	PublishScheduler          PublishScheduler             `json:"publish_scheduler"`
+	HTMLSanitizer             SanitizerPolicies            `json:"html_sanitizer"`
+	ReadTime                  ReadTimeConfig               `json:"read_time"`
+	DraftTrash                DraftTrash                   `json:"draft_trash"`
+	PostTrash                 PostTrash                    `json:"post_trash"`
+	PinnedPosts               PinnedPosts                  `json:"pinned_posts"`
+	CommentThreads            CommentThreads               `json:"comment_threads"`
+	ImageCollector            ImageCollector               `json:"image_collector"`
+	DraftPreview              DraftPreview                 `json:"draft_preview"`
+	LinkPreview               LinkPreview                  `json:"link_preview"`
+	DuplicateContent          DuplicateContent             `json:"duplicate_content"`
+	ContentFilter             []ContentFilterRule          `json:"content_filter"`
 }
 
 type Email struct {
@@ -45,6 +57,98 @@ type OAuth struct {
 	AcceptConsentRequestUrl string `json:"accept_consent_request_url"`
 	GetTokenUrl             string `json:"get_token_url"`
 }
+type PublishScheduler struct {
+	IntervalInSeconds     int `json:"interval_in_seconds"`
+	BatchSize             int `json:"batch_size"`
+	LeaseInSeconds        int `json:"lease_in_seconds"`
+	MaxAttempts           int `json:"max_attempts"`
+	RetryBackoffInSeconds int `json:"retry_backoff_in_seconds"`
+}
+
+// DraftTrash sets how long deleted drafts can be restored, how often the expired ones are purged and how
+// many failed purges a draft is retried for.
+type DraftTrash struct {
+	RetentionInDays   int `json:"retention_in_days"`
+	IntervalInSeconds int `json:"interval_in_seconds"`
+	BatchSize         int `json:"batch_size"`
+	MaxPurgeAttempts  int `json:"max_purge_attempts"`
+}
+
+// PostTrash sets how long deleted posts can be restored by their author.
+type PostTrash struct {
+	RetentionInDays int `json:"retention_in_days"`
+}
+
+// PinnedPosts sets how many posts an author can pin to the top of their profile.
+type PinnedPosts struct {
+	MaxPins int `json:"max_pins"`
+}
+
+// CommentThreads sets how many levels of replies are nested below a comment on the post. Replies can go
+// deeper, but below the last level they are shown as a single flat list.
+type CommentThreads struct {
+	MaxDisplayDepth int `json:"max_display_depth"`
+}
+
+// ImageCollector sets how often S3 is scanned for images no draft, post or profile refers to and how
+// old such an image has to be before it is deleted. A dry run only reports what would be deleted.
+type ImageCollector struct {
+	IntervalInSeconds  int  `json:"interval_in_seconds"`
+	GracePeriodInHours int  `json:"grace_period_in_hours"`
+	DryRun             bool `json:"dry_run"`
+}
+
+// DraftPreview sets how long shareable draft preview links last and names the key of the preview secret
+// that signs them.
+type DraftPreview struct {
+	SigningSecretKey     string `json:"signing_secret_key"`
+	DefaultExpiryInHours int    `json:"default_expiry_in_hours"`
+	MaxExpiryInHours     int    `json:"max_expiry_in_hours"`
+}
+
+// LinkPreview limits how link previews are fetched and sets how long they are cached. Private networks
+// are only meant to be allowed when developing or testing against a local server.
+type LinkPreview struct {
+	TimeoutInSeconds            int  `json:"timeout_in_seconds"`
+	MaxBodyInKB                 int  `json:"max_body_in_kb"`
+	CacheExpiryInMinutes        int  `json:"cache_expiry_in_minutes"`
+	FailureCacheExpiryInMinutes int  `json:"failure_cache_expiry_in_minutes"`
+	MaxLinksPerSave             int  `json:"max_links_per_save"`
+	AllowPrivateNetworks        bool `json:"allow_private_networks"`
+}
+
+// DuplicateContent sets how similar a post may be to an existing post before it is blocked, or only
+// flagged for review when the action is flag. Posts shorter than the minimum words are not compared, as
+// their fingerprints are not reliable.
+type DuplicateContent struct {
+	Similarity float64 `json:"similarity"`
+	Action     string  `json:"action"`
+	MinWords   int     `json:"min_words"`
+}
+
+// ContentFilterRule matches words or phrases in any script, regular expressions and the domains of links
+// in the text of posts, taglines, comments and profiles. Text that matches is rejected, held for review
+// or has the matching parts masked, depending on the action.
+type ContentFilterRule struct {
+	Name     string   `json:"name"`
+	Action   string   `json:"action"`
+	Words    []string `json:"words"`
+	Patterns []string `json:"patterns"`
+	Domains  []string `json:"domains"`
+}
+
+// SanitizerPolicies maps a block type to the tags allowed in it and the attributes each tag may keep.
+// A block type listed here replaces the built-in policy for that type.
+type SanitizerPolicies map[string]map[string][]string
+
+// ReadTimeConfig sets the reading speed per language, keyed by language code with "default" for
+// text of any other language, and the weight of each block type. Missing entries fall back to the
+// built-in values.
+type ReadTimeConfig struct {
+	WordsPerMinute map[string]int     `json:"words_per_minute"`
+	BlockWeights   map[string]float64 `json:"block_weights"`
+}
+
 type TemplatesPaths struct {
 	NewUserActivation string `json:"new_user_activation"`
 	ForgetPassword    string `json:"forget_password"`
@@ -0,0 +1,20 @@
+create table draft_revisions
+(
+    id         uuid                                  not null,
+    draft_id   uuid                                  not null
+        constraint draft_revisions_drafts_id_fk
+            references drafts,
+    revision   bigint                                not null,
+    data       jsonb                                 not null,
+    created_at timestamptz default current_timestamp not null
+);
+
+create unique index draft_revisions_id_uindex
+    on draft_revisions (id);
+
+create unique index draft_revisions_draft_id_revision_uindex
+    on draft_revisions (draft_id, revision);
+
+alter table draft_revisions
+    add constraint draft_revisions_pk
+        primary key (id);
@@ -0,0 +1,2 @@
+alter table drafts
+    add version bigint default 1 not null;
@@ -0,0 +1,12 @@
+alter table drafts
+    add publish_at timestamptz;
+
+alter table drafts
+    add publish_error text;
+
+alter table drafts
+    add publish_locked_until timestamptz;
+
+create index drafts_publish_at_index
+    on drafts (publish_at)
+    where is_published is false and publish_at is not null;
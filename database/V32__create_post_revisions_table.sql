@@ -0,0 +1,25 @@
+create table post_revisions
+(
+    id            uuid                                  not null,
+    post_id       uuid                                  not null
+        constraint post_revisions_posts_id_fk
+            references posts,
+    revision      bigint                                not null,
+    data          jsonb                                 not null,
+    title         varchar(300)                          not null,
+    tagline       varchar(100)                          not null,
+    preview_image text,
+    view_time     bigint                                not null,
+    published_at  timestamptz                           not null,
+    created_at    timestamptz default current_timestamp not null
+);
+
+create unique index post_revisions_id_uindex
+    on post_revisions (id);
+
+create unique index post_revisions_post_id_revision_uindex
+    on post_revisions (post_id, revision);
+
+alter table post_revisions
+    add constraint post_revisions_pk
+        primary key (id);
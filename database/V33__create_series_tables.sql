@@ -0,0 +1,40 @@
+create table series
+(
+    id          uuid                                  not null,
+    author_id   uuid                                  not null
+        constraint series_users_id_fk
+            references users,
+    title       varchar(300)                          not null,
+    description text,
+    created_at  timestamptz default current_timestamp not null,
+    updated_at  timestamptz,
+    deleted_at  timestamptz
+);
+
+create unique index series_id_uindex
+    on series (id);
+
+create index series_author_id_index
+    on series (author_id);
+
+alter table series
+    add constraint series_pk
+        primary key (id);
+
+create table series_posts
+(
+    series_id  uuid                                  not null
+        constraint series_posts_series_id_fk
+            references series,
+    post_id    uuid                                  not null
+        constraint series_posts_posts_id_fk
+            references posts,
+    position   int                                   not null,
+    created_at timestamptz default current_timestamp not null
+);
+
+create unique index series_posts_post_id_uindex
+    on series_posts (post_id);
+
+create index series_posts_series_id_position_index
+    on series_posts (series_id, position);
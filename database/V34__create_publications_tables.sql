@@ -0,0 +1,89 @@
+create table publications
+(
+    id          uuid                                  not null,
+    name        varchar(100)                          not null,
+    description text,
+    owner_id    uuid                                  not null
+        constraint publications_users_id_fk
+            references users,
+    created_at  timestamptz default current_timestamp not null,
+    updated_at  timestamptz,
+    deleted_at  timestamptz
+);
+
+create unique index publications_id_uindex
+    on publications (id);
+
+alter table publications
+    add constraint publications_pk
+        primary key (id);
+
+create table publication_members
+(
+    publication_id uuid                                  not null
+        constraint publication_members_publications_id_fk
+            references publications,
+    user_id        uuid                                  not null
+        constraint publication_members_users_id_fk
+            references users,
+    role           varchar(20)                           not null
+        constraint publication_members_role_check
+            check (role in ('owner', 'editor', 'writer')),
+    status         varchar(20)                           not null
+        constraint publication_members_status_check
+            check (status in ('invited', 'active')),
+    invited_by     uuid
+        constraint publication_members_invited_by_fk
+            references users,
+    created_at     timestamptz default current_timestamp not null,
+    updated_at     timestamptz
+);
+
+create unique index publication_members_publication_id_user_id_uindex
+    on publication_members (publication_id, user_id);
+
+create table publication_submissions
+(
+    id             uuid                                  not null,
+    publication_id uuid                                  not null
+        constraint publication_submissions_publications_id_fk
+            references publications,
+    draft_id       uuid                                  not null
+        constraint publication_submissions_drafts_id_fk
+            references drafts,
+    author_id      uuid                                  not null
+        constraint publication_submissions_users_id_fk
+            references users,
+    status         varchar(20)                           not null
+        constraint publication_submissions_status_check
+            check (status in ('pending', 'changes_requested', 'rejected', 'approved')),
+    feedback       text,
+    reviewed_by    uuid
+        constraint publication_submissions_reviewed_by_fk
+            references users,
+    created_at     timestamptz default current_timestamp not null,
+    updated_at     timestamptz
+);
+
+create unique index publication_submissions_id_uindex
+    on publication_submissions (id);
+
+create unique index publication_submissions_pending_draft_id_uindex
+    on publication_submissions (draft_id)
+    where status = 'pending';
+
+create index publication_submissions_publication_id_status_index
+    on publication_submissions (publication_id, status);
+
+alter table publication_submissions
+    add constraint publication_submissions_pk
+        primary key (id);
+
+alter table posts
+    add publication_id uuid
+        constraint posts_publications_id_fk
+            references publications;
+
+create index posts_publication_id_index
+    on posts (publication_id)
+    where publication_id is not null;
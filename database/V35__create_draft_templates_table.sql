@@ -0,0 +1,29 @@
+create table draft_templates
+(
+    id          uuid                                  not null,
+    user_id     uuid                                  not null
+        constraint draft_templates_users_id_fk
+            references users,
+    name        varchar(100)                          not null,
+    description text,
+    data        jsonb                                 not null,
+    tagline     varchar(100),
+    interests   varchar(50) ARRAY[5],
+    is_public   boolean     default false             not null,
+    created_at  timestamptz default current_timestamp not null,
+    updated_at  timestamptz
+);
+
+create unique index draft_templates_id_uindex
+    on draft_templates (id);
+
+create index draft_templates_user_id_index
+    on draft_templates (user_id);
+
+create index draft_templates_public_index
+    on draft_templates (created_at)
+    where is_public is true;
+
+alter table draft_templates
+    add constraint draft_templates_pk
+        primary key (id);
@@ -0,0 +1,3 @@
+create index drafts_deleted_at_index
+    on drafts (deleted_at)
+    where deleted_at is not null;
@@ -0,0 +1,25 @@
+create table draft_preview_links
+(
+    id             uuid                                  not null,
+    draft_id       uuid                                  not null
+        constraint draft_preview_links_drafts_id_fk
+            references drafts,
+    user_id        uuid                                  not null
+        constraint draft_preview_links_users_id_fk
+            references users,
+    expires_at     timestamptz                           not null,
+    revoked_at     timestamptz,
+    open_count     bigint      default 0                 not null,
+    last_opened_at timestamptz,
+    created_at     timestamptz default current_timestamp not null
+);
+
+create unique index draft_preview_links_id_uindex
+    on draft_preview_links (id);
+
+create index draft_preview_links_draft_id_index
+    on draft_preview_links (draft_id);
+
+alter table draft_preview_links
+    add constraint draft_preview_links_pk
+        primary key (id);
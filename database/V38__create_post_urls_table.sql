@@ -0,0 +1,23 @@
+alter table abstract_post
+    add custom_slug varchar(100);
+
+create table post_urls
+(
+    url        text                                  not null,
+    post_id    uuid                                  not null
+        constraint post_urls_posts_id_fk
+            references posts,
+    created_at timestamptz default current_timestamp not null
+);
+
+alter table post_urls
+    add constraint post_urls_pk
+        primary key (url);
+
+create index post_urls_post_id_index
+    on post_urls (post_id);
+
+insert into post_urls (url, post_id)
+select url, post_id
+from abstract_post
+where url is not null;
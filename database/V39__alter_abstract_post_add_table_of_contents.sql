@@ -0,0 +1,2 @@
+alter table abstract_post
+    add table_of_contents jsonb;
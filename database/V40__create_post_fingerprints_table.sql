@@ -0,0 +1,21 @@
+create table post_fingerprints
+(
+    post_id      uuid                                  not null
+        constraint post_fingerprints_posts_id_fk
+            references posts,
+    simhash      bigint                                not null,
+    duplicate_of uuid
+        constraint post_fingerprints_duplicate_of_fk
+            references posts,
+    similarity   real,
+    created_at   timestamptz default current_timestamp not null,
+    updated_at   timestamptz
+);
+
+alter table post_fingerprints
+    add constraint post_fingerprints_pk
+        primary key (post_id);
+
+create index post_fingerprints_duplicate_of_index
+    on post_fingerprints (duplicate_of)
+    where duplicate_of is not null;
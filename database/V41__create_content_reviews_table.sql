@@ -0,0 +1,38 @@
+alter table comments
+    add held_for_review boolean default false not null;
+
+create table content_reviews
+(
+    id              uuid        default uuid_generate_v4() not null,
+    content_type    varchar(16)                            not null,
+    content_id      uuid                                   not null,
+    content_version bigint,
+    user_id         uuid                                   not null
+        constraint content_reviews_users_id_fk
+            references users,
+    rules           text[]                                 not null,
+    content         text,
+    status          varchar(20) default 'pending'          not null
+        constraint content_reviews_status_check
+            check (status in ('pending', 'approved', 'rejected')),
+    reviewed_by     uuid
+        constraint content_reviews_reviewed_by_fk
+            references users,
+    reviewed_at     timestamptz,
+    created_at      timestamptz default current_timestamp  not null
+);
+
+alter table content_reviews
+    add constraint content_reviews_pk
+        primary key (id);
+
+create index content_reviews_pending_created_at_index
+    on content_reviews (created_at)
+    where status = 'pending';
+
+create unique index content_reviews_content_type_content_id_content_version_uindex
+    on content_reviews (content_type, content_id, content_version)
+    where status = 'pending';
+
+create index content_reviews_content_id_index
+    on content_reviews (content_id);
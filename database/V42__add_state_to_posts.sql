@@ -0,0 +1,15 @@
+alter table posts
+    add state varchar(16) default 'published' not null,
+    add state_before_delete varchar(16);
+
+update posts
+set state               = 'deleted',
+    state_before_delete = 'published'
+where deleted_at is not null;
+
+alter table posts
+    add constraint posts_state_check
+        check (state in ('published', 'unlisted', 'archived', 'deleted'));
+
+create index posts_author_id_state_index
+    on posts (author_id, state);
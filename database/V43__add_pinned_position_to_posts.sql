@@ -0,0 +1,6 @@
+alter table posts
+    add pinned_position smallint;
+
+create unique index posts_author_id_pinned_position_uindex
+    on posts (author_id, pinned_position)
+    where pinned_position is not null;
@@ -0,0 +1,13 @@
+alter table comments
+    add parent_id uuid
+        constraint comments_comments_id_fk
+            references comments;
+
+alter table comments
+    add ancestors uuid[] default '{}' not null;
+
+create index comments_post_id_parent_id_index
+    on comments (post_id, parent_id);
+
+create index comments_ancestors_index
+    on comments using gin (ancestors);
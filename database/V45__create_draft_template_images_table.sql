@@ -0,0 +1,20 @@
+create table draft_template_images
+(
+    id          uuid        default uuid_generate_v4() not null
+        constraint draft_template_images_pk
+            primary key,
+    template_id uuid                                   not null
+        constraint draft_template_images_draft_templates_id_fk
+            references draft_templates
+            on delete cascade,
+    draft_id    uuid                                   not null,
+    image_id    uuid                                   not null,
+    upload_id   text                                   not null,
+    created_at  timestamptz default current_timestamp  not null
+);
+
+create unique index draft_template_images_template_id_draft_id_image_id_uindex
+    on draft_template_images (template_id, draft_id, image_id);
+
+create index draft_template_images_upload_id_index
+    on draft_template_images (upload_id);
@@ -0,0 +1,5 @@
+alter table drafts
+    add purge_attempts int default 0 not null;
+
+alter table drafts
+    add purge_error text;
@@ -0,0 +1,10 @@
+alter table publication_submissions
+    add draft_version bigint;
+
+update publication_submissions
+set draft_version = drafts.version
+from drafts
+where drafts.id = publication_submissions.draft_id;
+
+alter table publication_submissions
+    alter column draft_version set not null;
@@ -0,0 +1,2 @@
+alter table drafts
+    add publish_attempts int default 0 not null;
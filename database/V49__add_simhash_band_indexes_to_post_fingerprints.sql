@@ -0,0 +1,11 @@
+create index post_fingerprints_simhash_band_1_index
+    on post_fingerprints (((simhash >> 48) & 65535));
+
+create index post_fingerprints_simhash_band_2_index
+    on post_fingerprints (((simhash >> 32) & 65535));
+
+create index post_fingerprints_simhash_band_3_index
+    on post_fingerprints (((simhash >> 16) & 65535));
+
+create index post_fingerprints_simhash_band_4_index
+    on post_fingerprints ((simhash & 65535));
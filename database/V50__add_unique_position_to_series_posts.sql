@@ -0,0 +1,14 @@
+update series_posts
+set position = ordered.position
+from (select series_id,
+             post_id,
+             row_number() over (partition by series_id order by position, created_at) as position
+      from series_posts) ordered
+where series_posts.series_id = ordered.series_id
+  and series_posts.post_id = ordered.post_id;
+
+drop index series_posts_series_id_position_index;
+
+alter table series_posts
+    add constraint series_posts_series_id_position_key
+        unique (series_id, position) deferrable initially deferred;
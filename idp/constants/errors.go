@@ -28,6 +28,8 @@ const (
 	UnableToFetchObjectErrorCode   string = "ERR_USER_PROFILE_UNABLE_TO_FETCH_OBJECT"
 	UnableToUpdateAvatarErrorCode  string = "ERR_IDP_UNABLE_TO_UPDATE_AVATAR"
 	UnableToResetPasswordErrorCode string = "ERR_IDP_UNABLE_TO_RESET_PASSWORD"
+	AboutRejectedCode              string = "ERR_IDP_USER_ABOUT_REJECTED"
+	AboutHeldForReviewCode         string = "ERR_IDP_USER_ABOUT_HELD_FOR_REVIEW"
 )
 
 var (
@@ -52,6 +54,7 @@ var (
 	UnableToUpdateAvatarError        = golaerror.Error{ErrorCode: UnableToUpdateAvatarErrorCode, ErrorMessage: "unable to upload avatar"}
 	UnauthorisedRequestError         = golaerror.Error{ErrorCode: UnauthorisedRequestCode, ErrorMessage: "unauthorized request"}
 	UnableToResetPasswordError       = golaerror.Error{ErrorCode: UnableToResetPasswordErrorCode, ErrorMessage: "unable to reset user password"}
+	AboutHeldForReviewError          = golaerror.Error{ErrorCode: AboutHeldForReviewCode, ErrorMessage: "about is held for review by a moderator"}
 )
 
 var ErrorCodeHttpStatusCodeMap = map[string]int{
@@ -71,6 +74,16 @@ var ErrorCodeHttpStatusCodeMap = map[string]int{
 	ObjectNotFoundErrorCode:        http.StatusNotFound,
 	UnauthorisedRequestCode:        http.StatusUnauthorized,
 	UnableToResetPasswordErrorCode: http.StatusInternalServerError,
+	AboutRejectedCode:              http.StatusUnprocessableEntity,
+	AboutHeldForReviewCode:         http.StatusAccepted,
+}
+
+func AboutRejectedError(rules []string) *golaerror.Error {
+	return &golaerror.Error{
+		ErrorCode:      AboutRejectedCode,
+		ErrorMessage:   "about is not allowed by the content rules",
+		AdditionalData: map[string][]string{"rules": rules},
+	}
 }
 
 func GetGolaHttpCode(golaErrCode string) int {
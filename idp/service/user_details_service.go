@@ -8,6 +8,9 @@ import (
 	"post-api/idp/constants"
 	"post-api/idp/models/request"
 	"post-api/idp/repository"
+	"post-api/story/models/db"
+	storyRepository "post-api/story/repository"
+	"post-api/story/utils"
 )
 
 type UserDetailsService interface {
@@ -18,6 +21,8 @@ type UserDetailsService interface {
 type userDetailsService struct {
 	repository              repository.UserDetailsRepository
 	userRegistrationService UserRegistrationService
+	contentFilter           utils.ContentFilter
+	reviewRepository        storyRepository.ContentReviewRepository
 }
 
 func (service userDetailsService) UpdateUserDetails(ctx context.Context, userID uuid.UUID, update request.UserDetailsUpdate) *golaerror.Error {
@@ -48,11 +53,26 @@ func (service userDetailsService) UpdateUserDetails(ctx context.Context, userID
 			return &constants.NameUpdateError
 		}
 	}
+	heldForReview := false
 	if update.About != "" {
-		err := service.repository.UpdateAbout(ctx, update.About, userID)
-		if err != nil {
-			logger.Error("unable to update username for user %v", userID)
-			return &constants.AboutUpdateError
+		about, result := service.contentFilter.FilterText(ctx, update.About)
+		switch result.Action {
+		case utils.FilterReject:
+			logger.Errorf("about of user %v rejected by content filter rules %v", userID, result.Rules)
+			return constants.AboutRejectedError(result.Rules)
+		case utils.FilterHold:
+			review := db.ContentReview{ContentType: db.ReviewAbout, ContentID: userID, UserID: userID, Rules: result.Rules, Content: &update.About}
+			if err := service.reviewRepository.Save(ctx, review); err != nil {
+				logger.Errorf("unable to hold about of user %v for review. Error %v", userID, err)
+				return &constants.AboutUpdateError
+			}
+			heldForReview = true
+		default:
+			err := service.repository.UpdateAbout(ctx, about, userID)
+			if err != nil {
+				logger.Error("unable to update username for user %v", userID)
+				return &constants.AboutUpdateError
+			}
 		}
 	}
 	if update.FacebookURL != "" {
@@ -77,6 +97,11 @@ func (service userDetailsService) UpdateUserDetails(ctx context.Context, userID
 		}
 	}
 
+	// the other details are updated while the about text waits for a moderator
+	if heldForReview {
+		return &constants.AboutHeldForReviewError
+	}
+
 	return nil
 }
 
@@ -93,6 +118,6 @@ func (service userDetailsService) UpdateProfileImage(ctx context.Context, avatar
 	return nil
 }
 
-func NewUserDetailsService(repository repository.UserDetailsRepository, service UserRegistrationService) UserDetailsService {
-	return userDetailsService{repository: repository, userRegistrationService: service}
+func NewUserDetailsService(repository repository.UserDetailsRepository, service UserRegistrationService, contentFilter utils.ContentFilter, reviewRepository storyRepository.ContentReviewRepository) UserDetailsService {
+	return userDetailsService{repository: repository, userRegistrationService: service, contentFilter: contentFilter, reviewRepository: reviewRepository}
 }
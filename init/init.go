@@ -14,6 +14,7 @@ func CreateRouter(data *configuration.ConfigData) *gin.Engine {
 	HttpClient(data)
 	db := Db(data, aws)
 	Objects(db, data, aws)
+	Schedulers()
 	RegisterRouter(router, data)
 	return router
 }
@@ -33,8 +33,20 @@ import (
 
 var (
 	draftController          storyController.DraftController
+	publishScheduler         service.PublishScheduler
 	interestsController      storyController.InterestsController
 	postController           storyController.PostController
+	postStateController      storyController.PostStateController
+	profilePinController     storyController.ProfilePinController
+	seriesController         storyController.SeriesController
+	draftTemplateController  storyController.DraftTemplateController
+	draftTrashController     storyController.DraftTrashController
+	draftPreviewController   storyController.DraftPreviewController
+	linkPreviewController    storyController.LinkPreviewController
+	trashPurger              service.TrashPurger
+	imageCollector           service.ImageCollector
+	publicationController    storyController.PublicationController
+	contentReviewController  storyController.ContentReviewController
 	registrationController   idpController.RegistrationController
 	loginController          idpController.LoginController
 	tokenController          idpController.TokenController
@@ -58,17 +70,56 @@ func Objects(db *sqlx.DB, configData *configuration.ConfigData, aws *session.Ses
 	awsServices := commonService.NewAwsServices(aws, configData)
 
 	postValidator := utils.NewPostValidator(configData)
+	contentSanitizer := utils.NewContentSanitizer(configData)
+	contentFilter, err := utils.NewContentFilter(configData.ContentFilter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	contentReviewRepository := repository.NewContentReviewRepository(db)
 	interestsRepository := repository.NewInterestRepository(db)
 	interestsService := service.NewInterestsService(interestsRepository)
 	interestsController = storyController.NewInterestsController(interestsService)
 	manager := helper.NewTransactionManager(db)
 	draftRepository := repository.NewDraftRepository(db)
-	draftService := service.NewDraftService(draftRepository, interestsRepository, postValidator, awsServices)
-	draftController = storyController.NewDraftController(draftService, awsServices)
+	draftRevisionRepository := repository.NewDraftRevisionRepository(db)
 	postRepository := repository.NewPostsRepository(db)
+	linkPreviewService := service.NewLinkPreviewService(redisClient, configData.LinkPreview)
+	draftService := service.NewDraftService(draftRepository, interestsRepository, postValidator, contentSanitizer, awsServices, draftRevisionRepository, postRepository, linkPreviewService, manager)
+	draftController = storyController.NewDraftController(draftService, awsServices)
+	linkPreviewController = storyController.NewLinkPreviewController(linkPreviewService)
+	draftTrashService := service.NewDraftTrashService(draftRepository, configData.DraftTrash)
+	draftTrashController = storyController.NewDraftTrashController(draftTrashService)
+	trashPurger = service.NewTrashPurger(draftRepository, awsServices, manager, configData.DraftTrash)
+	previewSecret, err := getDraftPreviewSecret(aws, configData)
+	if err != nil {
+		log.Fatal(err)
+	}
+	draftPreviewLinkRepository := repository.NewDraftPreviewLinkRepository(db)
+	draftPreviewService := service.NewDraftPreviewService(draftPreviewLinkRepository, draftRepository, awsServices, contentSanitizer, configData.DraftPreview, previewSecret)
+	draftPreviewController = storyController.NewDraftPreviewController(draftPreviewService)
+	imageRepository := repository.NewImageRepository(db)
+	imageCollector = service.NewImageCollector(imageRepository, awsServices, configData.ImageCollector)
 	previewPostRepository := repository.NewAbstractPostRepository(db)
-	postService := service.NewPostService(postRepository, draftRepository, postValidator, previewPostRepository, interestsRepository, manager, awsServices)
+	postRevisionRepository := repository.NewPostRevisionRepository(db)
+	seriesRepository := repository.NewSeriesRepository(db)
+	seriesService := service.NewSeriesService(seriesRepository, manager)
+	seriesController = storyController.NewSeriesController(seriesService)
+	draftTemplateRepository := repository.NewDraftTemplateRepository(db)
+	draftTemplateService := service.NewDraftTemplateService(draftTemplateRepository, draftRepository, manager)
+	draftTemplateController = storyController.NewDraftTemplateController(draftTemplateService)
+	postFingerprintRepository := repository.NewPostFingerprintRepository(db)
+	postService := service.NewPostService(postRepository, draftRepository, postValidator, contentSanitizer, previewPostRepository, postRevisionRepository, seriesService, interestsRepository, manager, awsServices, postFingerprintRepository, configData.DuplicateContent, contentFilter, contentReviewRepository, configData.CommentThreads)
 	postController = storyController.NewPostController(postService)
+	postStateService := service.NewPostStateService(postRepository, configData.PostTrash)
+	postStateController = storyController.NewPostStateController(postStateService)
+	profilePinService := service.NewProfilePinService(postRepository, manager, configData.PinnedPosts)
+	profilePinController = storyController.NewProfilePinController(profilePinService)
+	publicationRepository := repository.NewPublicationRepository(db)
+	publicationService := service.NewPublicationService(publicationRepository, postService, awsServices)
+	publicationController = storyController.NewPublicationController(publicationService)
+	contentReviewService := service.NewContentReviewService(contentReviewRepository, manager)
+	contentReviewController = storyController.NewContentReviewController(contentReviewService)
+	publishScheduler = service.NewPublishScheduler(draftRepository, postService, configData.PublishScheduler)
 
 	detailsRepository := idpRepository.NewUserDetailsRepository(db)
 	util := crypto.NewCryptoUtil(configData.CryptoServiceURL)
@@ -92,9 +143,9 @@ func Objects(db *sqlx.DB, configData *configuration.ConfigData, aws *session.Ses
 
 	userInterestsRepository := userProfileRepository.NewUserInterestsRepository(db)
 	userInterestsService := userProfileService.NewUserInterestsService(userInterestsRepository, awsServices)
-	profileController = userProfileController.NewUserProfileController(userInterestsService, postService, profileService, awsServices)
+	profileController = userProfileController.NewUserProfileController(userInterestsService, postService, seriesService, profileService, awsServices)
 
-	userDetailsService := idpService.NewUserDetailsService(detailsRepository, userRegistrationService)
+	userDetailsService := idpService.NewUserDetailsService(detailsRepository, userRegistrationService, contentFilter, contentReviewRepository)
 	userDetailsController = idpController.NewUserDetailsController(userDetailsService, awsServices)
 
 	reportRepository := repository.NewReportRepository(db)
@@ -103,21 +154,29 @@ func Objects(db *sqlx.DB, configData *configuration.ConfigData, aws *session.Ses
 }
 
 func getRedisPassword(awsSession *session.Session, config *configuration.ConfigData) (string, error) {
+	return getSecret(awsSession, strings.ToLower(config.Environment+"/redis/password"), config.RedisPasswordKey)
+}
+
+func getDraftPreviewSecret(awsSession *session.Session, config *configuration.ConfigData) (string, error) {
+	return getSecret(awsSession, strings.ToLower(config.Environment+"/draft-preview/secret"), config.DraftPreview.SigningSecretKey)
+}
+
+func getSecret(awsSession *session.Session, secretID, key string) (string, error) {
 	manager := secretsmanager.New(awsSession, nil)
 	value, err := manager.GetSecretValue(&secretsmanager.GetSecretValueInput{
-		SecretId: aws.String(strings.ToLower(config.Environment + "/redis/password")),
+		SecretId: aws.String(secretID),
 	})
 	if err != nil {
-		log.Println("unable to get db password from aws secrets ", err)
+		log.Println("unable to get secret from aws secrets ", secretID, err)
 		return "", err
 	}
 	secretString := []byte(*value.SecretString)
 	var data map[string]string
 	err = json.Unmarshal(secretString, &data)
 	if err != nil {
-		log.Println("unable to unmarshal db password from aws secrets ", err)
+		log.Println("unable to unmarshal secret from aws secrets ", secretID, err)
 		return "", err
 	}
 
-	return data[config.RedisPasswordKey], nil
+	return data[key], nil
 }
@@ -9,5 +9,6 @@ import (
 func Validators() {
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		_ = v.RegisterValidation("validPostUID", validators.ValidPostUID)
+		_ = v.RegisterValidation("validSlug", validators.ValidSlug)
 	}
 }
@@ -111,23 +111,42 @@ func RegisterRouter(router *gin.Engine, configData *configuration.ConfigData) {
 
 	defaultRouterGroup := router.Group("api/post/v1")
 	defaultRouterGroup.GET("/interests", interestsController.GetInterests)
+	defaultRouterGroup.GET("/draft/shared/:token", draftPreviewController.OpenPreviewLink)
 	defaultRouterGroup.Use(tokenIntrospectionMiddleware(configData.OauthUrl, oauthUtil, configData))
 	{
 		draftGroup := defaultRouterGroup.Group("/draft")
 		{
 			draftGroup.POST("", draftController.CreateDraft)
 			draftGroup.PUT("", draftController.SaveDraft)
+			draftGroup.PATCH("", draftController.PatchDraft)
 			draftGroup.GET("", draftController.GetDraft)
 			draftGroup.DELETE("", draftController.DeleteDraft)
 			draftGroup.PUT("/tagline", draftController.SaveTagline)
 			draftGroup.PUT("/interests", draftController.SaveInterests)
 			draftGroup.POST("/get-all-draft", draftController.GetAllDraft)
+			draftGroup.POST("/markdown", draftController.ImportMarkdown)
+			draftGroup.GET("/markdown/:draft_id", draftController.ExportMarkdown)
+			draftGroup.POST("fork/:draft_id", draftController.ForkDraft)
+			draftGroup.POST("fork/post/:post_id", draftController.ForkPost)
+			draftGroup.GET("trash", draftTrashController.GetTrashedDrafts)
+			draftGroup.PUT("trash/:draft_id/restore", draftTrashController.RestoreDraft)
+			draftGroup.POST("preview-links/:draft_id", draftPreviewController.CreatePreviewLink)
+			draftGroup.GET("preview-links/:draft_id", draftPreviewController.GetPreviewLinks)
+			draftGroup.DELETE("preview-links/:draft_id/:link_id", draftPreviewController.RevokePreviewLink)
+			draftGroup.GET("link-preview", linkPreviewController.Unfurl)
 			draftGroup.GET("/preview-draft/:draft_id", draftController.GetPreviewDraft)
 			draftGroup.GET("pre-sign/:draft_id", draftController.GetPreSignURLForDraftPreview)
 			draftGroup.GET("image/:draft_id", draftController.GetPreSignURLForDraftImage)
 			draftGroup.GET("image/:draft_id/:image_id", draftController.ViewDraftImage)
 			draftGroup.POST("image/:draft_id/upload", draftController.UploadDraftImageKey)
 			draftGroup.POST("preview/:draft_id/upload", draftController.UploadImageKey)
+			draftGroup.GET("revisions/:draft_id", draftController.GetRevisions)
+			draftGroup.GET("revisions/:draft_id/diff", draftController.DiffRevisions)
+			draftGroup.GET("revisions/:draft_id/:revision_id", draftController.GetRevision)
+			draftGroup.POST("revisions/:draft_id/:revision_id/restore", draftController.RestoreRevision)
+			draftGroup.GET("scheduled", draftController.GetScheduledDrafts)
+			draftGroup.PUT("schedule/:draft_id", draftController.SchedulePublish)
+			draftGroup.DELETE("schedule/:draft_id", draftController.CancelScheduledPublish)
 		}
 
 		postGroup := defaultRouterGroup.Group("/post")
@@ -137,16 +156,70 @@ func RegisterRouter(router *gin.Engine, configData *configuration.ConfigData) {
 			postGroup.GET("/unlike", postController.UnLike)
 			postGroup.GET("/saved", postController.GetReadLaterPosts)
 			postGroup.GET("/viewed", postController.GetReadPosts)
+			postGroup.GET("/resolve", postController.ResolvePost)
+			postGroup.GET("/mine", postStateController.GetPosts)
+			postGroup.PUT("/pins", profilePinController.SetPins)
 			postGroup.POST("/:post_id/comment", postController.Comment)
 			postGroup.GET("/:post_id", postController.GetPost)
 			postGroup.DELETE("/:post_id", postController.Delete)
+			postGroup.PUT("/:post_id/state", postStateController.SetState)
+			postGroup.PUT("/:post_id/restore", postStateController.RestorePost)
+			postGroup.PUT("/:post_id/pin", profilePinController.PinPost)
+			postGroup.DELETE("/:post_id/pin", profilePinController.UnpinPost)
+			postGroup.GET("/:post_id/edit", postController.EditPost)
+			postGroup.PUT("/:post_id/republish", postController.RepublishPost)
+			postGroup.PUT("/:post_id/slug", postController.SetSlug)
+			postGroup.GET("/:post_id/revisions", postController.GetPostRevisions)
+			postGroup.GET("/:post_id/markdown", postController.ExportMarkdown)
 			postGroup.GET("/:post_id/comments", postController.GetComments)
+			postGroup.GET("/:post_id/comments/:comment_id/replies", postController.GetReplies)
+			postGroup.DELETE("/:post_id/comments/:comment_id", postController.DeleteComment)
 			postGroup.GET("/:post_id/save", postController.SavePost)
 			postGroup.GET("/:post_id/remove", postController.RemoveBookmark)
 			postGroup.GET("/:post_id/viewed", postController.MarkAsViewed)
 			postGroup.POST("/:post_id/report", reportController.ReportPost)
 		}
 
+		seriesGroup := defaultRouterGroup.Group("/series")
+		{
+			seriesGroup.POST("", seriesController.CreateSeries)
+			seriesGroup.GET("/:series_id", seriesController.GetSeries)
+			seriesGroup.POST("/:series_id/posts", seriesController.AddPost)
+			seriesGroup.DELETE("/:series_id/posts/:post_id", seriesController.RemovePost)
+			seriesGroup.PUT("/:series_id/order", seriesController.ReorderPosts)
+		}
+
+		templateGroup := defaultRouterGroup.Group("/template")
+		{
+			templateGroup.POST("", draftTemplateController.SaveTemplate)
+			templateGroup.GET("", draftTemplateController.GetTemplates)
+			templateGroup.GET("/public", draftTemplateController.GetPublicTemplates)
+			templateGroup.GET("/:template_id", draftTemplateController.GetTemplate)
+			templateGroup.PUT("/:template_id/visibility", draftTemplateController.ShareTemplate)
+			templateGroup.DELETE("/:template_id", draftTemplateController.DeleteTemplate)
+			templateGroup.POST("/:template_id/draft", draftTemplateController.CreateDraftFromTemplate)
+		}
+
+		publicationGroup := defaultRouterGroup.Group("/publication")
+		{
+			publicationGroup.POST("", publicationController.CreatePublication)
+			publicationGroup.GET("/submissions", publicationController.GetMySubmissions)
+			publicationGroup.GET("/:publication_id", publicationController.GetPublication)
+			publicationGroup.GET("/:publication_id/posts", publicationController.GetPublicationPosts)
+			publicationGroup.POST("/:publication_id/members", publicationController.InviteMember)
+			publicationGroup.PUT("/:publication_id/members/accept", publicationController.AcceptInvite)
+			publicationGroup.POST("/:publication_id/submissions", publicationController.SubmitDraft)
+			publicationGroup.GET("/:publication_id/submissions", publicationController.GetSubmissions)
+			publicationGroup.GET("/:publication_id/submissions/:submission_id/draft", publicationController.GetSubmissionDraft)
+			publicationGroup.PUT("/:publication_id/submissions/:submission_id", publicationController.ReviewSubmission)
+		}
+
+		reviewGroup := defaultRouterGroup.Group("/reviews")
+		{
+			reviewGroup.GET("", contentReviewController.GetReviews)
+			reviewGroup.PUT("/:review_id", contentReviewController.ReviewContent)
+		}
+
 		feedGroup := defaultRouterGroup.Group("/posts")
 		{
 			feedGroup.GET("", postController.GetHomeFeed)
@@ -178,6 +251,7 @@ func RegisterRouter(router *gin.Engine, configData *configuration.ConfigData) {
 			userBehaviourGroup.GET(":user_id/follow", profileController.FollowUser)
 			userBehaviourGroup.GET(":user_id/unfollow", profileController.UnFollowUser)
 			userBehaviourGroup.GET(":user_id/block", profileController.BlockUser)
+			userBehaviourGroup.GET(":user_id/series", profileController.GetSeries)
 		}
 		posts := userGroup.Group("posts")
 		{
@@ -0,0 +1,9 @@
+package init
+
+import "context"
+
+func Schedulers() {
+	go publishScheduler.Start(context.Background())
+	go trashPurger.Start(context.Background())
+	go imageCollector.Start(context.Background())
+}
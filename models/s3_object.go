@@ -0,0 +1,8 @@
+package models
+
+import "time"
+
+type S3Object struct {
+	Key          string
+	LastModified time.Time
+}
@@ -1,11 +1,14 @@
 package service
 
+//go:generate mockgen -source=aws_services.go -destination=./../story/mocks/mock_aws_services.go -package=mocks
+
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"post-api/configuration"
+	"post-api/models"
 	"time"
 )
 
@@ -18,6 +21,8 @@ type AwsServices interface {
 	GetObjectInS3(key string, expiryTime time.Duration) (string, error)
 	PutObjectInS3(key string) (string, error)
 	CheckS3Object(key string) (bool, error)
+	DeleteObjectInS3(key string) error
+	ListObjectsInS3(prefix string) ([]models.S3Object, error)
 }
 
 func (service awsServices) GetObjectInS3(key string, expiryTime time.Duration) (string, error) {
@@ -72,6 +77,36 @@ func (service awsServices) CheckS3Object(key string) (bool, error) {
 	return true, nil
 }
 
+func (service awsServices) DeleteObjectInS3(key string) error {
+	svc := s3.New(service.session)
+	_, err := svc.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(service.config.AwsBucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+func (service awsServices) ListObjectsInS3(prefix string) ([]models.S3Object, error) {
+	svc := s3.New(service.session)
+	var objects []models.S3Object
+	err := svc.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(service.config.AwsBucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, models.S3Object{
+				Key:          aws.StringValue(object.Key),
+				LastModified: aws.TimeValue(object.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
 func NewAwsServices(session *session.Session, data *configuration.ConfigData) AwsServices {
 	return awsServices{
 		session: session,
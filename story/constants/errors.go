@@ -24,6 +24,38 @@ const (
 	UnableToUpdatePreviewImageCode  string = "ERR_POST_UNABLE_TO_UPDATE_PREVIEW"
 	InvalidImageKeyCode             string = "ERR_POST_INVALID_IMAGE_KEY"
 	UnauthorisedDraftCode           string = "ERR_POST_UNAUTHORISED_DRAFT"
+	NoDraftRevisionFoundCode        string = "ERR_NO_DRAFT_REVISION_FOUND"
+	DraftVersionConflictCode        string = "ERR_POST_DRAFT_VERSION_CONFLICT"
+	DraftVersionRequiredCode        string = "ERR_POST_DRAFT_VERSION_REQUIRED"
+	InvalidBlockOperationCode       string = "ERR_POST_INVALID_BLOCK_OPERATION"
+	InvalidPublishTimeCode          string = "ERR_POST_INVALID_PUBLISH_TIME"
+	NoScheduledDraftFoundCode       string = "ERR_NO_SCHEDULED_DRAFT_FOUND"
+	NoSeriesFoundCode               string = "ERR_NO_SERIES_FOUND"
+	PostAlreadyInSeriesCode         string = "ERR_POST_ALREADY_IN_SERIES"
+	InvalidSeriesOrderCode          string = "ERR_POST_INVALID_SERIES_ORDER"
+	NoPublicationFoundCode          string = "ERR_NO_PUBLICATION_FOUND"
+	PublicationAccessDeniedCode     string = "ERR_POST_PUBLICATION_ACCESS_DENIED"
+	AlreadyPublicationMemberCode    string = "ERR_POST_ALREADY_PUBLICATION_MEMBER"
+	NoPublicationInviteFoundCode    string = "ERR_NO_PUBLICATION_INVITE_FOUND"
+	DraftAlreadySubmittedCode       string = "ERR_POST_DRAFT_ALREADY_SUBMITTED"
+	NoSubmissionFoundCode           string = "ERR_NO_SUBMISSION_FOUND"
+	SubmissionAlreadyReviewedCode   string = "ERR_POST_SUBMISSION_ALREADY_REVIEWED"
+	PublicationReviewRequiredCode   string = "ERR_POST_PUBLICATION_REVIEW_REQUIRED"
+	DraftAlreadyPublishedCode       string = "ERR_POST_DRAFT_ALREADY_PUBLISHED"
+	DraftChangedAfterSubmitCode     string = "ERR_POST_DRAFT_CHANGED_SINCE_SUBMISSION"
+	InvalidBlocksCode               string = "ERR_POST_INVALID_BLOCKS"
+	NoTemplateFoundCode             string = "ERR_NO_TEMPLATE_FOUND"
+	NoPreviewLinkFoundCode          string = "ERR_NO_PREVIEW_LINK_FOUND"
+	SlugAlreadyTakenCode            string = "ERR_POST_SLUG_ALREADY_TAKEN"
+	InvalidLinkCode                 string = "ERR_POST_INVALID_LINK"
+	LinkPreviewUnavailableCode      string = "ERR_POST_LINK_PREVIEW_UNAVAILABLE"
+	DuplicateContentCode            string = "ERR_POST_DUPLICATE_CONTENT"
+	ContentRejectedCode             string = "ERR_POST_CONTENT_REJECTED"
+	ContentHeldForReviewCode        string = "ERR_POST_CONTENT_HELD_FOR_REVIEW"
+	TooManyPinnedPostsCode          string = "ERR_POST_TOO_MANY_PINNED"
+	NoCommentFoundCode              string = "ERR_NO_COMMENT_FOUND"
+	ModeratorAccessDeniedCode       string = "ERR_POST_MODERATOR_ACCESS_DENIED"
+	NoContentReviewFoundCode        string = "ERR_NO_CONTENT_REVIEW_FOUND"
 )
 
 var (
@@ -44,6 +76,33 @@ var (
 	UnableToUpdatePreviewError     = golaerror.Error{ErrorCode: UnableToUpdatePreviewImageCode, ErrorMessage: "unable to upload avatar"}
 	InvalidImageKeyError           = golaerror.Error{ErrorCode: InvalidImageKeyCode, ErrorMessage: "image key is invalid"}
 	UnauthorisedDraftError         = golaerror.Error{ErrorCode: UnauthorisedDraftCode, ErrorMessage: "unauthorised to access draft"}
+	NoDraftRevisionFoundError      = golaerror.Error{ErrorCode: NoDraftRevisionFoundCode, ErrorMessage: "no revision found for the given draft"}
+	DraftVersionRequiredError      = golaerror.Error{ErrorCode: DraftVersionRequiredCode, ErrorMessage: "draft version is required in the If-Match header"}
+	InvalidPublishTimeError        = golaerror.Error{ErrorCode: InvalidPublishTimeCode, ErrorMessage: "publish time should be in the future"}
+	NoScheduledDraftFoundError     = golaerror.Error{ErrorCode: NoScheduledDraftFoundCode, ErrorMessage: "no scheduled publish found for the given draft id"}
+	NoSeriesFoundError             = golaerror.Error{ErrorCode: NoSeriesFoundCode, ErrorMessage: "no series found for the given series id"}
+	PostAlreadyInSeriesError       = golaerror.Error{ErrorCode: PostAlreadyInSeriesCode, ErrorMessage: "post is already part of a series"}
+	InvalidSeriesOrderError        = golaerror.Error{ErrorCode: InvalidSeriesOrderCode, ErrorMessage: "order should contain every post of the series exactly once"}
+	InvalidPinnedPostsError        = golaerror.Error{ErrorCode: PayloadValidationErrorCode, ErrorMessage: "pinned posts should not repeat a post"}
+	NoCommentFoundError            = golaerror.Error{ErrorCode: NoCommentFoundCode, ErrorMessage: "no comment found for the given comment id"}
+	NoPublicationFoundError        = golaerror.Error{ErrorCode: NoPublicationFoundCode, ErrorMessage: "no publication found for the given publication id"}
+	PublicationAccessDeniedError   = golaerror.Error{ErrorCode: PublicationAccessDeniedCode, ErrorMessage: "user does not have the required role in the publication"}
+	AlreadyPublicationMemberError  = golaerror.Error{ErrorCode: AlreadyPublicationMemberCode, ErrorMessage: "user is already a member of the publication"}
+	NoPublicationInviteFoundError  = golaerror.Error{ErrorCode: NoPublicationInviteFoundCode, ErrorMessage: "no pending invite found for the publication"}
+	DraftAlreadySubmittedError     = golaerror.Error{ErrorCode: DraftAlreadySubmittedCode, ErrorMessage: "draft is already waiting for review"}
+	NoSubmissionFoundError         = golaerror.Error{ErrorCode: NoSubmissionFoundCode, ErrorMessage: "no submission found for the given submission id"}
+	SubmissionAlreadyReviewedError = golaerror.Error{ErrorCode: SubmissionAlreadyReviewedCode, ErrorMessage: "submission has already been reviewed"}
+	PublicationReviewRequiredError = golaerror.Error{ErrorCode: PublicationReviewRequiredCode, ErrorMessage: "post belongs to a publication, submit the edit to the publication for review"}
+	DraftAlreadyPublishedError     = golaerror.Error{ErrorCode: DraftAlreadyPublishedCode, ErrorMessage: "draft is already published, republish the post to apply your edits"}
+	DraftChangedAfterSubmitError   = golaerror.Error{ErrorCode: DraftChangedAfterSubmitCode, ErrorMessage: "draft was edited after it was submitted, submit it again for review"}
+	NoTemplateFoundError           = golaerror.Error{ErrorCode: NoTemplateFoundCode, ErrorMessage: "no template found for the given template id"}
+	NoPreviewLinkFoundError        = golaerror.Error{ErrorCode: NoPreviewLinkFoundCode, ErrorMessage: "preview link is invalid, expired or revoked"}
+	SlugAlreadyTakenError          = golaerror.Error{ErrorCode: SlugAlreadyTakenCode, ErrorMessage: "slug is already used by another of your posts"}
+	InvalidLinkError               = golaerror.Error{ErrorCode: InvalidLinkCode, ErrorMessage: "link must be an absolute http or https url"}
+	LinkPreviewUnavailableError    = golaerror.Error{ErrorCode: LinkPreviewUnavailableCode, ErrorMessage: "unable to fetch a preview for the link"}
+	ContentHeldForReviewError      = golaerror.Error{ErrorCode: ContentHeldForReviewCode, ErrorMessage: "content is held for review by a moderator"}
+	ModeratorAccessDeniedError     = golaerror.Error{ErrorCode: ModeratorAccessDeniedCode, ErrorMessage: "only moderators can review held content"}
+	NoContentReviewFoundError      = golaerror.Error{ErrorCode: NoContentReviewFoundCode, ErrorMessage: "no pending review found for the given review id"}
 )
 
 var ErrorCodeHttpStatusCodeMap = map[string]int{
@@ -59,6 +118,38 @@ var ErrorCodeHttpStatusCodeMap = map[string]int{
 	PostNotFoundCode:                http.StatusNotFound,
 	InvalidImageKeyCode:             http.StatusBadRequest,
 	UnauthorisedDraftCode:           http.StatusUnauthorized,
+	NoDraftRevisionFoundCode:        http.StatusNotFound,
+	DraftVersionConflictCode:        http.StatusConflict,
+	DraftVersionRequiredCode:        http.StatusPreconditionRequired,
+	InvalidBlockOperationCode:       http.StatusUnprocessableEntity,
+	InvalidPublishTimeCode:          http.StatusBadRequest,
+	NoScheduledDraftFoundCode:       http.StatusNotFound,
+	NoSeriesFoundCode:               http.StatusNotFound,
+	PostAlreadyInSeriesCode:         http.StatusConflict,
+	InvalidSeriesOrderCode:          http.StatusBadRequest,
+	NoPublicationFoundCode:          http.StatusNotFound,
+	PublicationAccessDeniedCode:     http.StatusForbidden,
+	AlreadyPublicationMemberCode:    http.StatusConflict,
+	NoPublicationInviteFoundCode:    http.StatusNotFound,
+	DraftAlreadySubmittedCode:       http.StatusConflict,
+	NoSubmissionFoundCode:           http.StatusNotFound,
+	SubmissionAlreadyReviewedCode:   http.StatusConflict,
+	PublicationReviewRequiredCode:   http.StatusForbidden,
+	DraftAlreadyPublishedCode:       http.StatusConflict,
+	DraftChangedAfterSubmitCode:     http.StatusConflict,
+	InvalidBlocksCode:               http.StatusUnprocessableEntity,
+	NoTemplateFoundCode:             http.StatusNotFound,
+	NoPreviewLinkFoundCode:          http.StatusNotFound,
+	SlugAlreadyTakenCode:            http.StatusConflict,
+	InvalidLinkCode:                 http.StatusBadRequest,
+	LinkPreviewUnavailableCode:      http.StatusUnprocessableEntity,
+	DuplicateContentCode:            http.StatusConflict,
+	ContentRejectedCode:             http.StatusUnprocessableEntity,
+	ContentHeldForReviewCode:        http.StatusAccepted,
+	TooManyPinnedPostsCode:          http.StatusUnprocessableEntity,
+	NoCommentFoundCode:              http.StatusNotFound,
+	ModeratorAccessDeniedCode:       http.StatusForbidden,
+	NoContentReviewFoundCode:        http.StatusNotFound,
 }
 
 func GetGolaHttpCode(golaErrCode string) int {
@@ -76,6 +167,59 @@ func StoryInternalServerError(message string) *golaerror.Error {
 	}
 }
 
+func DraftVersionConflictError(currentVersion int64) *golaerror.Error {
+	return &golaerror.Error{
+		ErrorCode:      DraftVersionConflictCode,
+		ErrorMessage:   "draft has been modified since it was last fetched",
+		AdditionalData: map[string]int64{"version": currentVersion},
+	}
+}
+
+func InvalidBlockOperationError(reason interface{}) *golaerror.Error {
+	return &golaerror.Error{
+		ErrorCode:      InvalidBlockOperationCode,
+		ErrorMessage:   "block operation cannot be applied to the draft",
+		AdditionalData: reason,
+	}
+}
+
+func InvalidBlocksError(blockErrors interface{}) *golaerror.Error {
+	return &golaerror.Error{
+		ErrorCode:      InvalidBlocksCode,
+		ErrorMessage:   "one or more blocks of the draft are invalid",
+		AdditionalData: blockErrors,
+	}
+}
+
+// DuplicateContentError names the post that is duplicated when postID is given. Posts the author may not
+// see are left out, so the error does not reveal them.
+func DuplicateContentError(postID interface{}) *golaerror.Error {
+	err := &golaerror.Error{
+		ErrorCode:    DuplicateContentCode,
+		ErrorMessage: "post is too similar to an existing post",
+	}
+	if postID != nil {
+		err.AdditionalData = map[string]interface{}{"duplicate_of": postID}
+	}
+	return err
+}
+
+func ContentRejectedError(rules []string) *golaerror.Error {
+	return &golaerror.Error{
+		ErrorCode:      ContentRejectedCode,
+		ErrorMessage:   "content is not allowed by the content rules",
+		AdditionalData: map[string][]string{"rules": rules},
+	}
+}
+
+func TooManyPinnedPostsError(maxPins int) *golaerror.Error {
+	return &golaerror.Error{
+		ErrorCode:      TooManyPinnedPostsCode,
+		ErrorMessage:   "no more posts can be pinned to the profile",
+		AdditionalData: map[string]int{"max_pins": maxPins},
+	}
+}
+
 func RespondWithGolaError(ctx *gin.Context, err error) {
 	if golaErr, ok := err.(*golaerror.Error); ok {
 		ctx.JSON(GetGolaHttpCode(golaErr.ErrorCode), golaErr)
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type ContentReviewController struct {
+	service service.ContentReviewService
+}
+
+// GetReviews godoc
+// @Tags review
+// @Summary GetReviews
+// @Description get the posts, comments and about texts held by the content filter for moderators, oldest first
+// @Accept json
+// @Param start query int false "Start"
+// @Param limit query int true "Limit"
+// @Success 200 {object} []db.ContentReview
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/reviews [get]
+func (controller ContentReviewController) GetReviews(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewController").WithField("method", "GetReviews")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var reviewsRequest request.ContentReviewsRequest
+	if err := ctx.ShouldBindQuery(&reviewsRequest); err != nil {
+		logger.Errorf("Error occurred while binding get reviews query %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	reviews, fetchErr := controller.service.GetPendingReviews(ctx, userUUID, reviewsRequest.Limit, reviewsRequest.Start)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching pending reviews for user %v .%v", userUUID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reviews)
+}
+
+// ReviewContent godoc
+// @Tags review
+// @Summary ReviewContent
+// @Description approve or reject held content, an approved comment or about text is released right away while an approved post can be published again as it was held
+// @Accept json
+// @Param review_id path string true "Review ID"
+// @Param request body request.ReviewContentRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/reviews/:review_id [put]
+func (controller ContentReviewController) ReviewContent(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewController").WithField("method", "ReviewContent")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.ContentReviewURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding review content request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var reviewRequest request.ReviewContentRequest
+	if err := ctx.ShouldBindBodyWith(&reviewRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding review content request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	reviewID, _ := uuid.Parse(uriRequest.ReviewID)
+	reviewErr := controller.service.ReviewContent(ctx, reviewID, userUUID, reviewRequest.Decision)
+	if reviewErr != nil {
+		logger.Errorf("Error occurred while reviewing %v .%v", reviewID, reviewErr)
+		constants.RespondWithGolaError(ctx, reviewErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func NewContentReviewController(reviewService service.ContentReviewService) ContentReviewController {
+	return ContentReviewController{service: reviewService}
+}
@@ -15,6 +15,7 @@ import (
 	"post-api/story/models/request"
 	"post-api/story/service"
 	"post-api/story/utils"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -45,17 +46,21 @@ func (controller DraftController) CreateDraft(ctx *gin.Context) {
 		return
 	}
 	upsertPost.UserID = userUUID
-	draftUUID, draftSaveErr := controller.service.CreateDraft(ctx, upsertPost)
+	draftUUID, sanitized, draftSaveErr := controller.service.CreateDraft(ctx, upsertPost)
 	if draftSaveErr != nil {
 		logger.Errorf("Error occurred in draft service while saving draft for user %v. Error %v", userUUID, draftSaveErr)
-		ctx.AbortWithStatus(http.StatusInternalServerError)
+		constants.RespondWithGolaError(ctx, draftSaveErr)
 		return
 	}
 
 	logger.Infof("writing response to draft request for user %v", userUUID)
-	ctx.JSON(http.StatusOK, gin.H{
+	body := gin.H{
 		"draft_id": draftUUID.String(),
-	})
+	}
+	if len(sanitized) > 0 {
+		body["sanitized"] = sanitized
+	}
+	ctx.JSON(http.StatusOK, body)
 }
 
 // SaveDraft godoc
@@ -64,8 +69,11 @@ func (controller DraftController) CreateDraft(ctx *gin.Context) {
 // @Description Save new draft or update existing draft
 // @Accept json
 // @Param request body models.UpsertDraft true "Request Body"
+// @Param If-Match header string true "Draft version returned as ETag by GetDraft"
 // @Success 200
 // @Failure 400
+// @Failure 409 {object} golaerror.Error
+// @Failure 428 {object} golaerror.Error
 // @Failure 500
 // @Router /api/post/v1/draft/upsertDraft [post]
 func (controller DraftController) SaveDraft(ctx *gin.Context) {
@@ -96,18 +104,89 @@ func (controller DraftController) SaveDraft(ctx *gin.Context) {
 		return
 	}
 
+	version, ok := draftVersion(ctx)
+	if !ok {
+		logger.Errorf("draft version missing in save draft request for user %v", userUUID)
+		constants.RespondWithGolaError(ctx, &constants.DraftVersionRequiredError)
+		return
+	}
+
 	logger.Infof("Request body bind successful with upsert draft request for user %v", userUUID)
 	upsertPost.UserID = userUUID
 	upsertPost.DraftID = draftID
-	draftSaveErr := controller.service.UpdateDraft(upsertPost, ctx)
+	upsertPost.Version = version
+	newVersion, sanitized, draftSaveErr := controller.service.UpdateDraft(upsertPost, ctx)
 	if draftSaveErr != nil {
 		logger.Errorf("Error occurred in draft service while saving draft for user %v. Error %v", userUUID, draftSaveErr)
-		ctx.AbortWithStatus(http.StatusInternalServerError)
+		constants.RespondWithGolaError(ctx, draftSaveErr)
 		return
 	}
 
 	logger.Infof("writing response to draft request for user %v", userUUID)
-	ctx.Status(http.StatusOK)
+	respondWithDraftVersion(ctx, newVersion, sanitized)
+}
+
+// PatchDraft godoc
+// @Tags draft
+// @Summary PatchDraft
+// @Description Apply block level insert, update, move and delete operations to an existing draft
+// @Accept json
+// @Param draft query string true "Draft ID"
+// @Param If-Match header string true "Draft version returned as ETag by GetDraft"
+// @Param request body request.DraftPatchRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 409 {object} golaerror.Error
+// @Failure 422 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft [patch]
+func (controller DraftController) PatchDraft(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "PatchDraft")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to patch draft request for user %v", userUUID)
+
+	draftID, err := uuid.Parse(ctx.Query("draft"))
+	if err != nil {
+		logger.Errorf("invalid draft id request for user %v. Error %v", userUUID, err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var patchRequest request.DraftPatchRequest
+	err = ctx.ShouldBindBodyWith(&patchRequest, binding.JSON)
+	if err != nil {
+		logger.Errorf("Unable to bind patch draft request for user %v. Error %v", userUUID, err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	version, ok := draftVersion(ctx)
+	if !ok {
+		logger.Errorf("draft version missing in patch draft request for user %v", userUUID)
+		constants.RespondWithGolaError(ctx, &constants.DraftVersionRequiredError)
+		return
+	}
+
+	patchRequest.UserID = userUUID
+	patchRequest.DraftID = draftID
+	patchRequest.Version = version
+	newVersion, sanitized, patchErr := controller.service.PatchDraft(ctx, patchRequest)
+	if patchErr != nil {
+		logger.Errorf("Error occurred in draft service while patching draft %v for user %v. Error %v", draftID, userUUID, patchErr)
+		constants.RespondWithGolaError(ctx, patchErr)
+		return
+	}
+
+	logger.Infof("writing response to patch draft request for user %v", userUUID)
+	respondWithDraftVersion(ctx, newVersion, sanitized)
 }
 
 func (controller DraftController) SaveTagline(ctx *gin.Context) {
@@ -138,20 +217,28 @@ func (controller DraftController) SaveTagline(ctx *gin.Context) {
 		return
 	}
 
+	version, ok := draftVersion(ctx)
+	if !ok {
+		logger.Errorf("draft version missing in save tagline request for user %v", userUUID)
+		constants.RespondWithGolaError(ctx, &constants.DraftVersionRequiredError)
+		return
+	}
+
 	logger.Infof("Request body bind successful with save tagline request for user %v", userUUID)
 	upsertTagline.DraftID = draftID
 	upsertTagline.UserID = userUUID
-	draftSaveErr := controller.service.UpsertTagline(upsertTagline, ctx)
+	upsertTagline.Version = version
+	newVersion, draftSaveErr := controller.service.UpsertTagline(upsertTagline, ctx)
 
 	if draftSaveErr != nil {
 		logger.Errorf("Error occurred in draft service while saving tagline for user %v. Error %v", "12", draftSaveErr)
-		ctx.AbortWithStatus(http.StatusInternalServerError)
+		constants.RespondWithGolaError(ctx, draftSaveErr)
 		return
 	}
 
 	logger.Infof("writing response to tagline request for user %v", userUUID)
 
-	ctx.Status(http.StatusOK)
+	respondWithDraftVersion(ctx, newVersion, nil)
 }
 
 func (controller DraftController) SaveInterests(ctx *gin.Context) {
@@ -184,10 +271,18 @@ func (controller DraftController) SaveInterests(ctx *gin.Context) {
 		return
 	}
 
+	version, ok := draftVersion(ctx)
+	if !ok {
+		log.Errorf("draft version missing in save interests request for user %v", userUUID)
+		constants.RespondWithGolaError(ctx, &constants.DraftVersionRequiredError)
+		return
+	}
+
 	log.Infof("Request body bind successful with save interests request for user %v", userUUID)
 	upsertInterests.DraftID = draftID
 	upsertInterests.UserID = userUUID
-	draftSaveErr := controller.service.UpsertInterests(upsertInterests, ctx)
+	upsertInterests.Version = version
+	newVersion, draftSaveErr := controller.service.UpsertInterests(upsertInterests, ctx)
 
 	if draftSaveErr != nil {
 		log.Errorf("Error occurred in draft service while saving interests for user %v. Error %v", "12", draftSaveErr)
@@ -197,7 +292,7 @@ func (controller DraftController) SaveInterests(ctx *gin.Context) {
 
 	log.Infof("writing response to interests request for user %v", userUUID)
 
-	ctx.Status(http.StatusOK)
+	respondWithDraftVersion(ctx, newVersion, nil)
 }
 
 func (controller DraftController) GetDraft(ctx *gin.Context) {
@@ -228,6 +323,7 @@ func (controller DraftController) GetDraft(ctx *gin.Context) {
 		return
 	}
 	logger.Infof("writing response to draft data request for user %v %s", userUUID, draftID)
+	ctx.Header("ETag", fmt.Sprintf(`"%d"`, draftData.Version))
 	ctx.JSON(http.StatusOK, draftData)
 }
 
@@ -277,10 +373,18 @@ func (controller DraftController) UploadImageKey(ctx *gin.Context) {
 		return
 	}
 
-	uploadErr := controller.service.SavePreviewImage(ctx, request.PreviewImageSaveRequest{
+	version, ok := draftVersion(ctx)
+	if !ok {
+		logger.Errorf("draft version missing in preview image request for user %v", userUUID)
+		constants.RespondWithGolaError(ctx, &constants.DraftVersionRequiredError)
+		return
+	}
+
+	newVersion, uploadErr := controller.service.SavePreviewImage(ctx, request.PreviewImageSaveRequest{
 		UserID:   userUUID,
 		DraftID:  draftID,
 		UploadID: upload.UploadID,
+		Version:  version,
 	})
 	if uploadErr != nil {
 		logger.Errorf("unable to upload image %v", uploadErr)
@@ -288,7 +392,7 @@ func (controller DraftController) UploadImageKey(ctx *gin.Context) {
 		return
 	}
 
-	ctx.Status(http.StatusOK)
+	respondWithDraftVersion(ctx, newVersion, nil)
 }
 
 func (controller DraftController) UploadDraftImageKey(ctx *gin.Context) {
@@ -659,6 +763,514 @@ func (controller DraftController) GetPreviewDraft(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, draftData)
 }
 
+// ImportMarkdown godoc
+// @Tags draft
+// @Summary ImportMarkdown
+// @Description create a draft from markdown
+// @Accept json
+// @Param request body request.MarkdownImportRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/markdown [post]
+func (controller DraftController) ImportMarkdown(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "ImportMarkdown")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to import markdown draft for user %v", userUUID)
+
+	var importRequest request.MarkdownImportRequest
+	if err := ctx.ShouldBindBodyWith(&importRequest, binding.JSON); err != nil {
+		logger.Errorf("Unable to bind markdown import request for user %v. Error %v", userUUID, err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+	importRequest.UserID = userUUID
+
+	draftID, sanitized, importErr := controller.service.ImportMarkdown(ctx, importRequest)
+	if importErr != nil {
+		logger.Errorf("Error occurred in draft service while importing markdown for user %v. Error %v", userUUID, importErr)
+		constants.RespondWithGolaError(ctx, importErr)
+		return
+	}
+
+	body := gin.H{
+		"draft_id": draftID.String(),
+	}
+	if len(sanitized) > 0 {
+		body["sanitized"] = sanitized
+	}
+	ctx.JSON(http.StatusOK, body)
+}
+
+// ExportMarkdown godoc
+// @Tags draft
+// @Summary ExportMarkdown
+// @Description export the content of a draft as markdown
+// @Param draft_id path string true "Draft ID"
+// @Produce plain
+// @Success 200 {string} string
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/markdown/:draft_id [get]
+func (controller DraftController) ExportMarkdown(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "ExportMarkdown")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	markdown, exportErr := controller.service.ExportMarkdown(ctx, draftID, userUUID)
+	if exportErr != nil {
+		logger.Errorf("Error occurred in draft service while exporting draft %v. Error %v", draftID, exportErr)
+		constants.RespondWithGolaError(ctx, exportErr)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(markdown))
+}
+
+// ForkDraft godoc
+// @Tags draft
+// @Summary ForkDraft
+// @Description start a new draft with a copy of the content, tagline and interests of a draft
+// @Param draft_id path string true "Draft ID"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/fork/:draft_id [post]
+func (controller DraftController) ForkDraft(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "ForkDraft")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	forkID, forkErr := controller.service.ForkDraft(ctx, draftID, userUUID)
+	if forkErr != nil {
+		logger.Errorf("Error occurred in draft service while forking draft %v. Error %v", draftID, forkErr)
+		constants.RespondWithGolaError(ctx, forkErr)
+		return
+	}
+
+	logger.Infof("Successfully forked draft %v into draft %v for user %v", draftID, forkID, userUUID)
+	ctx.JSON(http.StatusCreated, gin.H{
+		"draft_id": forkID,
+	})
+}
+
+// ForkPost godoc
+// @Tags draft
+// @Summary ForkPost
+// @Description start a new draft from a published post of the user
+// @Param post_id path string true "Post ID"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/fork/post/:post_id [post]
+func (controller DraftController) ForkPost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "ForkPost")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postURIRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	postID, _ := uuid.Parse(postURIRequest.PostUID)
+	forkID, forkErr := controller.service.ForkPost(ctx, postID, userUUID)
+	if forkErr != nil {
+		logger.Errorf("Error occurred in draft service while forking post %v. Error %v", postID, forkErr)
+		constants.RespondWithGolaError(ctx, forkErr)
+		return
+	}
+
+	logger.Infof("Successfully forked post %v into draft %v for user %v", postID, forkID, userUUID)
+	ctx.JSON(http.StatusCreated, gin.H{
+		"draft_id": forkID,
+	})
+}
+
+// GetRevisions godoc
+// @Tags draft
+// @Summary GetRevisions
+// @Description list the saved revisions of a draft, latest first
+// @Param draft_id path string true "Draft ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/revisions/:draft_id [get]
+func (controller DraftController) GetRevisions(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "GetRevisions")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to get draft revisions for user %v", userUUID)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	revisions, revisionsErr := controller.service.GetRevisions(ctx, draftID, userUUID)
+	if revisionsErr != nil {
+		logger.Errorf("Error occurred in draft service while fetching revisions for draft %v. Error %v", draftID, revisionsErr)
+		constants.RespondWithGolaError(ctx, revisionsErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
+// GetRevision godoc
+// @Tags draft
+// @Summary GetRevision
+// @Description get a single revision of a draft including its data
+// @Param draft_id path string true "Draft ID"
+// @Param revision_id path string true "Revision ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/revisions/:draft_id/:revision_id [get]
+func (controller DraftController) GetRevision(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "GetRevision")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to get draft revision for user %v", userUUID)
+
+	var revisionURIRequest request.RevisionURIRequest
+	if err := ctx.ShouldBindUri(&revisionURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(revisionURIRequest.DraftID)
+	revisionID, _ := uuid.Parse(revisionURIRequest.RevisionID)
+	revision, revisionErr := controller.service.GetRevision(ctx, draftID, revisionID, userUUID)
+	if revisionErr != nil {
+		logger.Errorf("Error occurred in draft service while fetching revision %v for draft %v. Error %v", revisionID, draftID, revisionErr)
+		constants.RespondWithGolaError(ctx, revisionErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision)
+}
+
+// DiffRevisions godoc
+// @Tags draft
+// @Summary DiffRevisions
+// @Description compare two revisions of a draft block by block
+// @Param draft_id path string true "Draft ID"
+// @Param from query string true "Older revision ID"
+// @Param to query string true "Newer revision ID"
+// @Success 200 {object} response.RevisionDiff
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/revisions/:draft_id/diff [get]
+func (controller DraftController) DiffRevisions(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "DiffRevisions")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to diff draft revisions for user %v", userUUID)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var diffRequest request.RevisionDiffRequest
+	if err := ctx.ShouldBindQuery(&diffRequest); err != nil {
+		logger.Errorf("unable to bind query parameters %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	fromID, _ := uuid.Parse(diffRequest.From)
+	toID, _ := uuid.Parse(diffRequest.To)
+	diff, diffErr := controller.service.DiffRevisions(ctx, draftID, fromID, toID, userUUID)
+	if diffErr != nil {
+		logger.Errorf("Error occurred in draft service while comparing revisions for draft %v. Error %v", draftID, diffErr)
+		constants.RespondWithGolaError(ctx, diffErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, diff)
+}
+
+// RestoreRevision godoc
+// @Tags draft
+// @Summary RestoreRevision
+// @Description restore an older revision as the current draft, recorded as a new revision
+// @Param draft_id path string true "Draft ID"
+// @Param revision_id path string true "Revision ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Param If-Match header string true "Draft version returned as ETag by GetDraft"
+// @Router /api/post/v1/draft/revisions/:draft_id/:revision_id/restore [post]
+func (controller DraftController) RestoreRevision(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "RestoreRevision")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to restore draft revision for user %v", userUUID)
+
+	var revisionURIRequest request.RevisionURIRequest
+	if err := ctx.ShouldBindUri(&revisionURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	version, ok := draftVersion(ctx)
+	if !ok {
+		logger.Errorf("draft version missing in restore revision request for user %v", userUUID)
+		constants.RespondWithGolaError(ctx, &constants.DraftVersionRequiredError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(revisionURIRequest.DraftID)
+	revisionID, _ := uuid.Parse(revisionURIRequest.RevisionID)
+	newVersion, sanitized, restoreErr := controller.service.RestoreRevision(ctx, draftID, revisionID, userUUID, version)
+	if restoreErr != nil {
+		logger.Errorf("Error occurred in draft service while restoring revision %v for draft %v. Error %v", revisionID, draftID, restoreErr)
+		constants.RespondWithGolaError(ctx, restoreErr)
+		return
+	}
+
+	respondWithDraftVersion(ctx, newVersion, sanitized)
+}
+
+// SchedulePublish godoc
+// @Tags draft
+// @Summary SchedulePublish
+// @Description schedule a draft to be published at the given time
+// @Accept json
+// @Param draft_id path string true "Draft ID"
+// @Param request body request.SchedulePublishRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/schedule/:draft_id [put]
+func (controller DraftController) SchedulePublish(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "SchedulePublish")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to schedule draft publish for user %v", userUUID)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var scheduleRequest request.SchedulePublishRequest
+	if err := ctx.ShouldBindBodyWith(&scheduleRequest, binding.JSON); err != nil {
+		logger.Errorf("Unable to bind schedule publish request for user %v. Error %v", userUUID, err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	scheduleRequest.DraftID, _ = uuid.Parse(draftURIRequest.DraftID)
+	scheduleRequest.UserID = userUUID
+	scheduleErr := controller.service.SchedulePublish(ctx, scheduleRequest)
+	if scheduleErr != nil {
+		logger.Errorf("Error occurred in draft service while scheduling draft %v. Error %v", scheduleRequest.DraftID, scheduleErr)
+		constants.RespondWithGolaError(ctx, scheduleErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// CancelScheduledPublish godoc
+// @Tags draft
+// @Summary CancelScheduledPublish
+// @Description cancel the scheduled publish of a draft
+// @Param draft_id path string true "Draft ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/schedule/:draft_id [delete]
+func (controller DraftController) CancelScheduledPublish(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "CancelScheduledPublish")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to cancel scheduled publish for user %v", userUUID)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("unable to bind path parameter %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	cancelErr := controller.service.CancelScheduledPublish(ctx, draftID, userUUID)
+	if cancelErr != nil {
+		logger.Errorf("Error occurred in draft service while cancelling schedule of draft %v. Error %v", draftID, cancelErr)
+		constants.RespondWithGolaError(ctx, cancelErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// GetScheduledDrafts godoc
+// @Tags draft
+// @Summary GetScheduledDrafts
+// @Description list the drafts of the user that are scheduled to be published
+// @Success 200 {array} db.ScheduledDraft
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/scheduled [get]
+func (controller DraftController) GetScheduledDrafts(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftController").WithField("method", "GetScheduledDrafts")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	logger.Infof("Entered controller to get scheduled drafts for user %v", userUUID)
+
+	drafts, draftsErr := controller.service.GetScheduledDrafts(ctx, userUUID)
+	if draftsErr != nil {
+		logger.Errorf("Error occurred in draft service while fetching scheduled drafts for user %v. Error %v", userUUID, draftsErr)
+		constants.RespondWithGolaError(ctx, draftsErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, drafts)
+}
+
+// draftVersion reads the draft version the client last saw from the If-Match header. Both the
+// quoted ETag returned by GetDraft and a bare version number are accepted.
+func draftVersion(ctx *gin.Context) (int64, bool) {
+	value := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return version, true
+}
+
+// respondWithDraftVersion writes the new draft version. Markup stripped from the saved content is
+// listed under sanitized so the author can see what did not survive.
+func respondWithDraftVersion(ctx *gin.Context, version int64, sanitized []models.SanitizeReport) {
+	ctx.Header("ETag", fmt.Sprintf(`"%d"`, version))
+	body := gin.H{
+		"version": version,
+	}
+	if len(sanitized) > 0 {
+		body["sanitized"] = sanitized
+	}
+	ctx.JSON(http.StatusOK, body)
+}
+
 func isOneOf(key string) bool {
 	i := []string{"interest", "draft"}
 	for _, prefix := range i {
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type DraftPreviewController struct {
+	service service.DraftPreviewService
+}
+
+// CreatePreviewLink godoc
+// @Tags draft
+// @Summary CreatePreviewLink
+// @Description create a signed link that lets anyone read the draft until it expires or is revoked
+// @Accept json
+// @Param draft_id path string true "Draft ID"
+// @Param request body request.CreatePreviewLinkRequest false "Request Body"
+// @Success 201 {object} response.DraftPreviewLink
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/preview-links/:draft_id [post]
+func (controller DraftPreviewController) CreatePreviewLink(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewController").WithField("method", "CreatePreviewLink")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("Error occurred while binding create preview link request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var createRequest request.CreatePreviewLinkRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindBodyWith(&createRequest, binding.JSON); err != nil {
+			logger.Errorf("Error occurred while binding create preview link request body %v", err)
+			ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+			return
+		}
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	link, createErr := controller.service.CreateLink(ctx, draftID, userUUID, createRequest.ExpiresInHours)
+	if createErr != nil {
+		logger.Errorf("Error occurred while creating preview link for draft %v .%v", draftID, createErr)
+		constants.RespondWithGolaError(ctx, createErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, link)
+}
+
+// GetPreviewLinks godoc
+// @Tags draft
+// @Summary GetPreviewLinks
+// @Description list the active preview links of the draft with the number of times each was opened
+// @Accept json
+// @Param draft_id path string true "Draft ID"
+// @Success 200 {object} []db.DraftPreviewLink
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/preview-links/:draft_id [get]
+func (controller DraftPreviewController) GetPreviewLinks(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewController").WithField("method", "GetPreviewLinks")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("Error occurred while binding get preview links request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	links, fetchErr := controller.service.GetLinks(ctx, draftID, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching preview links of draft %v .%v", draftID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, links)
+}
+
+// RevokePreviewLink godoc
+// @Tags draft
+// @Summary RevokePreviewLink
+// @Description revoke a preview link so it can no longer be opened
+// @Accept json
+// @Param draft_id path string true "Draft ID"
+// @Param link_id path string true "Preview Link ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/preview-links/:draft_id/:link_id [delete]
+func (controller DraftPreviewController) RevokePreviewLink(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewController").WithField("method", "RevokePreviewLink")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var linkURIRequest request.PreviewLinkURIRequest
+	if err := ctx.ShouldBindUri(&linkURIRequest); err != nil {
+		logger.Errorf("Error occurred while binding revoke preview link request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(linkURIRequest.DraftID)
+	linkID, _ := uuid.Parse(linkURIRequest.LinkID)
+	revokeErr := controller.service.RevokeLink(ctx, draftID, linkID, userUUID)
+	if revokeErr != nil {
+		logger.Errorf("Error occurred while revoking preview link %v .%v", linkID, revokeErr)
+		constants.RespondWithGolaError(ctx, revokeErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// OpenPreviewLink godoc
+// @Tags draft
+// @Summary OpenPreviewLink
+// @Description read the draft a preview link points to, without login
+// @Accept json
+// @Param token path string true "Preview Token"
+// @Success 200 {object} response.SharedDraft
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/shared/:token [get]
+func (controller DraftPreviewController) OpenPreviewLink(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewController").WithField("method", "OpenPreviewLink")
+
+	var sharedRequest request.SharedDraftURIRequest
+	if err := ctx.ShouldBindUri(&sharedRequest); err != nil {
+		logger.Errorf("Error occurred while binding open preview link request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draft, openErr := controller.service.OpenLink(ctx, sharedRequest.Token)
+	if openErr != nil {
+		logger.Errorf("Error occurred while opening preview link .%v", openErr)
+		constants.RespondWithGolaError(ctx, openErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+}
+
+func NewDraftPreviewController(previewService service.DraftPreviewService) DraftPreviewController {
+	return DraftPreviewController{service: previewService}
+}
@@ -0,0 +1,296 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/models"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type DraftTemplateController struct {
+	service service.DraftTemplateService
+}
+
+// SaveTemplate godoc
+// @Tags template
+// @Summary SaveTemplate
+// @Description save one of the user's drafts as a template
+// @Accept json
+// @Param request body request.SaveTemplateRequest true "Request Body"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template [post]
+func (controller DraftTemplateController) SaveTemplate(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "SaveTemplate")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var saveRequest request.SaveTemplateRequest
+	if err := ctx.ShouldBindBodyWith(&saveRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding save template request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+	saveRequest.UserID = userUUID
+
+	templateID, saveErr := controller.service.SaveAsTemplate(ctx, saveRequest)
+	if saveErr != nil {
+		logger.Errorf("Error occurred while saving draft %v as template .%v", saveRequest.DraftID, saveErr)
+		constants.RespondWithGolaError(ctx, saveErr)
+		return
+	}
+
+	logger.Infof("Successfully saved template %v for user %v", templateID, userUUID)
+	ctx.JSON(http.StatusCreated, gin.H{
+		"template_id": templateID,
+	})
+}
+
+// GetTemplates godoc
+// @Tags template
+// @Summary GetTemplates
+// @Description get the templates saved by the user
+// @Accept json
+// @Success 200 {object} []db.DraftTemplateSummary
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template [get]
+func (controller DraftTemplateController) GetTemplates(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "GetTemplates")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	templates, fetchErr := controller.service.GetTemplates(ctx, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching templates of user %v .%v", userUUID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+}
+
+// GetPublicTemplates godoc
+// @Tags template
+// @Summary GetPublicTemplates
+// @Description get the templates shared publicly, newest first
+// @Accept json
+// @Param start query int false "Start"
+// @Param limit query int false "Limit"
+// @Success 200 {object} []db.DraftTemplateSummary
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template/public [get]
+func (controller DraftTemplateController) GetPublicTemplates(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "GetPublicTemplates")
+
+	var pagination models.Pagination
+	if err := ctx.ShouldBindQuery(&pagination); err != nil {
+		logger.Errorf("Error occurred while binding pagination %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	templates, fetchErr := controller.service.GetPublicTemplates(ctx, pagination.Limit, pagination.Start)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching public templates .%v", fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+}
+
+// GetTemplate godoc
+// @Tags template
+// @Summary GetTemplate
+// @Description get a template owned by the user or shared publicly
+// @Accept json
+// @Param template_id path string true "Template ID"
+// @Success 200 {object} db.DraftTemplate
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template/:template_id [get]
+func (controller DraftTemplateController) GetTemplate(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "GetTemplate")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.TemplateURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding get template request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	templateID, _ := uuid.Parse(uriRequest.TemplateID)
+	template, fetchErr := controller.service.GetTemplate(ctx, templateID, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching template %v .%v", templateID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, template)
+}
+
+// ShareTemplate godoc
+// @Tags template
+// @Summary ShareTemplate
+// @Description share a template publicly or make it private again
+// @Accept json
+// @Param template_id path string true "Template ID"
+// @Param request body request.TemplateVisibilityRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template/:template_id/visibility [put]
+func (controller DraftTemplateController) ShareTemplate(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "ShareTemplate")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.TemplateURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding share template request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var visibilityRequest request.TemplateVisibilityRequest
+	if err := ctx.ShouldBindBodyWith(&visibilityRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding share template request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	templateID, _ := uuid.Parse(uriRequest.TemplateID)
+	shareErr := controller.service.ShareTemplate(ctx, templateID, userUUID, *visibilityRequest.IsPublic)
+	if shareErr != nil {
+		logger.Errorf("Error occurred while updating visibility of template %v .%v", templateID, shareErr)
+		constants.RespondWithGolaError(ctx, shareErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// DeleteTemplate godoc
+// @Tags template
+// @Summary DeleteTemplate
+// @Description delete a template of the user
+// @Accept json
+// @Param template_id path string true "Template ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template/:template_id [delete]
+func (controller DraftTemplateController) DeleteTemplate(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "DeleteTemplate")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.TemplateURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding delete template request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	templateID, _ := uuid.Parse(uriRequest.TemplateID)
+	deleteErr := controller.service.DeleteTemplate(ctx, templateID, userUUID)
+	if deleteErr != nil {
+		logger.Errorf("Error occurred while deleting template %v .%v", templateID, deleteErr)
+		constants.RespondWithGolaError(ctx, deleteErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// CreateDraftFromTemplate godoc
+// @Tags template
+// @Summary CreateDraftFromTemplate
+// @Description start a new draft with the content of a template
+// @Accept json
+// @Param template_id path string true "Template ID"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/template/:template_id/draft [post]
+func (controller DraftTemplateController) CreateDraftFromTemplate(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateController").WithField("method", "CreateDraftFromTemplate")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.TemplateURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding create draft from template request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	templateID, _ := uuid.Parse(uriRequest.TemplateID)
+	draftID, createErr := controller.service.CreateDraftFromTemplate(ctx, templateID, userUUID)
+	if createErr != nil {
+		logger.Errorf("Error occurred while creating draft from template %v .%v", templateID, createErr)
+		constants.RespondWithGolaError(ctx, createErr)
+		return
+	}
+
+	logger.Infof("Successfully created draft %v from template %v for user %v", draftID, templateID, userUUID)
+	ctx.JSON(http.StatusCreated, gin.H{
+		"draft_id": draftID,
+	})
+}
+
+func NewDraftTemplateController(templateService service.DraftTemplateService) DraftTemplateController {
+	return DraftTemplateController{service: templateService}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type DraftTrashController struct {
+	service service.DraftTrashService
+}
+
+// GetTrashedDrafts godoc
+// @Tags draft
+// @Summary GetTrashedDrafts
+// @Description list the deleted drafts of the user with the time each one is purged
+// @Accept json
+// @Success 200 {object} []db.TrashedDraft
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/trash [get]
+func (controller DraftTrashController) GetTrashedDrafts(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTrashController").WithField("method", "GetTrashedDrafts")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	drafts, fetchErr := controller.service.GetTrashedDrafts(ctx, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching trashed drafts of user %v .%v", userUUID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, drafts)
+}
+
+// RestoreDraft godoc
+// @Tags draft
+// @Summary RestoreDraft
+// @Description move a deleted draft back out of the trash
+// @Accept json
+// @Param draft_id path string true "Draft ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/draft/trash/:draft_id/restore [put]
+func (controller DraftTrashController) RestoreDraft(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTrashController").WithField("method", "RestoreDraft")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var draftURIRequest request.DraftURIRequest
+	if err := ctx.ShouldBindUri(&draftURIRequest); err != nil {
+		logger.Errorf("Error occurred while binding restore draft request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	draftID, _ := uuid.Parse(draftURIRequest.DraftID)
+	restoreErr := controller.service.RestoreDraft(ctx, draftID, userUUID)
+	if restoreErr != nil {
+		logger.Errorf("Error occurred while restoring draft %v .%v", draftID, restoreErr)
+		constants.RespondWithGolaError(ctx, restoreErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func NewDraftTrashController(trashService service.DraftTrashService) DraftTrashController {
+	return DraftTrashController{service: trashService}
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/models/response"
+	"post-api/story/service"
+)
+
+type LinkPreviewController struct {
+	service service.LinkPreviewService
+}
+
+// Unfurl godoc
+// @Tags draft
+// @Summary Unfurl
+// @Description fetch the title, description and image of a link for a link block
+// @Accept json
+// @Param url query string true "Link"
+// @Success 200 {object} response.LinkPreview
+// @Failure 400 {object} golaerror.Error
+// @Failure 422 {object} golaerror.Error
+// @Router /api/post/v1/draft/link-preview [get]
+func (controller LinkPreviewController) Unfurl(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "LinkPreviewController").WithField("method", "Unfurl")
+
+	var previewRequest request.LinkPreviewRequest
+	if err := ctx.ShouldBindQuery(&previewRequest); err != nil {
+		logger.Errorf("Error occurred while binding link preview request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	meta, unfurlErr := controller.service.Unfurl(ctx, previewRequest.URL)
+	if unfurlErr != nil {
+		logger.Errorf("Error occurred while fetching preview of link %v .%v", previewRequest.URL, unfurlErr)
+		constants.RespondWithGolaError(ctx, unfurlErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.LinkPreview{Success: 1, Link: previewRequest.URL, Meta: meta})
+}
+
+func NewLinkPreviewController(previewService service.LinkPreviewService) LinkPreviewController {
+	return LinkPreviewController{service: previewService}
+}
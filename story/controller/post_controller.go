@@ -8,8 +8,10 @@ import (
 	"post-api/story/models/request"
 	"post-api/story/service"
 	"post-api/story/utils"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/inclusi-blog/gola-utils/logging"
 )
 
@@ -180,6 +182,7 @@ func (controller PostController) GetComments(ctx *gin.Context) {
 		return
 	}
 	commentRequest.PostID = id
+	commentRequest.UserID = userUUID
 	logger.Infof("Request body bind successful with get draft request for user %v", userUUID)
 
 	comments, serviceErr := controller.postService.GetComments(ctx, commentRequest)
@@ -192,6 +195,98 @@ func (controller PostController) GetComments(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, comments)
 }
 
+// GetReplies godoc
+// @Tags post
+// @Summary GetReplies
+// @Description get the replies of a comment, or every reply below it as one flat list once the comment is at the deepest level shown
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Param start query int false "Start"
+// @Param limit query int true "Limit"
+// @Success 200 {object} []response.Comment
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/comments/:comment_id/replies [get]
+func (controller PostController) GetReplies(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "GetReplies")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var commentRequest request.CommentURIRequest
+	if err := ctx.ShouldBindUri(&commentRequest); err != nil {
+		logger.Errorf("Error occurred while binding get replies request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	var repliesRequest request.FetchReplies
+	if err := ctx.ShouldBindQuery(&repliesRequest); err != nil {
+		logger.Errorf("unable to bind request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+	repliesRequest.PostID, _ = uuid.Parse(commentRequest.PostUID)
+	repliesRequest.CommentID, _ = uuid.Parse(commentRequest.CommentUID)
+	repliesRequest.UserID = userUUID
+
+	replies, serviceErr := controller.postService.GetReplies(ctx, repliesRequest)
+	if serviceErr != nil {
+		logger.Errorf("Error occurred while fetching replies of comment %v .%v", repliesRequest.CommentID, serviceErr)
+		constants.RespondWithGolaError(ctx, serviceErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, replies)
+}
+
+// DeleteComment godoc
+// @Tags post
+// @Summary DeleteComment
+// @Description delete a comment of the user, leaving a tombstone in its place when it has replies
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/comments/:comment_id [delete]
+func (controller PostController) DeleteComment(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "DeleteComment")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var commentRequest request.CommentURIRequest
+	if err := ctx.ShouldBindUri(&commentRequest); err != nil {
+		logger.Errorf("Error occurred while binding delete comment request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+	postID, _ := uuid.Parse(commentRequest.PostUID)
+	commentID, _ := uuid.Parse(commentRequest.CommentUID)
+
+	serviceErr := controller.postService.DeleteComment(ctx, commentID, postID, userUUID)
+	if serviceErr != nil {
+		logger.Errorf("Error occurred while deleting comment %v .%v", commentID, serviceErr)
+		constants.RespondWithGolaError(ctx, serviceErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
 func (controller PostController) SavePost(ctx *gin.Context) {
 	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "GetComments")
 	token, err := utils.GetIDToken(ctx)
@@ -282,9 +377,10 @@ func (controller PostController) MarkAsViewed(ctx *gin.Context) {
 // GetPost godoc
 // @Tags post
 // @Summary GetPost
-// @Description get a post
+// @Description get a post. Pass format=html or an Accept header with text/html to also get the rendered content
 // @Accept json
 // @Param request body request.PostURIRequest true "Request Body"
+// @Param format query string false "json or html"
 // @Success 200 {object} response.Post
 // @Failure 400 {object} golaerror.Error
 // @Failure 404 {object} golaerror.Error
@@ -311,13 +407,21 @@ func (controller PostController) GetPost(ctx *gin.Context) {
 		return
 	}
 
+	var getPostRequest request.GetPostRequest
+	if err := ctx.ShouldBindQuery(&getPostRequest); err != nil {
+		logger.Errorf("Error occurred while binding get post query %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+	withHTML := getPostRequest.Format == "html" || strings.Contains(ctx.GetHeader("Accept"), "text/html")
+
 	id, _ := uuid.Parse(postRequest.PostUID)
 	logger.Infof("Successfully bind get post request body for post id %v", id)
-	post, publishErr := controller.postService.GetPost(ctx, id, userUUID)
+	post, publishErr := controller.postService.GetPost(ctx, id, userUUID, withHTML)
 
 	if publishErr != nil {
 		logger.Errorf("Error occurred while publishing draft for draft id %v .%v", id, publishErr)
-		constants.RespondWithGolaError(ctx, err)
+		constants.RespondWithGolaError(ctx, publishErr)
 		return
 	}
 
@@ -459,7 +563,7 @@ func (controller PostController) Delete(ctx *gin.Context) {
 
 	if deleteErr != nil {
 		logger.Errorf("Error occurred while publishing draft for draft id %v .%v", id, deleteErr)
-		constants.RespondWithGolaError(ctx, err)
+		constants.RespondWithGolaError(ctx, deleteErr)
 		return
 	}
 
@@ -467,6 +571,271 @@ func (controller PostController) Delete(ctx *gin.Context) {
 	ctx.Status(http.StatusOK)
 }
 
+// ExportMarkdown godoc
+// @Tags post
+// @Summary ExportMarkdown
+// @Description export the content of a published post as markdown
+// @Param post_id path string true "Post ID"
+// @Produce plain
+// @Success 200 {string} string
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/markdown [get]
+func (controller PostController) ExportMarkdown(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "ExportMarkdown")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding export post request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	id, _ := uuid.Parse(postRequest.PostUID)
+	markdown, exportErr := controller.postService.ExportMarkdown(ctx, id, userUUID)
+	if exportErr != nil {
+		logger.Errorf("Error occurred while exporting post %v as markdown .%v", id, exportErr)
+		constants.RespondWithGolaError(ctx, exportErr)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(markdown))
+}
+
+// EditPost godoc
+// @Tags post
+// @Summary EditPost
+// @Description get the source draft of a published post to edit it
+// @Accept json
+// @Param request body request.PostURIRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/edit [get]
+func (controller PostController) EditPost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "EditPost")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding edit post request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	id, _ := uuid.Parse(postRequest.PostUID)
+	draftID, editErr := controller.postService.EditPost(ctx, id, userUUID)
+	if editErr != nil {
+		logger.Errorf("Error occurred while fetching source draft of post %v .%v", id, editErr)
+		constants.RespondWithGolaError(ctx, editErr)
+		return
+	}
+
+	logger.Infof("Successfully fetched source draft of post %v", id)
+	ctx.JSON(http.StatusOK, gin.H{
+		"draft_id": draftID,
+	})
+}
+
+// RepublishPost godoc
+// @Tags post
+// @Summary RepublishPost
+// @Description replace a published post with the current content of its source draft, edits of a publication post have to be submitted to the publication instead
+// @Accept json
+// @Param request body request.PostURIRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/republish [put]
+func (controller PostController) RepublishPost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "RepublishPost")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding republish post request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	id, _ := uuid.Parse(postRequest.PostUID)
+	republishErr := controller.postService.RepublishPost(ctx, id, userUUID)
+	if republishErr != nil {
+		logger.Errorf("Error occurred while republishing post %v .%v", id, republishErr)
+		constants.RespondWithGolaError(ctx, republishErr)
+		return
+	}
+
+	logger.Infof("Successfully republished post %v", id)
+	ctx.JSON(http.StatusOK, gin.H{
+		"status": "republished",
+	})
+}
+
+// SetSlug godoc
+// @Tags post
+// @Summary SetSlug
+// @Description move a published post to a url built from a custom slug, the previous url keeps redirecting
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Param request body request.SetSlugRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 409 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/slug [put]
+func (controller PostController) SetSlug(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "SetSlug")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding set slug request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	var slugRequest request.SetSlugRequest
+	if err := ctx.ShouldBindBodyWith(&slugRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding set slug request body %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	id, _ := uuid.Parse(postRequest.PostUID)
+	postURL, slugErr := controller.postService.SetSlug(ctx, id, userUUID, slugRequest.Slug)
+	if slugErr != nil {
+		logger.Errorf("Error occurred while setting slug of post %v .%v", id, slugErr)
+		constants.RespondWithGolaError(ctx, slugErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"url": postURL,
+	})
+}
+
+// ResolvePost godoc
+// @Tags post
+// @Summary ResolvePost
+// @Description get the post a url or slug points to, including urls the post had before it was renamed
+// @Accept json
+// @Param url query string true "Post URL"
+// @Param format query string false "json or html"
+// @Success 200 {object} response.ResolvedPost
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/resolve [get]
+func (controller PostController) ResolvePost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "ResolvePost")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var resolveRequest request.ResolvePostRequest
+	if err := ctx.ShouldBindQuery(&resolveRequest); err != nil {
+		logger.Errorf("Error occurred while binding resolve post query %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+	withHTML := resolveRequest.Format == "html" || strings.Contains(ctx.GetHeader("Accept"), "text/html")
+
+	post, resolveErr := controller.postService.ResolvePost(ctx, resolveRequest.URL, userUUID, withHTML)
+	if resolveErr != nil {
+		logger.Errorf("Error occurred while resolving post url %v .%v", resolveRequest.URL, resolveErr)
+		constants.RespondWithGolaError(ctx, resolveErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, post)
+}
+
+// GetPostRevisions godoc
+// @Tags post
+// @Summary GetPostRevisions
+// @Description get the previously published versions of a post, the content of each version is only returned to the author
+// @Accept json
+// @Param request body request.PostURIRequest true "Request Body"
+// @Success 200 {object} []db.PostRevision
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/revisions [get]
+func (controller PostController) GetPostRevisions(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "GetPostRevisions")
+
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding get post revisions request %v", err)
+		constants.RespondWithGolaError(ctx, &constants.PayloadValidationError)
+		return
+	}
+
+	id, _ := uuid.Parse(postRequest.PostUID)
+	revisions, fetchErr := controller.postService.GetPostRevisions(ctx, id, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching revisions of post %v .%v", id, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
 func (controller PostController) GetHomeFeed(ctx *gin.Context) {
 	logger := logging.GetLogger(ctx).WithField("class", "PostController").WithField("method", "GetHomeFeed")
 	logger.Info("Started get post to fetch post for the given post id")
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type PostStateController struct {
+	service service.PostStateService
+}
+
+// SetState godoc
+// @Tags post
+// @Summary SetState
+// @Description publish, unlist or archive a post of the author
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Param request body request.PostStateRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/state [put]
+func (controller PostStateController) SetState(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostStateController").WithField("method", "SetState")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding post state request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var stateRequest request.PostStateRequest
+	if err := ctx.ShouldBindBodyWith(&stateRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding post state request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	postID, _ := uuid.Parse(postRequest.PostUID)
+	stateErr := controller.service.SetState(ctx, postID, userUUID, stateRequest.State)
+	if stateErr != nil {
+		logger.Errorf("Error occurred while moving post %v to state %v .%v", postID, stateRequest.State, stateErr)
+		constants.RespondWithGolaError(ctx, stateErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// RestorePost godoc
+// @Tags post
+// @Summary RestorePost
+// @Description restore a deleted post of the author within the retention
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/restore [put]
+func (controller PostStateController) RestorePost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostStateController").WithField("method", "RestorePost")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding restore post request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	postID, _ := uuid.Parse(postRequest.PostUID)
+	restoreErr := controller.service.RestorePost(ctx, postID, userUUID)
+	if restoreErr != nil {
+		logger.Errorf("Error occurred while restoring post %v .%v", postID, restoreErr)
+		constants.RespondWithGolaError(ctx, restoreErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// GetPosts godoc
+// @Tags post
+// @Summary GetPosts
+// @Description list the posts of the author in a state, deleted posts with the time they can be restored until
+// @Accept json
+// @Param state query string true "published, unlisted, archived or deleted"
+// @Success 200 {object} []db.AuthorPost
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/mine [get]
+func (controller PostStateController) GetPosts(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostStateController").WithField("method", "GetPosts")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var stateRequest request.PostsByStateRequest
+	if err := ctx.ShouldBindQuery(&stateRequest); err != nil {
+		logger.Errorf("Error occurred while binding posts by state request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	posts, fetchErr := controller.service.GetPosts(ctx, userUUID, stateRequest.State)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching %v posts of user %v .%v", stateRequest.State, userUUID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, posts)
+}
+
+func NewPostStateController(stateService service.PostStateService) PostStateController {
+	return PostStateController{service: stateService}
+}
@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type ProfilePinController struct {
+	service service.ProfilePinService
+}
+
+// SetPins godoc
+// @Tags post
+// @Summary SetPins
+// @Description replace the posts pinned to the profile of the author, in the order they are shown
+// @Accept json
+// @Param request body request.PinPostsRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 422 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/pins [put]
+func (controller ProfilePinController) SetPins(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinController").WithField("method", "SetPins")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var pinRequest request.PinPostsRequest
+	if err := ctx.ShouldBindBodyWith(&pinRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding pin posts request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	pinErr := controller.service.SetPins(ctx, userUUID, pinRequest.PostIDs)
+	if pinErr != nil {
+		logger.Errorf("Error occurred while pinning posts of user %v .%v", userUUID, pinErr)
+		constants.RespondWithGolaError(ctx, pinErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// PinPost godoc
+// @Tags post
+// @Summary PinPost
+// @Description pin a published post of the author below the posts already pinned to the profile
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 422 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/pin [put]
+func (controller ProfilePinController) PinPost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinController").WithField("method", "PinPost")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding pin post request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	postID, _ := uuid.Parse(postRequest.PostUID)
+	pinErr := controller.service.PinPost(ctx, postID, userUUID)
+	if pinErr != nil {
+		logger.Errorf("Error occurred while pinning post %v .%v", postID, pinErr)
+		constants.RespondWithGolaError(ctx, pinErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// UnpinPost godoc
+// @Tags post
+// @Summary UnpinPost
+// @Description remove a post from the posts pinned to the profile of the author
+// @Accept json
+// @Param post_id path string true "Post ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/post/:post_id/pin [delete]
+func (controller ProfilePinController) UnpinPost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinController").WithField("method", "UnpinPost")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var postRequest request.PostURIRequest
+	if err := ctx.ShouldBindUri(&postRequest); err != nil {
+		logger.Errorf("Error occurred while binding unpin post request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	postID, _ := uuid.Parse(postRequest.PostUID)
+	unpinErr := controller.service.UnpinPost(ctx, postID, userUUID)
+	if unpinErr != nil {
+		logger.Errorf("Error occurred while unpinning post %v .%v", postID, unpinErr)
+		constants.RespondWithGolaError(ctx, unpinErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func NewProfilePinController(pinService service.ProfilePinService) ProfilePinController {
+	return ProfilePinController{service: pinService}
+}
@@ -0,0 +1,447 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/models"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type PublicationController struct {
+	service service.PublicationService
+}
+
+// CreatePublication godoc
+// @Tags publication
+// @Summary CreatePublication
+// @Description create a publication owned by the user
+// @Accept json
+// @Param request body request.CreatePublicationRequest true "Request Body"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication [post]
+func (controller PublicationController) CreatePublication(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "CreatePublication")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var publicationRequest request.CreatePublicationRequest
+	if err := ctx.ShouldBindBodyWith(&publicationRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding create publication request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+	publicationRequest.OwnerID = userUUID
+
+	publicationID, createErr := controller.service.CreatePublication(ctx, publicationRequest)
+	if createErr != nil {
+		logger.Errorf("Error occurred while creating publication for user %v .%v", userUUID, createErr)
+		constants.RespondWithGolaError(ctx, createErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"publication_id": publicationID,
+	})
+}
+
+// GetPublication godoc
+// @Tags publication
+// @Summary GetPublication
+// @Description get the publication profile with its members
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Success 200 {object} response.Publication
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id [get]
+func (controller PublicationController) GetPublication(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "GetPublication")
+
+	var uriRequest request.PublicationURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding get publication request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	publication, fetchErr := controller.service.GetPublication(ctx, publicationID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching publication %v .%v", publicationID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, publication)
+}
+
+// GetPublicationPosts godoc
+// @Tags publication
+// @Summary GetPublicationPosts
+// @Description get the posts published under a publication, newest first
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Param start query int false "Start"
+// @Param limit query int false "Limit"
+// @Success 200 {object} []response.PublishedPost
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/posts [get]
+func (controller PublicationController) GetPublicationPosts(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "GetPublicationPosts")
+
+	var uriRequest request.PublicationURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding get publication posts request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var pagination models.Pagination
+	if err := ctx.ShouldBindQuery(&pagination); err != nil {
+		logger.Errorf("Error occurred while binding pagination %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	posts, fetchErr := controller.service.GetPublicationPosts(ctx, publicationID, pagination.Limit, pagination.Start)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching posts of publication %v .%v", publicationID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, posts)
+}
+
+// InviteMember godoc
+// @Tags publication
+// @Summary InviteMember
+// @Description invite a user to the publication as an editor or writer
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Param request body request.InviteMemberRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 409 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/members [post]
+func (controller PublicationController) InviteMember(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "InviteMember")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.PublicationURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding invite member request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var inviteRequest request.InviteMemberRequest
+	if err := ctx.ShouldBindBodyWith(&inviteRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding invite member request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	inviteErr := controller.service.InviteMember(ctx, publicationID, userUUID, inviteRequest)
+	if inviteErr != nil {
+		logger.Errorf("Error occurred while inviting user %v to publication %v .%v", inviteRequest.UserID, publicationID, inviteErr)
+		constants.RespondWithGolaError(ctx, inviteErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// AcceptInvite godoc
+// @Tags publication
+// @Summary AcceptInvite
+// @Description accept a pending invite to the publication
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/members/accept [put]
+func (controller PublicationController) AcceptInvite(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "AcceptInvite")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.PublicationURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding accept invite request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	acceptErr := controller.service.AcceptInvite(ctx, publicationID, userUUID)
+	if acceptErr != nil {
+		logger.Errorf("Error occurred while accepting invite to publication %v .%v", publicationID, acceptErr)
+		constants.RespondWithGolaError(ctx, acceptErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// SubmitDraft godoc
+// @Tags publication
+// @Summary SubmitDraft
+// @Description submit a draft for review by the publication editors
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Param request body request.SubmitDraftRequest true "Request Body"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 409 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/submissions [post]
+func (controller PublicationController) SubmitDraft(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "SubmitDraft")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.PublicationURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding submit draft request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var submitRequest request.SubmitDraftRequest
+	if err := ctx.ShouldBindBodyWith(&submitRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding submit draft request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	submissionID, submitErr := controller.service.SubmitDraft(ctx, publicationID, userUUID, submitRequest.DraftID)
+	if submitErr != nil {
+		logger.Errorf("Error occurred while submitting draft %v to publication %v .%v", submitRequest.DraftID, publicationID, submitErr)
+		constants.RespondWithGolaError(ctx, submitErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"submission_id": submissionID,
+	})
+}
+
+// GetSubmissions godoc
+// @Tags publication
+// @Summary GetSubmissions
+// @Description get the submissions of a publication for its editors, pending ones by default
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Param status query string false "Status"
+// @Success 200 {object} []db.PublicationSubmission
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/submissions [get]
+func (controller PublicationController) GetSubmissions(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "GetSubmissions")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.PublicationURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding get submissions request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var submissionsRequest request.SubmissionsRequest
+	if err := ctx.ShouldBindQuery(&submissionsRequest); err != nil {
+		logger.Errorf("Error occurred while binding get submissions query %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	submissions, fetchErr := controller.service.GetSubmissions(ctx, publicationID, userUUID, submissionsRequest.Status)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching submissions of publication %v .%v", publicationID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, submissions)
+}
+
+// GetMySubmissions godoc
+// @Tags publication
+// @Summary GetMySubmissions
+// @Description get the submissions of the user across publications with the editor feedback
+// @Accept json
+// @Success 200 {object} []db.PublicationSubmission
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/submissions [get]
+func (controller PublicationController) GetMySubmissions(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "GetMySubmissions")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+	submissions, fetchErr := controller.service.GetSubmissionsByAuthor(ctx, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching submissions of user %v .%v", userUUID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, submissions)
+}
+
+// GetSubmissionDraft godoc
+// @Tags publication
+// @Summary GetSubmissionDraft
+// @Description get the draft of a submission for the publication editors to review
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Param submission_id path string true "Submission ID"
+// @Success 200 {object} db.Draft
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/submissions/:submission_id/draft [get]
+func (controller PublicationController) GetSubmissionDraft(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "GetSubmissionDraft")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.SubmissionURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding get submission draft request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	submissionID, _ := uuid.Parse(uriRequest.SubmissionID)
+	draft, fetchErr := controller.service.GetSubmissionDraft(ctx, publicationID, submissionID, userUUID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching draft of submission %v .%v", submissionID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// ReviewSubmission godoc
+// @Tags publication
+// @Summary ReviewSubmission
+// @Description approve, request changes on or reject a pending submission
+// @Accept json
+// @Param publication_id path string true "Publication ID"
+// @Param submission_id path string true "Submission ID"
+// @Param request body request.ReviewSubmissionRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 403 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 409 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/publication/:publication_id/submissions/:submission_id [put]
+func (controller PublicationController) ReviewSubmission(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationController").WithField("method", "ReviewSubmission")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.SubmissionURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding review submission request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var reviewRequest request.ReviewSubmissionRequest
+	if err := ctx.ShouldBindBodyWith(&reviewRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding review submission request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	publicationID, _ := uuid.Parse(uriRequest.PublicationID)
+	submissionID, _ := uuid.Parse(uriRequest.SubmissionID)
+	reviewErr := controller.service.ReviewSubmission(ctx, publicationID, submissionID, userUUID, reviewRequest)
+	if reviewErr != nil {
+		logger.Errorf("Error occurred while reviewing submission %v .%v", submissionID, reviewErr)
+		constants.RespondWithGolaError(ctx, reviewErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func NewPublicationController(publicationService service.PublicationService) PublicationController {
+	return PublicationController{service: publicationService}
+}
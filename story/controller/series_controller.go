@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"net/http"
+	"post-api/story/constants"
+	"post-api/story/models/request"
+	"post-api/story/service"
+	"post-api/story/utils"
+)
+
+type SeriesController struct {
+	service service.SeriesService
+}
+
+// CreateSeries godoc
+// @Tags series
+// @Summary CreateSeries
+// @Description create a named series for the author
+// @Accept json
+// @Param request body request.CreateSeriesRequest true "Request Body"
+// @Success 201
+// @Failure 400 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/series [post]
+func (controller SeriesController) CreateSeries(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesController").WithField("method", "CreateSeries")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var seriesRequest request.CreateSeriesRequest
+	if err := ctx.ShouldBindBodyWith(&seriesRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding create series request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+	seriesRequest.AuthorID = userUUID
+
+	seriesID, createErr := controller.service.CreateSeries(ctx, seriesRequest)
+	if createErr != nil {
+		logger.Errorf("Error occurred while creating series for user %v .%v", userUUID, createErr)
+		constants.RespondWithGolaError(ctx, createErr)
+		return
+	}
+
+	logger.Infof("Successfully created series %v for user %v", seriesID, userUUID)
+	ctx.JSON(http.StatusCreated, gin.H{
+		"series_id": seriesID,
+	})
+}
+
+// GetSeries godoc
+// @Tags series
+// @Summary GetSeries
+// @Description get a series with its posts in order
+// @Accept json
+// @Param series_id path string true "Series ID"
+// @Success 200 {object} response.Series
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/series/:series_id [get]
+func (controller SeriesController) GetSeries(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesController").WithField("method", "GetSeries")
+
+	var uriRequest request.SeriesURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding get series request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	seriesID, _ := uuid.Parse(uriRequest.SeriesID)
+	series, fetchErr := controller.service.GetSeries(ctx, seriesID)
+	if fetchErr != nil {
+		logger.Errorf("Error occurred while fetching series %v .%v", seriesID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, series)
+}
+
+// AddPost godoc
+// @Tags series
+// @Summary AddPost
+// @Description add a published post to the end of a series
+// @Accept json
+// @Param series_id path string true "Series ID"
+// @Param request body request.AddSeriesPostRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 409 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/series/:series_id/posts [post]
+func (controller SeriesController) AddPost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesController").WithField("method", "AddPost")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.SeriesURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding add series post request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var postRequest request.AddSeriesPostRequest
+	if err := ctx.ShouldBindBodyWith(&postRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding add series post request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	seriesID, _ := uuid.Parse(uriRequest.SeriesID)
+	addErr := controller.service.AddPost(ctx, seriesID, postRequest.PostID, userUUID)
+	if addErr != nil {
+		logger.Errorf("Error occurred while adding post %v to series %v .%v", postRequest.PostID, seriesID, addErr)
+		constants.RespondWithGolaError(ctx, addErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// RemovePost godoc
+// @Tags series
+// @Summary RemovePost
+// @Description remove a post from a series
+// @Accept json
+// @Param series_id path string true "Series ID"
+// @Param post_id path string true "Post ID"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/series/:series_id/posts/:post_id [delete]
+func (controller SeriesController) RemovePost(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesController").WithField("method", "RemovePost")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.SeriesPostURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding remove series post request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	seriesID, _ := uuid.Parse(uriRequest.SeriesID)
+	postID, _ := uuid.Parse(uriRequest.PostID)
+	removeErr := controller.service.RemovePost(ctx, seriesID, postID, userUUID)
+	if removeErr != nil {
+		logger.Errorf("Error occurred while removing post %v from series %v .%v", postID, seriesID, removeErr)
+		constants.RespondWithGolaError(ctx, removeErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// ReorderPosts godoc
+// @Tags series
+// @Summary ReorderPosts
+// @Description set the order of the posts in a series
+// @Accept json
+// @Param series_id path string true "Series ID"
+// @Param request body request.ReorderSeriesRequest true "Request Body"
+// @Success 200
+// @Failure 400 {object} golaerror.Error
+// @Failure 404 {object} golaerror.Error
+// @Failure 500 {object} golaerror.Error
+// @Router /api/post/v1/series/:series_id/order [put]
+func (controller SeriesController) ReorderPosts(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesController").WithField("method", "ReorderPosts")
+	token, err := utils.GetIDToken(ctx)
+	if err != nil {
+		logger.Error("id token not found", err)
+		ctx.JSON(http.StatusInternalServerError, constants.InternalServerError)
+		return
+	}
+
+	userUUID, _ := uuid.Parse(token.UserId)
+
+	var uriRequest request.SeriesURIRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		logger.Errorf("Error occurred while binding reorder series request %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	var reorderRequest request.ReorderSeriesRequest
+	if err := ctx.ShouldBindBodyWith(&reorderRequest, binding.JSON); err != nil {
+		logger.Errorf("Error occurred while binding reorder series request body %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	seriesID, _ := uuid.Parse(uriRequest.SeriesID)
+	reorderErr := controller.service.ReorderPosts(ctx, seriesID, userUUID, reorderRequest.PostIDs)
+	if reorderErr != nil {
+		logger.Errorf("Error occurred while reordering series %v .%v", seriesID, reorderErr)
+		constants.RespondWithGolaError(ctx, reorderErr)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func NewSeriesController(seriesService service.SeriesService) SeriesController {
+	return SeriesController{service: seriesService}
+}
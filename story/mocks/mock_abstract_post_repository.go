@@ -37,6 +37,36 @@ func (m *MockAbstractPostRepository) EXPECT() *MockAbstractPostRepositoryMockRec
 	return m.recorder
 }
 
+// AddURL mocks base method.
+func (m *MockAbstractPostRepository) AddURL(ctx context.Context, txn helper.Transaction, postID uuid.UUID, url string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddURL", ctx, txn, postID, url)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddURL indicates an expected call of AddURL.
+func (mr *MockAbstractPostRepositoryMockRecorder) AddURL(ctx, txn, postID, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddURL", reflect.TypeOf((*MockAbstractPostRepository)(nil).AddURL), ctx, txn, postID, url)
+}
+
+// ResolveURL mocks base method.
+func (m *MockAbstractPostRepository) ResolveURL(ctx context.Context, urls []string, userID uuid.UUID) (uuid.UUID, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveURL", ctx, urls, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveURL indicates an expected call of ResolveURL.
+func (mr *MockAbstractPostRepositoryMockRecorder) ResolveURL(ctx, urls, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveURL", reflect.TypeOf((*MockAbstractPostRepository)(nil).ResolveURL), ctx, urls, userID)
+}
+
 // Save mocks base method.
 func (m *MockAbstractPostRepository) Save(ctx context.Context, txn helper.Transaction, post db.AbstractPost) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -51,3 +81,47 @@ func (mr *MockAbstractPostRepositoryMockRecorder) Save(ctx, txn, post interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAbstractPostRepository)(nil).Save), ctx, txn, post)
 }
+
+// SetCustomSlug mocks base method.
+func (m *MockAbstractPostRepository) SetCustomSlug(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, slug string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomSlug", ctx, txn, postID, userID, slug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCustomSlug indicates an expected call of SetCustomSlug.
+func (mr *MockAbstractPostRepositoryMockRecorder) SetCustomSlug(ctx, txn, postID, userID, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomSlug", reflect.TypeOf((*MockAbstractPostRepository)(nil).SetCustomSlug), ctx, txn, postID, userID, slug)
+}
+
+// Update mocks base method.
+func (m *MockAbstractPostRepository) Update(ctx context.Context, txn helper.Transaction, post db.AbstractPost) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, txn, post)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAbstractPostRepositoryMockRecorder) Update(ctx, txn, post interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAbstractPostRepository)(nil).Update), ctx, txn, post)
+}
+
+// UpdateGeneratedURL mocks base method.
+func (m *MockAbstractPostRepository) UpdateGeneratedURL(ctx context.Context, txn helper.Transaction, postID uuid.UUID, url string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGeneratedURL", ctx, txn, postID, url)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGeneratedURL indicates an expected call of UpdateGeneratedURL.
+func (mr *MockAbstractPostRepositoryMockRecorder) UpdateGeneratedURL(ctx, txn, postID, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGeneratedURL", reflect.TypeOf((*MockAbstractPostRepository)(nil).UpdateGeneratedURL), ctx, txn, postID, url)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: aws_services.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "post-api/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAwsServices is a mock of AwsServices interface.
+type MockAwsServices struct {
+	ctrl     *gomock.Controller
+	recorder *MockAwsServicesMockRecorder
+}
+
+// MockAwsServicesMockRecorder is the mock recorder for MockAwsServices.
+type MockAwsServicesMockRecorder struct {
+	mock *MockAwsServices
+}
+
+// NewMockAwsServices creates a new mock instance.
+func NewMockAwsServices(ctrl *gomock.Controller) *MockAwsServices {
+	mock := &MockAwsServices{ctrl: ctrl}
+	mock.recorder = &MockAwsServicesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAwsServices) EXPECT() *MockAwsServicesMockRecorder {
+	return m.recorder
+}
+
+// CheckS3Object mocks base method.
+func (m *MockAwsServices) CheckS3Object(key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckS3Object", key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckS3Object indicates an expected call of CheckS3Object.
+func (mr *MockAwsServicesMockRecorder) CheckS3Object(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckS3Object", reflect.TypeOf((*MockAwsServices)(nil).CheckS3Object), key)
+}
+
+// DeleteObjectInS3 mocks base method.
+func (m *MockAwsServices) DeleteObjectInS3(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectInS3", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectInS3 indicates an expected call of DeleteObjectInS3.
+func (mr *MockAwsServicesMockRecorder) DeleteObjectInS3(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectInS3", reflect.TypeOf((*MockAwsServices)(nil).DeleteObjectInS3), key)
+}
+
+// GetObjectInS3 mocks base method.
+func (m *MockAwsServices) GetObjectInS3(key string, expiryTime time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectInS3", key, expiryTime)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectInS3 indicates an expected call of GetObjectInS3.
+func (mr *MockAwsServicesMockRecorder) GetObjectInS3(key, expiryTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectInS3", reflect.TypeOf((*MockAwsServices)(nil).GetObjectInS3), key, expiryTime)
+}
+
+// ListObjectsInS3 mocks base method.
+func (m *MockAwsServices) ListObjectsInS3(prefix string) ([]models.S3Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectsInS3", prefix)
+	ret0, _ := ret[0].([]models.S3Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectsInS3 indicates an expected call of ListObjectsInS3.
+func (mr *MockAwsServicesMockRecorder) ListObjectsInS3(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectsInS3", reflect.TypeOf((*MockAwsServices)(nil).ListObjectsInS3), prefix)
+}
+
+// PutObjectInS3 mocks base method.
+func (m *MockAwsServices) PutObjectInS3(key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObjectInS3", key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObjectInS3 indicates an expected call of PutObjectInS3.
+func (mr *MockAwsServicesMockRecorder) PutObjectInS3(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObjectInS3", reflect.TypeOf((*MockAwsServices)(nil).PutObjectInS3), key)
+}
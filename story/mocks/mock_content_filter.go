@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_filter.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "post-api/story/models"
+	utils "post-api/story/utils"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockContentFilter is a mock of ContentFilter interface.
+type MockContentFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentFilterMockRecorder
+}
+
+// MockContentFilterMockRecorder is the mock recorder for MockContentFilter.
+type MockContentFilterMockRecorder struct {
+	mock *MockContentFilter
+}
+
+// NewMockContentFilter creates a new mock instance.
+func NewMockContentFilter(ctrl *gomock.Controller) *MockContentFilter {
+	mock := &MockContentFilter{ctrl: ctrl}
+	mock.recorder = &MockContentFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentFilter) EXPECT() *MockContentFilterMockRecorder {
+	return m.recorder
+}
+
+// FilterEditor mocks base method.
+func (m *MockContentFilter) FilterEditor(ctx context.Context, content models.JSONString) (models.JSONString, utils.FilterResult, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterEditor", ctx, content)
+	ret0, _ := ret[0].(models.JSONString)
+	ret1, _ := ret[1].(utils.FilterResult)
+	ret2, _ := ret[2].(*golaerror.Error)
+	return ret0, ret1, ret2
+}
+
+// FilterEditor indicates an expected call of FilterEditor.
+func (mr *MockContentFilterMockRecorder) FilterEditor(ctx, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterEditor", reflect.TypeOf((*MockContentFilter)(nil).FilterEditor), ctx, content)
+}
+
+// FilterText mocks base method.
+func (m *MockContentFilter) FilterText(ctx context.Context, text string) (string, utils.FilterResult) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterText", ctx, text)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(utils.FilterResult)
+	return ret0, ret1
+}
+
+// FilterText indicates an expected call of FilterText.
+func (mr *MockContentFilterMockRecorder) FilterText(ctx, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterText", reflect.TypeOf((*MockContentFilter)(nil).FilterText), ctx, text)
+}
@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_review_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	helper "post-api/helper"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockContentReviewRepository is a mock of ContentReviewRepository interface.
+type MockContentReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentReviewRepositoryMockRecorder
+}
+
+// MockContentReviewRepositoryMockRecorder is the mock recorder for MockContentReviewRepository.
+type MockContentReviewRepositoryMockRecorder struct {
+	mock *MockContentReviewRepository
+}
+
+// NewMockContentReviewRepository creates a new mock instance.
+func NewMockContentReviewRepository(ctrl *gomock.Controller) *MockContentReviewRepository {
+	mock := &MockContentReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockContentReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentReviewRepository) EXPECT() *MockContentReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPending mocks base method.
+func (m *MockContentReviewRepository) GetPending(ctx context.Context, limit, offset int) ([]db.ContentReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", ctx, limit, offset)
+	ret0, _ := ret[0].([]db.ContentReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending.
+func (mr *MockContentReviewRepositoryMockRecorder) GetPending(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockContentReviewRepository)(nil).GetPending), ctx, limit, offset)
+}
+
+// GetPostReviewStatus mocks base method.
+func (m *MockContentReviewRepository) GetPostReviewStatus(ctx context.Context, draftID uuid.UUID, version int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostReviewStatus", ctx, draftID, version)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostReviewStatus indicates an expected call of GetPostReviewStatus.
+func (mr *MockContentReviewRepositoryMockRecorder) GetPostReviewStatus(ctx, draftID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostReviewStatus", reflect.TypeOf((*MockContentReviewRepository)(nil).GetPostReviewStatus), ctx, draftID, version)
+}
+
+// Hold mocks base method.
+func (m *MockContentReviewRepository) Hold(ctx context.Context, txn helper.Transaction, review db.ContentReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, txn, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockContentReviewRepositoryMockRecorder) Hold(ctx, txn, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockContentReviewRepository)(nil).Hold), ctx, txn, review)
+}
+
+// IsModerator mocks base method.
+func (m *MockContentReviewRepository) IsModerator(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsModerator", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsModerator indicates an expected call of IsModerator.
+func (mr *MockContentReviewRepositoryMockRecorder) IsModerator(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsModerator", reflect.TypeOf((*MockContentReviewRepository)(nil).IsModerator), ctx, userID)
+}
+
+// ReleaseAbout mocks base method.
+func (m *MockContentReviewRepository) ReleaseAbout(ctx context.Context, txn helper.Transaction, userID uuid.UUID, about string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAbout", ctx, txn, userID, about)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseAbout indicates an expected call of ReleaseAbout.
+func (mr *MockContentReviewRepositoryMockRecorder) ReleaseAbout(ctx, txn, userID, about interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAbout", reflect.TypeOf((*MockContentReviewRepository)(nil).ReleaseAbout), ctx, txn, userID, about)
+}
+
+// ReleaseComment mocks base method.
+func (m *MockContentReviewRepository) ReleaseComment(ctx context.Context, txn helper.Transaction, commentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseComment", ctx, txn, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseComment indicates an expected call of ReleaseComment.
+func (mr *MockContentReviewRepositoryMockRecorder) ReleaseComment(ctx, txn, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseComment", reflect.TypeOf((*MockContentReviewRepository)(nil).ReleaseComment), ctx, txn, commentID)
+}
+
+// ReleasePost mocks base method.
+func (m *MockContentReviewRepository) ReleasePost(ctx context.Context, txn helper.Transaction, draftID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePost", ctx, txn, draftID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePost indicates an expected call of ReleasePost.
+func (mr *MockContentReviewRepositoryMockRecorder) ReleasePost(ctx, txn, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePost", reflect.TypeOf((*MockContentReviewRepository)(nil).ReleasePost), ctx, txn, draftID)
+}
+
+// Resolve mocks base method.
+func (m *MockContentReviewRepository) Resolve(ctx context.Context, txn helper.Transaction, reviewID, reviewerID uuid.UUID, status string) (db.ContentReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, txn, reviewID, reviewerID, status)
+	ret0, _ := ret[0].(db.ContentReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockContentReviewRepositoryMockRecorder) Resolve(ctx, txn, reviewID, reviewerID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockContentReviewRepository)(nil).Resolve), ctx, txn, reviewID, reviewerID, status)
+}
+
+// Save mocks base method.
+func (m *MockContentReviewRepository) Save(ctx context.Context, review db.ContentReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockContentReviewRepositoryMockRecorder) Save(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockContentReviewRepository)(nil).Save), ctx, review)
+}
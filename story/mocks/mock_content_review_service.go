@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_review_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockContentReviewService is a mock of ContentReviewService interface.
+type MockContentReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentReviewServiceMockRecorder
+}
+
+// MockContentReviewServiceMockRecorder is the mock recorder for MockContentReviewService.
+type MockContentReviewServiceMockRecorder struct {
+	mock *MockContentReviewService
+}
+
+// NewMockContentReviewService creates a new mock instance.
+func NewMockContentReviewService(ctrl *gomock.Controller) *MockContentReviewService {
+	mock := &MockContentReviewService{ctrl: ctrl}
+	mock.recorder = &MockContentReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentReviewService) EXPECT() *MockContentReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPendingReviews mocks base method.
+func (m *MockContentReviewService) GetPendingReviews(ctx context.Context, moderatorID uuid.UUID, limit, offset int) ([]db.ContentReview, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReviews", ctx, moderatorID, limit, offset)
+	ret0, _ := ret[0].([]db.ContentReview)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPendingReviews indicates an expected call of GetPendingReviews.
+func (mr *MockContentReviewServiceMockRecorder) GetPendingReviews(ctx, moderatorID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReviews", reflect.TypeOf((*MockContentReviewService)(nil).GetPendingReviews), ctx, moderatorID, limit, offset)
+}
+
+// ReviewContent mocks base method.
+func (m *MockContentReviewService) ReviewContent(ctx context.Context, reviewID, moderatorID uuid.UUID, decision string) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewContent", ctx, reviewID, moderatorID, decision)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// ReviewContent indicates an expected call of ReviewContent.
+func (mr *MockContentReviewServiceMockRecorder) ReviewContent(ctx, reviewID, moderatorID, decision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewContent", reflect.TypeOf((*MockContentReviewService)(nil).ReviewContent), ctx, reviewID, moderatorID, decision)
+}
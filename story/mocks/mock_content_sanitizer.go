@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_sanitizer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "post-api/story/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockContentSanitizer is a mock of ContentSanitizer interface.
+type MockContentSanitizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentSanitizerMockRecorder
+}
+
+// MockContentSanitizerMockRecorder is the mock recorder for MockContentSanitizer.
+type MockContentSanitizerMockRecorder struct {
+	mock *MockContentSanitizer
+}
+
+// NewMockContentSanitizer creates a new mock instance.
+func NewMockContentSanitizer(ctrl *gomock.Controller) *MockContentSanitizer {
+	mock := &MockContentSanitizer{ctrl: ctrl}
+	mock.recorder = &MockContentSanitizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentSanitizer) EXPECT() *MockContentSanitizerMockRecorder {
+	return m.recorder
+}
+
+// Sanitize mocks base method.
+func (m *MockContentSanitizer) Sanitize(ctx context.Context, content models.JSONString) (models.JSONString, []models.SanitizeReport, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sanitize", ctx, content)
+	ret0, _ := ret[0].(models.JSONString)
+	ret1, _ := ret[1].([]models.SanitizeReport)
+	ret2, _ := ret[2].(*golaerror.Error)
+	return ret0, ret1, ret2
+}
+
+// Sanitize indicates an expected call of Sanitize.
+func (mr *MockContentSanitizerMockRecorder) Sanitize(ctx, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sanitize", reflect.TypeOf((*MockContentSanitizer)(nil).Sanitize), ctx, content)
+}
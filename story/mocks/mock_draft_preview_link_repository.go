@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft_preview_link_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockDraftPreviewLinkRepository is a mock of DraftPreviewLinkRepository interface.
+type MockDraftPreviewLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftPreviewLinkRepositoryMockRecorder
+}
+
+// MockDraftPreviewLinkRepositoryMockRecorder is the mock recorder for MockDraftPreviewLinkRepository.
+type MockDraftPreviewLinkRepositoryMockRecorder struct {
+	mock *MockDraftPreviewLinkRepository
+}
+
+// NewMockDraftPreviewLinkRepository creates a new mock instance.
+func NewMockDraftPreviewLinkRepository(ctrl *gomock.Controller) *MockDraftPreviewLinkRepository {
+	mock := &MockDraftPreviewLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockDraftPreviewLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftPreviewLinkRepository) EXPECT() *MockDraftPreviewLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDraftPreviewLinkRepository) Create(ctx context.Context, linkID, draftID, userID uuid.UUID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, linkID, draftID, userID, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDraftPreviewLinkRepositoryMockRecorder) Create(ctx, linkID, draftID, userID, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDraftPreviewLinkRepository)(nil).Create), ctx, linkID, draftID, userID, expiresAt)
+}
+
+// GetActiveLinks mocks base method.
+func (m *MockDraftPreviewLinkRepository) GetActiveLinks(ctx context.Context, draftID, userID uuid.UUID) ([]db.DraftPreviewLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveLinks", ctx, draftID, userID)
+	ret0, _ := ret[0].([]db.DraftPreviewLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveLinks indicates an expected call of GetActiveLinks.
+func (mr *MockDraftPreviewLinkRepositoryMockRecorder) GetActiveLinks(ctx, draftID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveLinks", reflect.TypeOf((*MockDraftPreviewLinkRepository)(nil).GetActiveLinks), ctx, draftID, userID)
+}
+
+// Open mocks base method.
+func (m *MockDraftPreviewLinkRepository) Open(ctx context.Context, linkID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, linkID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockDraftPreviewLinkRepositoryMockRecorder) Open(ctx, linkID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockDraftPreviewLinkRepository)(nil).Open), ctx, linkID)
+}
+
+// Revoke mocks base method.
+func (m *MockDraftPreviewLinkRepository) Revoke(ctx context.Context, linkID, draftID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, linkID, draftID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockDraftPreviewLinkRepositoryMockRecorder) Revoke(ctx, linkID, draftID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockDraftPreviewLinkRepository)(nil).Revoke), ctx, linkID, draftID, userID)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft_preview_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	response "post-api/story/models/response"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockDraftPreviewService is a mock of DraftPreviewService interface.
+type MockDraftPreviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftPreviewServiceMockRecorder
+}
+
+// MockDraftPreviewServiceMockRecorder is the mock recorder for MockDraftPreviewService.
+type MockDraftPreviewServiceMockRecorder struct {
+	mock *MockDraftPreviewService
+}
+
+// NewMockDraftPreviewService creates a new mock instance.
+func NewMockDraftPreviewService(ctrl *gomock.Controller) *MockDraftPreviewService {
+	mock := &MockDraftPreviewService{ctrl: ctrl}
+	mock.recorder = &MockDraftPreviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftPreviewService) EXPECT() *MockDraftPreviewServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLink mocks base method.
+func (m *MockDraftPreviewService) CreateLink(ctx context.Context, draftID, userID uuid.UUID, expiresInHours int) (response.DraftPreviewLink, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLink", ctx, draftID, userID, expiresInHours)
+	ret0, _ := ret[0].(response.DraftPreviewLink)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// CreateLink indicates an expected call of CreateLink.
+func (mr *MockDraftPreviewServiceMockRecorder) CreateLink(ctx, draftID, userID, expiresInHours interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLink", reflect.TypeOf((*MockDraftPreviewService)(nil).CreateLink), ctx, draftID, userID, expiresInHours)
+}
+
+// GetLinks mocks base method.
+func (m *MockDraftPreviewService) GetLinks(ctx context.Context, draftID, userID uuid.UUID) ([]db.DraftPreviewLink, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinks", ctx, draftID, userID)
+	ret0, _ := ret[0].([]db.DraftPreviewLink)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetLinks indicates an expected call of GetLinks.
+func (mr *MockDraftPreviewServiceMockRecorder) GetLinks(ctx, draftID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinks", reflect.TypeOf((*MockDraftPreviewService)(nil).GetLinks), ctx, draftID, userID)
+}
+
+// OpenLink mocks base method.
+func (m *MockDraftPreviewService) OpenLink(ctx context.Context, token string) (response.SharedDraft, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenLink", ctx, token)
+	ret0, _ := ret[0].(response.SharedDraft)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// OpenLink indicates an expected call of OpenLink.
+func (mr *MockDraftPreviewServiceMockRecorder) OpenLink(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenLink", reflect.TypeOf((*MockDraftPreviewService)(nil).OpenLink), ctx, token)
+}
+
+// RevokeLink mocks base method.
+func (m *MockDraftPreviewService) RevokeLink(ctx context.Context, draftID, linkID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeLink", ctx, draftID, linkID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// RevokeLink indicates an expected call of RevokeLink.
+func (mr *MockDraftPreviewServiceMockRecorder) RevokeLink(ctx, draftID, linkID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeLink", reflect.TypeOf((*MockDraftPreviewService)(nil).RevokeLink), ctx, draftID, linkID, userID)
+}
@@ -6,10 +6,12 @@ package mocks
 
 import (
 	context "context"
+	helper "post-api/helper"
 	models "post-api/story/models"
 	db "post-api/story/models/db"
 	request "post-api/story/models/request"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -38,19 +40,92 @@ func (m *MockDraftRepository) EXPECT() *MockDraftRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddImage mocks base method.
+func (m *MockDraftRepository) AddImage(ctx context.Context, txn helper.Transaction, saveRequest request.PreviewImageSaveRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImage", ctx, txn, saveRequest)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddImage indicates an expected call of AddImage.
+func (mr *MockDraftRepositoryMockRecorder) AddImage(ctx, txn, saveRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImage", reflect.TypeOf((*MockDraftRepository)(nil).AddImage), ctx, txn, saveRequest)
+}
+
+// CancelScheduledPublish mocks base method.
+func (m *MockDraftRepository) CancelScheduledPublish(ctx context.Context, draftID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledPublish", ctx, draftID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledPublish indicates an expected call of CancelScheduledPublish.
+func (mr *MockDraftRepositoryMockRecorder) CancelScheduledPublish(ctx, draftID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledPublish", reflect.TypeOf((*MockDraftRepository)(nil).CancelScheduledPublish), ctx, draftID, userID)
+}
+
+// ClaimScheduledDraft mocks base method.
+func (m *MockDraftRepository) ClaimScheduledDraft(ctx context.Context, draftID uuid.UUID, lease time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimScheduledDraft", ctx, draftID, lease)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimScheduledDraft indicates an expected call of ClaimScheduledDraft.
+func (mr *MockDraftRepositoryMockRecorder) ClaimScheduledDraft(ctx, draftID, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimScheduledDraft", reflect.TypeOf((*MockDraftRepository)(nil).ClaimScheduledDraft), ctx, draftID, lease)
+}
+
+// CopyDraft mocks base method.
+func (m *MockDraftRepository) CopyDraft(ctx context.Context, draftUID, userID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyDraft", ctx, draftUID, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyDraft indicates an expected call of CopyDraft.
+func (mr *MockDraftRepositoryMockRecorder) CopyDraft(ctx, draftUID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyDraft", reflect.TypeOf((*MockDraftRepository)(nil).CopyDraft), ctx, draftUID, userID)
+}
+
 // CreateDraft mocks base method.
-func (m *MockDraftRepository) CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, error) {
+func (m *MockDraftRepository) CreateDraft(ctx context.Context, txn helper.Transaction, draft models.CreateDraft) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDraft", ctx, draft)
+	ret := m.ctrl.Call(m, "CreateDraft", ctx, txn, draft)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateDraft indicates an expected call of CreateDraft.
-func (mr *MockDraftRepositoryMockRecorder) CreateDraft(ctx, draft interface{}) *gomock.Call {
+func (mr *MockDraftRepositoryMockRecorder) CreateDraft(ctx, txn, draft interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDraft", reflect.TypeOf((*MockDraftRepository)(nil).CreateDraft), ctx, draft)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDraft", reflect.TypeOf((*MockDraftRepository)(nil).CreateDraft), ctx, txn, draft)
+}
+
+// DelayPublish mocks base method.
+func (m *MockDraftRepository) DelayPublish(ctx context.Context, draftID uuid.UUID, delay time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelayPublish", ctx, draftID, delay)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelayPublish indicates an expected call of DelayPublish.
+func (mr *MockDraftRepositoryMockRecorder) DelayPublish(ctx, draftID, delay interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelayPublish", reflect.TypeOf((*MockDraftRepository)(nil).DelayPublish), ctx, draftID, delay)
 }
 
 // DeleteDraft mocks base method.
@@ -83,7 +158,22 @@ func (mr *MockDraftRepositoryMockRecorder) GetAllDraft(ctx, allDraftReq interfac
 }
 
 // GetDraft mocks base method.
-func (m *MockDraftRepository) GetDraft(ctx context.Context, draftUID, userID uuid.UUID) (db.Draft, error) {
+func (m *MockDraftRepository) GetDraft(ctx context.Context, draftID uuid.UUID) (*db.Draft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraft", ctx, draftID)
+	ret0, _ := ret[0].(*db.Draft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraft indicates an expected call of GetDraft.
+func (mr *MockDraftRepositoryMockRecorder) GetDraft(ctx, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraft", reflect.TypeOf((*MockDraftRepository)(nil).GetDraft), ctx, draftID)
+}
+
+// GetDraftByUser mocks base method.
+func (m *MockDraftRepository) GetDraftByUser(ctx context.Context, draftUID, userID uuid.UUID) (db.Draft, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDraftByUser", ctx, draftUID, userID)
 	ret0, _ := ret[0].(db.Draft)
@@ -91,20 +181,153 @@ func (m *MockDraftRepository) GetDraft(ctx context.Context, draftUID, userID uui
 	return ret0, ret1
 }
 
-// GetDraft indicates an expected call of GetDraft.
-func (mr *MockDraftRepositoryMockRecorder) GetDraft(ctx, draftUID, userID interface{}) *gomock.Call {
+// GetDraftByUser indicates an expected call of GetDraftByUser.
+func (mr *MockDraftRepositoryMockRecorder) GetDraftByUser(ctx, draftUID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftByUser", reflect.TypeOf((*MockDraftRepository)(nil).GetDraft), ctx, draftUID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftByUser", reflect.TypeOf((*MockDraftRepository)(nil).GetDraftByUser), ctx, draftUID, userID)
 }
 
-// SaveInterestsToDraft mocks base method.
-func (m *MockDraftRepository) SaveInterestsToDraft(interestsSaveRequest request.InterestsSaveRequest, ctx context.Context) error {
+// GetDraftImage mocks base method.
+func (m *MockDraftRepository) GetDraftImage(ctx context.Context, draftID, imageID uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveInterestsToDraft", interestsSaveRequest, ctx)
+	ret := m.ctrl.Call(m, "GetDraftImage", ctx, draftID, imageID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraftImage indicates an expected call of GetDraftImage.
+func (mr *MockDraftRepositoryMockRecorder) GetDraftImage(ctx, draftID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftImage", reflect.TypeOf((*MockDraftRepository)(nil).GetDraftImage), ctx, draftID, imageID)
+}
+
+// GetDueScheduledDrafts mocks base method.
+func (m *MockDraftRepository) GetDueScheduledDrafts(ctx context.Context, limit int) ([]db.ScheduledDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueScheduledDrafts", ctx, limit)
+	ret0, _ := ret[0].([]db.ScheduledDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueScheduledDrafts indicates an expected call of GetDueScheduledDrafts.
+func (mr *MockDraftRepositoryMockRecorder) GetDueScheduledDrafts(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueScheduledDrafts", reflect.TypeOf((*MockDraftRepository)(nil).GetDueScheduledDrafts), ctx, limit)
+}
+
+// GetExpiredTrashedDrafts mocks base method.
+func (m *MockDraftRepository) GetExpiredTrashedDrafts(ctx context.Context, retention time.Duration, maxAttempts, limit int) ([]db.TrashedDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredTrashedDrafts", ctx, retention, maxAttempts, limit)
+	ret0, _ := ret[0].([]db.TrashedDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredTrashedDrafts indicates an expected call of GetExpiredTrashedDrafts.
+func (mr *MockDraftRepositoryMockRecorder) GetExpiredTrashedDrafts(ctx, retention, maxAttempts, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredTrashedDrafts", reflect.TypeOf((*MockDraftRepository)(nil).GetExpiredTrashedDrafts), ctx, retention, maxAttempts, limit)
+}
+
+// GetScheduledDrafts mocks base method.
+func (m *MockDraftRepository) GetScheduledDrafts(ctx context.Context, userID uuid.UUID) ([]db.ScheduledDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledDrafts", ctx, userID)
+	ret0, _ := ret[0].([]db.ScheduledDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledDrafts indicates an expected call of GetScheduledDrafts.
+func (mr *MockDraftRepositoryMockRecorder) GetScheduledDrafts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledDrafts", reflect.TypeOf((*MockDraftRepository)(nil).GetScheduledDrafts), ctx, userID)
+}
+
+// GetTrashedDrafts mocks base method.
+func (m *MockDraftRepository) GetTrashedDrafts(ctx context.Context, userID uuid.UUID, retention time.Duration) ([]db.TrashedDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedDrafts", ctx, userID, retention)
+	ret0, _ := ret[0].([]db.TrashedDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedDrafts indicates an expected call of GetTrashedDrafts.
+func (mr *MockDraftRepositoryMockRecorder) GetTrashedDrafts(ctx, userID, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedDrafts", reflect.TypeOf((*MockDraftRepository)(nil).GetTrashedDrafts), ctx, userID, retention)
+}
+
+// GetUnsharedImageKeys mocks base method.
+func (m *MockDraftRepository) GetUnsharedImageKeys(ctx context.Context, draftID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsharedImageKeys", ctx, draftID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsharedImageKeys indicates an expected call of GetUnsharedImageKeys.
+func (mr *MockDraftRepositoryMockRecorder) GetUnsharedImageKeys(ctx, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsharedImageKeys", reflect.TypeOf((*MockDraftRepository)(nil).GetUnsharedImageKeys), ctx, draftID)
+}
+
+// PurgeDraft mocks base method.
+func (m *MockDraftRepository) PurgeDraft(ctx context.Context, txn helper.Transaction, draftID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDraft", ctx, txn, draftID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// PurgeDraft indicates an expected call of PurgeDraft.
+func (mr *MockDraftRepositoryMockRecorder) PurgeDraft(ctx, txn, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDraft", reflect.TypeOf((*MockDraftRepository)(nil).PurgeDraft), ctx, txn, draftID)
+}
+
+// RecordPurgeFailure mocks base method.
+func (m *MockDraftRepository) RecordPurgeFailure(ctx context.Context, draftID uuid.UUID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPurgeFailure", ctx, draftID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPurgeFailure indicates an expected call of RecordPurgeFailure.
+func (mr *MockDraftRepositoryMockRecorder) RecordPurgeFailure(ctx, draftID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPurgeFailure", reflect.TypeOf((*MockDraftRepository)(nil).RecordPurgeFailure), ctx, draftID, reason)
+}
+
+// RestoreDraft mocks base method.
+func (m *MockDraftRepository) RestoreDraft(ctx context.Context, draftID, userID uuid.UUID, retention time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDraft", ctx, draftID, userID, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDraft indicates an expected call of RestoreDraft.
+func (mr *MockDraftRepositoryMockRecorder) RestoreDraft(ctx, draftID, userID, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDraft", reflect.TypeOf((*MockDraftRepository)(nil).RestoreDraft), ctx, draftID, userID, retention)
+}
+
+// SaveInterestsToDraft mocks base method.
+func (m *MockDraftRepository) SaveInterestsToDraft(interestsSaveRequest request.InterestsSaveRequest, ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveInterestsToDraft", interestsSaveRequest, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // SaveInterestsToDraft indicates an expected call of SaveInterestsToDraft.
 func (mr *MockDraftRepositoryMockRecorder) SaveInterestsToDraft(interestsSaveRequest, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -112,25 +335,27 @@ func (mr *MockDraftRepositoryMockRecorder) SaveInterestsToDraft(interestsSaveReq
 }
 
 // SavePostDraft mocks base method.
-func (m *MockDraftRepository) SavePostDraft(draft models.UpsertDraft, ctx context.Context) error {
+func (m *MockDraftRepository) SavePostDraft(ctx context.Context, txn helper.Transaction, draft models.UpsertDraft) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SavePostDraft", draft, ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SavePostDraft", ctx, txn, draft)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SavePostDraft indicates an expected call of SavePostDraft.
-func (mr *MockDraftRepositoryMockRecorder) SavePostDraft(draft, ctx interface{}) *gomock.Call {
+func (mr *MockDraftRepositoryMockRecorder) SavePostDraft(ctx, txn, draft interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePostDraft", reflect.TypeOf((*MockDraftRepository)(nil).SavePostDraft), draft, ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePostDraft", reflect.TypeOf((*MockDraftRepository)(nil).SavePostDraft), ctx, txn, draft)
 }
 
 // SaveTaglineToDraft mocks base method.
-func (m *MockDraftRepository) SaveTaglineToDraft(taglineSaveRequest request.TaglineSaveRequest, ctx context.Context) error {
+func (m *MockDraftRepository) SaveTaglineToDraft(taglineSaveRequest request.TaglineSaveRequest, ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SaveTaglineToDraft", taglineSaveRequest, ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SaveTaglineToDraft indicates an expected call of SaveTaglineToDraft.
@@ -139,14 +364,72 @@ func (mr *MockDraftRepositoryMockRecorder) SaveTaglineToDraft(taglineSaveRequest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaglineToDraft", reflect.TypeOf((*MockDraftRepository)(nil).SaveTaglineToDraft), taglineSaveRequest, ctx)
 }
 
-// UpsertPreviewImage mocks base method.
-func (m *MockDraftRepository) UpsertPreviewImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) error {
+// SchedulePublish mocks base method.
+func (m *MockDraftRepository) SchedulePublish(ctx context.Context, draftID, userID uuid.UUID, publishAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertPreviewImage", ctx, saveRequest)
+	ret := m.ctrl.Call(m, "SchedulePublish", ctx, draftID, userID, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulePublish indicates an expected call of SchedulePublish.
+func (mr *MockDraftRepositoryMockRecorder) SchedulePublish(ctx, draftID, userID, publishAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublish", reflect.TypeOf((*MockDraftRepository)(nil).SchedulePublish), ctx, draftID, userID, publishAt)
+}
+
+// UpdatePublishError mocks base method.
+func (m *MockDraftRepository) UpdatePublishError(ctx context.Context, draftID uuid.UUID, publishError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePublishError", ctx, draftID, publishError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePublishError indicates an expected call of UpdatePublishError.
+func (mr *MockDraftRepositoryMockRecorder) UpdatePublishError(ctx, draftID, publishError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePublishError", reflect.TypeOf((*MockDraftRepository)(nil).UpdatePublishError), ctx, draftID, publishError)
+}
+
+// UpdatePublishStatus mocks base method.
+func (m *MockDraftRepository) UpdatePublishStatus(ctx context.Context, txn helper.Transaction, draftUID, userID uuid.UUID, status bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePublishStatus", ctx, txn, draftUID, userID, status)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// UpdatePublishStatus indicates an expected call of UpdatePublishStatus.
+func (mr *MockDraftRepositoryMockRecorder) UpdatePublishStatus(ctx, txn, draftUID, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePublishStatus", reflect.TypeOf((*MockDraftRepository)(nil).UpdatePublishStatus), ctx, txn, draftUID, userID, status)
+}
+
+// UpsertImage mocks base method.
+func (m *MockDraftRepository) UpsertImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertImage", ctx, saveRequest)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertImage indicates an expected call of UpsertImage.
+func (mr *MockDraftRepositoryMockRecorder) UpsertImage(ctx, saveRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertImage", reflect.TypeOf((*MockDraftRepository)(nil).UpsertImage), ctx, saveRequest)
+}
+
+// UpsertPreviewImage mocks base method.
+func (m *MockDraftRepository) UpsertPreviewImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPreviewImage", ctx, saveRequest)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpsertPreviewImage indicates an expected call of UpsertPreviewImage.
 func (mr *MockDraftRepositoryMockRecorder) UpsertPreviewImage(ctx, saveRequest interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
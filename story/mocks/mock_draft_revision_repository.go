@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft_revision_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	helper "post-api/helper"
+	models "post-api/story/models"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockDraftRevisionRepository is a mock of DraftRevisionRepository interface.
+type MockDraftRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftRevisionRepositoryMockRecorder
+}
+
+// MockDraftRevisionRepositoryMockRecorder is the mock recorder for MockDraftRevisionRepository.
+type MockDraftRevisionRepositoryMockRecorder struct {
+	mock *MockDraftRevisionRepository
+}
+
+// NewMockDraftRevisionRepository creates a new mock instance.
+func NewMockDraftRevisionRepository(ctrl *gomock.Controller) *MockDraftRevisionRepository {
+	mock := &MockDraftRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockDraftRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftRevisionRepository) EXPECT() *MockDraftRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRevision mocks base method.
+func (m *MockDraftRevisionRepository) GetRevision(ctx context.Context, draftID, revisionID uuid.UUID) (db.DraftRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, draftID, revisionID)
+	ret0, _ := ret[0].(db.DraftRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockDraftRevisionRepositoryMockRecorder) GetRevision(ctx, draftID, revisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockDraftRevisionRepository)(nil).GetRevision), ctx, draftID, revisionID)
+}
+
+// GetRevisions mocks base method.
+func (m *MockDraftRevisionRepository) GetRevisions(ctx context.Context, draftID uuid.UUID) ([]db.DraftRevisionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, draftID)
+	ret0, _ := ret[0].([]db.DraftRevisionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockDraftRevisionRepositoryMockRecorder) GetRevisions(ctx, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockDraftRevisionRepository)(nil).GetRevisions), ctx, draftID)
+}
+
+// Save mocks base method.
+func (m *MockDraftRevisionRepository) Save(ctx context.Context, txn helper.Transaction, draftID uuid.UUID, data models.JSONString) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, txn, draftID, data)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDraftRevisionRepositoryMockRecorder) Save(ctx, txn, draftID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDraftRevisionRepository)(nil).Save), ctx, txn, draftID, data)
+}
@@ -9,11 +9,13 @@ import (
 	models "post-api/story/models"
 	db "post-api/story/models/db"
 	request "post-api/story/models/request"
+	response "post-api/story/models/response"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+	model "github.com/inclusi-blog/gola-utils/model"
 )
 
 // MockDraftService is a mock of DraftService interface.
@@ -39,13 +41,28 @@ func (m *MockDraftService) EXPECT() *MockDraftServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelScheduledPublish mocks base method.
+func (m *MockDraftService) CancelScheduledPublish(ctx context.Context, draftID, userUUID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledPublish", ctx, draftID, userUUID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// CancelScheduledPublish indicates an expected call of CancelScheduledPublish.
+func (mr *MockDraftServiceMockRecorder) CancelScheduledPublish(ctx, draftID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledPublish", reflect.TypeOf((*MockDraftService)(nil).CancelScheduledPublish), ctx, draftID, userUUID)
+}
+
 // CreateDraft mocks base method.
-func (m *MockDraftService) CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, error) {
+func (m *MockDraftService) CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, []models.SanitizeReport, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateDraft", ctx, draft)
 	ret0, _ := ret[0].(uuid.UUID)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].([]models.SanitizeReport)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // CreateDraft indicates an expected call of CreateDraft.
@@ -68,6 +85,66 @@ func (mr *MockDraftServiceMockRecorder) DeleteDraft(ctx, draftID, userUUID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDraft", reflect.TypeOf((*MockDraftService)(nil).DeleteDraft), ctx, draftID, userUUID)
 }
 
+// DiffRevisions mocks base method.
+func (m *MockDraftService) DiffRevisions(ctx context.Context, draftID, fromID, toID, userUUID uuid.UUID) (response.RevisionDiff, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", ctx, draftID, fromID, toID, userUUID)
+	ret0, _ := ret[0].(response.RevisionDiff)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockDraftServiceMockRecorder) DiffRevisions(ctx, draftID, fromID, toID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockDraftService)(nil).DiffRevisions), ctx, draftID, fromID, toID, userUUID)
+}
+
+// ExportMarkdown mocks base method.
+func (m *MockDraftService) ExportMarkdown(ctx context.Context, draftID, userUUID uuid.UUID) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMarkdown", ctx, draftID, userUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// ExportMarkdown indicates an expected call of ExportMarkdown.
+func (mr *MockDraftServiceMockRecorder) ExportMarkdown(ctx, draftID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMarkdown", reflect.TypeOf((*MockDraftService)(nil).ExportMarkdown), ctx, draftID, userUUID)
+}
+
+// ForkDraft mocks base method.
+func (m *MockDraftService) ForkDraft(ctx context.Context, draftID, userUUID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkDraft", ctx, draftID, userUUID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// ForkDraft indicates an expected call of ForkDraft.
+func (mr *MockDraftServiceMockRecorder) ForkDraft(ctx, draftID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkDraft", reflect.TypeOf((*MockDraftService)(nil).ForkDraft), ctx, draftID, userUUID)
+}
+
+// ForkPost mocks base method.
+func (m *MockDraftService) ForkPost(ctx context.Context, postID, userUUID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkPost", ctx, postID, userUUID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// ForkPost indicates an expected call of ForkPost.
+func (mr *MockDraftServiceMockRecorder) ForkPost(ctx, postID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkPost", reflect.TypeOf((*MockDraftService)(nil).ForkPost), ctx, postID, userUUID)
+}
+
 // GetAllDraft mocks base method.
 func (m *MockDraftService) GetAllDraft(ctx context.Context, allDraftReq models.GetAllDraftRequest) ([]db.DraftPreview, error) {
 	m.ctrl.T.Helper()
@@ -86,7 +163,7 @@ func (mr *MockDraftServiceMockRecorder) GetAllDraft(ctx, allDraftReq interface{}
 // GetDraft mocks base method.
 func (m *MockDraftService) GetDraft(ctx context.Context, draftUID, userUUID uuid.UUID) (db.Draft, *golaerror.Error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDraftByUser", ctx, draftUID, userUUID)
+	ret := m.ctrl.Call(m, "GetDraft", ctx, draftUID, userUUID)
 	ret0, _ := ret[0].(db.Draft)
 	ret1, _ := ret[1].(*golaerror.Error)
 	return ret0, ret1
@@ -95,15 +172,139 @@ func (m *MockDraftService) GetDraft(ctx context.Context, draftUID, userUUID uuid
 // GetDraft indicates an expected call of GetDraft.
 func (mr *MockDraftServiceMockRecorder) GetDraft(ctx, draftUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftByUser", reflect.TypeOf((*MockDraftService)(nil).GetDraft), ctx, draftUID, userUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraft", reflect.TypeOf((*MockDraftService)(nil).GetDraft), ctx, draftUID, userUUID)
+}
+
+// GetDraftImage mocks base method.
+func (m *MockDraftService) GetDraftImage(ctx context.Context, draftID, imageID uuid.UUID) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraftImage", ctx, draftID, imageID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetDraftImage indicates an expected call of GetDraftImage.
+func (mr *MockDraftServiceMockRecorder) GetDraftImage(ctx, draftID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftImage", reflect.TypeOf((*MockDraftService)(nil).GetDraftImage), ctx, draftID, imageID)
+}
+
+// GetRevision mocks base method.
+func (m *MockDraftService) GetRevision(ctx context.Context, draftID, revisionID, userUUID uuid.UUID) (db.DraftRevision, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, draftID, revisionID, userUUID)
+	ret0, _ := ret[0].(db.DraftRevision)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockDraftServiceMockRecorder) GetRevision(ctx, draftID, revisionID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockDraftService)(nil).GetRevision), ctx, draftID, revisionID, userUUID)
+}
+
+// GetRevisions mocks base method.
+func (m *MockDraftService) GetRevisions(ctx context.Context, draftID, userUUID uuid.UUID) ([]db.DraftRevisionSummary, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, draftID, userUUID)
+	ret0, _ := ret[0].([]db.DraftRevisionSummary)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockDraftServiceMockRecorder) GetRevisions(ctx, draftID, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockDraftService)(nil).GetRevisions), ctx, draftID, userUUID)
+}
+
+// GetScheduledDrafts mocks base method.
+func (m *MockDraftService) GetScheduledDrafts(ctx context.Context, userUUID uuid.UUID) ([]db.ScheduledDraft, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledDrafts", ctx, userUUID)
+	ret0, _ := ret[0].([]db.ScheduledDraft)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetScheduledDrafts indicates an expected call of GetScheduledDrafts.
+func (mr *MockDraftServiceMockRecorder) GetScheduledDrafts(ctx, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledDrafts", reflect.TypeOf((*MockDraftService)(nil).GetScheduledDrafts), ctx, userUUID)
+}
+
+// ImportMarkdown mocks base method.
+func (m *MockDraftService) ImportMarkdown(ctx context.Context, importRequest request.MarkdownImportRequest) (uuid.UUID, []models.SanitizeReport, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMarkdown", ctx, importRequest)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].([]models.SanitizeReport)
+	ret2, _ := ret[2].(*golaerror.Error)
+	return ret0, ret1, ret2
+}
+
+// ImportMarkdown indicates an expected call of ImportMarkdown.
+func (mr *MockDraftServiceMockRecorder) ImportMarkdown(ctx, importRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMarkdown", reflect.TypeOf((*MockDraftService)(nil).ImportMarkdown), ctx, importRequest)
+}
+
+// PatchDraft mocks base method.
+func (m *MockDraftService) PatchDraft(ctx context.Context, patchRequest request.DraftPatchRequest) (int64, []models.SanitizeReport, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchDraft", ctx, patchRequest)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]models.SanitizeReport)
+	ret2, _ := ret[2].(*golaerror.Error)
+	return ret0, ret1, ret2
+}
+
+// PatchDraft indicates an expected call of PatchDraft.
+func (mr *MockDraftServiceMockRecorder) PatchDraft(ctx, patchRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDraft", reflect.TypeOf((*MockDraftService)(nil).PatchDraft), ctx, patchRequest)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockDraftService) RestoreRevision(ctx context.Context, draftID, revisionID, userUUID uuid.UUID, version int64) (int64, []models.SanitizeReport, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, draftID, revisionID, userUUID, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]models.SanitizeReport)
+	ret2, _ := ret[2].(*golaerror.Error)
+	return ret0, ret1, ret2
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockDraftServiceMockRecorder) RestoreRevision(ctx, draftID, revisionID, userUUID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockDraftService)(nil).RestoreRevision), ctx, draftID, revisionID, userUUID, version)
+}
+
+// SaveImage mocks base method.
+func (m *MockDraftService) SaveImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveImage", ctx, imageSaveRequest)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// SaveImage indicates an expected call of SaveImage.
+func (mr *MockDraftServiceMockRecorder) SaveImage(ctx, imageSaveRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveImage", reflect.TypeOf((*MockDraftService)(nil).SaveImage), ctx, imageSaveRequest)
 }
 
 // SavePreviewImage mocks base method.
-func (m *MockDraftService) SavePreviewImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) *golaerror.Error {
+func (m *MockDraftService) SavePreviewImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) (int64, *golaerror.Error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SavePreviewImage", ctx, imageSaveRequest)
-	ret0, _ := ret[0].(*golaerror.Error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
 }
 
 // SavePreviewImage indicates an expected call of SavePreviewImage.
@@ -112,14 +313,30 @@ func (mr *MockDraftServiceMockRecorder) SavePreviewImage(ctx, imageSaveRequest i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePreviewImage", reflect.TypeOf((*MockDraftService)(nil).SavePreviewImage), ctx, imageSaveRequest)
 }
 
-// UpdateDraft mocks base method.
-func (m *MockDraftService) UpdateDraft(postData models.UpsertDraft, ctx context.Context) *golaerror.Error {
+// SchedulePublish mocks base method.
+func (m *MockDraftService) SchedulePublish(ctx context.Context, scheduleRequest request.SchedulePublishRequest) *golaerror.Error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDraft", postData, ctx)
+	ret := m.ctrl.Call(m, "SchedulePublish", ctx, scheduleRequest)
 	ret0, _ := ret[0].(*golaerror.Error)
 	return ret0
 }
 
+// SchedulePublish indicates an expected call of SchedulePublish.
+func (mr *MockDraftServiceMockRecorder) SchedulePublish(ctx, scheduleRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublish", reflect.TypeOf((*MockDraftService)(nil).SchedulePublish), ctx, scheduleRequest)
+}
+
+// UpdateDraft mocks base method.
+func (m *MockDraftService) UpdateDraft(postData models.UpsertDraft, ctx context.Context) (int64, []models.SanitizeReport, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDraft", postData, ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]models.SanitizeReport)
+	ret2, _ := ret[2].(*golaerror.Error)
+	return ret0, ret1, ret2
+}
+
 // UpdateDraft indicates an expected call of UpdateDraft.
 func (mr *MockDraftServiceMockRecorder) UpdateDraft(postData, ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -127,11 +344,12 @@ func (mr *MockDraftServiceMockRecorder) UpdateDraft(postData, ctx interface{}) *
 }
 
 // UpsertInterests mocks base method.
-func (m *MockDraftService) UpsertInterests(interestRequest request.InterestsSaveRequest, ctx context.Context) *golaerror.Error {
+func (m *MockDraftService) UpsertInterests(interestRequest request.InterestsSaveRequest, ctx context.Context) (int64, *golaerror.Error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpsertInterests", interestRequest, ctx)
-	ret0, _ := ret[0].(*golaerror.Error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
 }
 
 // UpsertInterests indicates an expected call of UpsertInterests.
@@ -141,11 +359,12 @@ func (mr *MockDraftServiceMockRecorder) UpsertInterests(interestRequest, ctx int
 }
 
 // UpsertTagline mocks base method.
-func (m *MockDraftService) UpsertTagline(taglineRequest request.TaglineSaveRequest, ctx context.Context) *golaerror.Error {
+func (m *MockDraftService) UpsertTagline(taglineRequest request.TaglineSaveRequest, ctx context.Context) (int64, *golaerror.Error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpsertTagline", taglineRequest, ctx)
-	ret0, _ := ret[0].(*golaerror.Error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
 }
 
 // UpsertTagline indicates an expected call of UpsertTagline.
@@ -153,3 +372,18 @@ func (mr *MockDraftServiceMockRecorder) UpsertTagline(taglineRequest, ctx interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTagline", reflect.TypeOf((*MockDraftService)(nil).UpsertTagline), taglineRequest, ctx)
 }
+
+// ValidateAndGetDraft mocks base method.
+func (m *MockDraftService) ValidateAndGetDraft(ctx context.Context, draftId uuid.UUID, user model.IdToken) (response.PreviewDraft, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAndGetDraft", ctx, draftId, user)
+	ret0, _ := ret[0].(response.PreviewDraft)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// ValidateAndGetDraft indicates an expected call of ValidateAndGetDraft.
+func (mr *MockDraftServiceMockRecorder) ValidateAndGetDraft(ctx, draftId, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAndGetDraft", reflect.TypeOf((*MockDraftService)(nil).ValidateAndGetDraft), ctx, draftId, user)
+}
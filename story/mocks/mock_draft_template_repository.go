@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft_template_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	helper "post-api/helper"
+	db "post-api/story/models/db"
+	request "post-api/story/models/request"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockDraftTemplateRepository is a mock of DraftTemplateRepository interface.
+type MockDraftTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftTemplateRepositoryMockRecorder
+}
+
+// MockDraftTemplateRepositoryMockRecorder is the mock recorder for MockDraftTemplateRepository.
+type MockDraftTemplateRepositoryMockRecorder struct {
+	mock *MockDraftTemplateRepository
+}
+
+// NewMockDraftTemplateRepository creates a new mock instance.
+func NewMockDraftTemplateRepository(ctrl *gomock.Controller) *MockDraftTemplateRepository {
+	mock := &MockDraftTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockDraftTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftTemplateRepository) EXPECT() *MockDraftTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddImage mocks base method.
+func (m *MockDraftTemplateRepository) AddImage(ctx context.Context, txn helper.Transaction, templateID, draftID, imageID uuid.UUID, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImage", ctx, txn, templateID, draftID, imageID, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddImage indicates an expected call of AddImage.
+func (mr *MockDraftTemplateRepositoryMockRecorder) AddImage(ctx, txn, templateID, draftID, imageID, uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImage", reflect.TypeOf((*MockDraftTemplateRepository)(nil).AddImage), ctx, txn, templateID, draftID, imageID, uploadID)
+}
+
+// CreateFromDraft mocks base method.
+func (m *MockDraftTemplateRepository) CreateFromDraft(ctx context.Context, txn helper.Transaction, saveRequest request.SaveTemplateRequest) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromDraft", ctx, txn, saveRequest)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromDraft indicates an expected call of CreateFromDraft.
+func (mr *MockDraftTemplateRepositoryMockRecorder) CreateFromDraft(ctx, txn, saveRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromDraft", reflect.TypeOf((*MockDraftTemplateRepository)(nil).CreateFromDraft), ctx, txn, saveRequest)
+}
+
+// Delete mocks base method.
+func (m *MockDraftTemplateRepository) Delete(ctx context.Context, templateID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, templateID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDraftTemplateRepositoryMockRecorder) Delete(ctx, templateID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDraftTemplateRepository)(nil).Delete), ctx, templateID, userID)
+}
+
+// GetImage mocks base method.
+func (m *MockDraftTemplateRepository) GetImage(ctx context.Context, templateID, draftID, imageID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, templateID, draftID, imageID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockDraftTemplateRepositoryMockRecorder) GetImage(ctx, templateID, draftID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockDraftTemplateRepository)(nil).GetImage), ctx, templateID, draftID, imageID)
+}
+
+// GetPublicTemplates mocks base method.
+func (m *MockDraftTemplateRepository) GetPublicTemplates(ctx context.Context, limit, offset int) ([]db.DraftTemplateSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicTemplates", ctx, limit, offset)
+	ret0, _ := ret[0].([]db.DraftTemplateSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicTemplates indicates an expected call of GetPublicTemplates.
+func (mr *MockDraftTemplateRepositoryMockRecorder) GetPublicTemplates(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicTemplates", reflect.TypeOf((*MockDraftTemplateRepository)(nil).GetPublicTemplates), ctx, limit, offset)
+}
+
+// GetTemplate mocks base method.
+func (m *MockDraftTemplateRepository) GetTemplate(ctx context.Context, templateID, userID uuid.UUID) (db.DraftTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, templateID, userID)
+	ret0, _ := ret[0].(db.DraftTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockDraftTemplateRepositoryMockRecorder) GetTemplate(ctx, templateID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockDraftTemplateRepository)(nil).GetTemplate), ctx, templateID, userID)
+}
+
+// GetTemplatesByUser mocks base method.
+func (m *MockDraftTemplateRepository) GetTemplatesByUser(ctx context.Context, userID uuid.UUID) ([]db.DraftTemplateSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplatesByUser", ctx, userID)
+	ret0, _ := ret[0].([]db.DraftTemplateSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplatesByUser indicates an expected call of GetTemplatesByUser.
+func (mr *MockDraftTemplateRepositoryMockRecorder) GetTemplatesByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplatesByUser", reflect.TypeOf((*MockDraftTemplateRepository)(nil).GetTemplatesByUser), ctx, userID)
+}
+
+// UpdateVisibility mocks base method.
+func (m *MockDraftTemplateRepository) UpdateVisibility(ctx context.Context, templateID, userID uuid.UUID, isPublic bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", ctx, templateID, userID, isPublic)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockDraftTemplateRepositoryMockRecorder) UpdateVisibility(ctx, templateID, userID, isPublic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockDraftTemplateRepository)(nil).UpdateVisibility), ctx, templateID, userID, isPublic)
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft_template_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	request "post-api/story/models/request"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockDraftTemplateService is a mock of DraftTemplateService interface.
+type MockDraftTemplateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftTemplateServiceMockRecorder
+}
+
+// MockDraftTemplateServiceMockRecorder is the mock recorder for MockDraftTemplateService.
+type MockDraftTemplateServiceMockRecorder struct {
+	mock *MockDraftTemplateService
+}
+
+// NewMockDraftTemplateService creates a new mock instance.
+func NewMockDraftTemplateService(ctrl *gomock.Controller) *MockDraftTemplateService {
+	mock := &MockDraftTemplateService{ctrl: ctrl}
+	mock.recorder = &MockDraftTemplateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftTemplateService) EXPECT() *MockDraftTemplateServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDraftFromTemplate mocks base method.
+func (m *MockDraftTemplateService) CreateDraftFromTemplate(ctx context.Context, templateID, userID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDraftFromTemplate", ctx, templateID, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// CreateDraftFromTemplate indicates an expected call of CreateDraftFromTemplate.
+func (mr *MockDraftTemplateServiceMockRecorder) CreateDraftFromTemplate(ctx, templateID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDraftFromTemplate", reflect.TypeOf((*MockDraftTemplateService)(nil).CreateDraftFromTemplate), ctx, templateID, userID)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockDraftTemplateService) DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, templateID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockDraftTemplateServiceMockRecorder) DeleteTemplate(ctx, templateID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockDraftTemplateService)(nil).DeleteTemplate), ctx, templateID, userID)
+}
+
+// GetPublicTemplates mocks base method.
+func (m *MockDraftTemplateService) GetPublicTemplates(ctx context.Context, limit, offset int) ([]db.DraftTemplateSummary, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicTemplates", ctx, limit, offset)
+	ret0, _ := ret[0].([]db.DraftTemplateSummary)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPublicTemplates indicates an expected call of GetPublicTemplates.
+func (mr *MockDraftTemplateServiceMockRecorder) GetPublicTemplates(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicTemplates", reflect.TypeOf((*MockDraftTemplateService)(nil).GetPublicTemplates), ctx, limit, offset)
+}
+
+// GetTemplate mocks base method.
+func (m *MockDraftTemplateService) GetTemplate(ctx context.Context, templateID, userID uuid.UUID) (db.DraftTemplate, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, templateID, userID)
+	ret0, _ := ret[0].(db.DraftTemplate)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockDraftTemplateServiceMockRecorder) GetTemplate(ctx, templateID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockDraftTemplateService)(nil).GetTemplate), ctx, templateID, userID)
+}
+
+// GetTemplates mocks base method.
+func (m *MockDraftTemplateService) GetTemplates(ctx context.Context, userID uuid.UUID) ([]db.DraftTemplateSummary, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", ctx, userID)
+	ret0, _ := ret[0].([]db.DraftTemplateSummary)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockDraftTemplateServiceMockRecorder) GetTemplates(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockDraftTemplateService)(nil).GetTemplates), ctx, userID)
+}
+
+// SaveAsTemplate mocks base method.
+func (m *MockDraftTemplateService) SaveAsTemplate(ctx context.Context, saveRequest request.SaveTemplateRequest) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAsTemplate", ctx, saveRequest)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// SaveAsTemplate indicates an expected call of SaveAsTemplate.
+func (mr *MockDraftTemplateServiceMockRecorder) SaveAsTemplate(ctx, saveRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAsTemplate", reflect.TypeOf((*MockDraftTemplateService)(nil).SaveAsTemplate), ctx, saveRequest)
+}
+
+// ShareTemplate mocks base method.
+func (m *MockDraftTemplateService) ShareTemplate(ctx context.Context, templateID, userID uuid.UUID, isPublic bool) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareTemplate", ctx, templateID, userID, isPublic)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// ShareTemplate indicates an expected call of ShareTemplate.
+func (mr *MockDraftTemplateServiceMockRecorder) ShareTemplate(ctx, templateID, userID, isPublic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareTemplate", reflect.TypeOf((*MockDraftTemplateService)(nil).ShareTemplate), ctx, templateID, userID, isPublic)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft_trash_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockDraftTrashService is a mock of DraftTrashService interface.
+type MockDraftTrashService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftTrashServiceMockRecorder
+}
+
+// MockDraftTrashServiceMockRecorder is the mock recorder for MockDraftTrashService.
+type MockDraftTrashServiceMockRecorder struct {
+	mock *MockDraftTrashService
+}
+
+// NewMockDraftTrashService creates a new mock instance.
+func NewMockDraftTrashService(ctrl *gomock.Controller) *MockDraftTrashService {
+	mock := &MockDraftTrashService{ctrl: ctrl}
+	mock.recorder = &MockDraftTrashServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftTrashService) EXPECT() *MockDraftTrashServiceMockRecorder {
+	return m.recorder
+}
+
+// GetTrashedDrafts mocks base method.
+func (m *MockDraftTrashService) GetTrashedDrafts(ctx context.Context, userID uuid.UUID) ([]db.TrashedDraft, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedDrafts", ctx, userID)
+	ret0, _ := ret[0].([]db.TrashedDraft)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetTrashedDrafts indicates an expected call of GetTrashedDrafts.
+func (mr *MockDraftTrashServiceMockRecorder) GetTrashedDrafts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedDrafts", reflect.TypeOf((*MockDraftTrashService)(nil).GetTrashedDrafts), ctx, userID)
+}
+
+// RestoreDraft mocks base method.
+func (m *MockDraftTrashService) RestoreDraft(ctx context.Context, draftID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDraft", ctx, draftID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// RestoreDraft indicates an expected call of RestoreDraft.
+func (mr *MockDraftTrashServiceMockRecorder) RestoreDraft(ctx, draftID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDraft", reflect.TypeOf((*MockDraftTrashService)(nil).RestoreDraft), ctx, draftID, userID)
+}
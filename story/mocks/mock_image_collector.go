@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: image_collector.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	response "post-api/story/models/response"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockImageCollector is a mock of ImageCollector interface.
+type MockImageCollector struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageCollectorMockRecorder
+}
+
+// MockImageCollectorMockRecorder is the mock recorder for MockImageCollector.
+type MockImageCollectorMockRecorder struct {
+	mock *MockImageCollector
+}
+
+// NewMockImageCollector creates a new mock instance.
+func NewMockImageCollector(ctrl *gomock.Controller) *MockImageCollector {
+	mock := &MockImageCollector{ctrl: ctrl}
+	mock.recorder = &MockImageCollectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageCollector) EXPECT() *MockImageCollectorMockRecorder {
+	return m.recorder
+}
+
+// Collect mocks base method.
+func (m *MockImageCollector) Collect(ctx context.Context) (response.ImageCollectionReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collect", ctx)
+	ret0, _ := ret[0].(response.ImageCollectionReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Collect indicates an expected call of Collect.
+func (mr *MockImageCollectorMockRecorder) Collect(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockImageCollector)(nil).Collect), ctx)
+}
+
+// Start mocks base method.
+func (m *MockImageCollector) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockImageCollectorMockRecorder) Start(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockImageCollector)(nil).Start), ctx)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: image_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockImageRepository is a mock of ImageRepository interface.
+type MockImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageRepositoryMockRecorder
+}
+
+// MockImageRepositoryMockRecorder is the mock recorder for MockImageRepository.
+type MockImageRepositoryMockRecorder struct {
+	mock *MockImageRepository
+}
+
+// NewMockImageRepository creates a new mock instance.
+func NewMockImageRepository(ctrl *gomock.Controller) *MockImageRepository {
+	mock := &MockImageRepository{ctrl: ctrl}
+	mock.recorder = &MockImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageRepository) EXPECT() *MockImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteDraftImages mocks base method.
+func (m *MockImageRepository) DeleteDraftImages(ctx context.Context, imageIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDraftImages", ctx, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDraftImages indicates an expected call of DeleteDraftImages.
+func (mr *MockImageRepositoryMockRecorder) DeleteDraftImages(ctx, imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDraftImages", reflect.TypeOf((*MockImageRepository)(nil).DeleteDraftImages), ctx, imageIDs)
+}
+
+// GetDraftImages mocks base method.
+func (m *MockImageRepository) GetDraftImages(ctx context.Context) ([]db.DraftImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraftImages", ctx)
+	ret0, _ := ret[0].([]db.DraftImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraftImages indicates an expected call of GetDraftImages.
+func (mr *MockImageRepositoryMockRecorder) GetDraftImages(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftImages", reflect.TypeOf((*MockImageRepository)(nil).GetDraftImages), ctx)
+}
+
+// GetImageBlockURLs mocks base method.
+func (m *MockImageRepository) GetImageBlockURLs(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageBlockURLs", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageBlockURLs indicates an expected call of GetImageBlockURLs.
+func (mr *MockImageRepositoryMockRecorder) GetImageBlockURLs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageBlockURLs", reflect.TypeOf((*MockImageRepository)(nil).GetImageBlockURLs), ctx)
+}
+
+// GetStoredImageKeys mocks base method.
+func (m *MockImageRepository) GetStoredImageKeys(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredImageKeys", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredImageKeys indicates an expected call of GetStoredImageKeys.
+func (mr *MockImageRepositoryMockRecorder) GetStoredImageKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredImageKeys", reflect.TypeOf((*MockImageRepository)(nil).GetStoredImageKeys), ctx)
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	db "post-api/story/models/db"
+	response "post-api/story/models/response"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,21 @@ func (m *MockInterestsRepository) EXPECT() *MockInterestsRepositoryMockRecorder
 	return m.recorder
 }
 
+// GetFollowCount mocks base method.
+func (m *MockInterestsRepository) GetFollowCount(ctx context.Context, interestName string, userID uuid.UUID) (response.InterestCountDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowCount", ctx, interestName, userID)
+	ret0, _ := ret[0].(response.InterestCountDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowCount indicates an expected call of GetFollowCount.
+func (mr *MockInterestsRepositoryMockRecorder) GetFollowCount(ctx, interestName, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCount", reflect.TypeOf((*MockInterestsRepository)(nil).GetFollowCount), ctx, interestName, userID)
+}
+
 // GetInterestIDs mocks base method.
 func (m *MockInterestsRepository) GetInterestIDs(ctx context.Context, interestNames []string) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +81,18 @@ func (mr *MockInterestsRepositoryMockRecorder) GetInterests(ctx interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterests", reflect.TypeOf((*MockInterestsRepository)(nil).GetInterests), ctx)
 }
+
+// GetInterestsForName mocks base method.
+func (m *MockInterestsRepository) GetInterestsForName(ctx context.Context, interestNames []string) ([]db.Interests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterestsForName", ctx, interestNames)
+	ret0, _ := ret[0].([]db.Interests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterestsForName indicates an expected call of GetInterestsForName.
+func (mr *MockInterestsRepositoryMockRecorder) GetInterestsForName(ctx, interestNames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestsForName", reflect.TypeOf((*MockInterestsRepository)(nil).GetInterestsForName), ctx, interestNames)
+}
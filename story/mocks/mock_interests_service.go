@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	db "post-api/story/models/db"
+	response "post-api/story/models/response"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
 )
 
@@ -36,6 +38,21 @@ func (m *MockInterestsService) EXPECT() *MockInterestsServiceMockRecorder {
 	return m.recorder
 }
 
+// GetFollowCount mocks base method.
+func (m *MockInterestsService) GetFollowCount(ctx context.Context, interestName string, userID uuid.UUID) (response.InterestCountDetails, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowCount", ctx, interestName, userID)
+	ret0, _ := ret[0].(response.InterestCountDetails)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetFollowCount indicates an expected call of GetFollowCount.
+func (mr *MockInterestsServiceMockRecorder) GetFollowCount(ctx, interestName, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCount", reflect.TypeOf((*MockInterestsService)(nil).GetFollowCount), ctx, interestName, userID)
+}
+
 // GetInterests mocks base method.
 func (m *MockInterestsService) GetInterests(ctx context.Context) ([]db.Interests, *golaerror.Error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: link_preview_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "post-api/story/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockLinkPreviewService is a mock of LinkPreviewService interface.
+type MockLinkPreviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLinkPreviewServiceMockRecorder
+}
+
+// MockLinkPreviewServiceMockRecorder is the mock recorder for MockLinkPreviewService.
+type MockLinkPreviewServiceMockRecorder struct {
+	mock *MockLinkPreviewService
+}
+
+// NewMockLinkPreviewService creates a new mock instance.
+func NewMockLinkPreviewService(ctrl *gomock.Controller) *MockLinkPreviewService {
+	mock := &MockLinkPreviewService{ctrl: ctrl}
+	mock.recorder = &MockLinkPreviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLinkPreviewService) EXPECT() *MockLinkPreviewServiceMockRecorder {
+	return m.recorder
+}
+
+// FillLinkPreviews mocks base method.
+func (m *MockLinkPreviewService) FillLinkPreviews(ctx context.Context, content models.JSONString) models.JSONString {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillLinkPreviews", ctx, content)
+	ret0, _ := ret[0].(models.JSONString)
+	return ret0
+}
+
+// FillLinkPreviews indicates an expected call of FillLinkPreviews.
+func (mr *MockLinkPreviewServiceMockRecorder) FillLinkPreviews(ctx, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillLinkPreviews", reflect.TypeOf((*MockLinkPreviewService)(nil).FillLinkPreviews), ctx, content)
+}
+
+// Unfurl mocks base method.
+func (m *MockLinkPreviewService) Unfurl(ctx context.Context, link string) (models.LinkMeta, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfurl", ctx, link)
+	ret0, _ := ret[0].(models.LinkMeta)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// Unfurl indicates an expected call of Unfurl.
+func (mr *MockLinkPreviewServiceMockRecorder) Unfurl(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfurl", reflect.TypeOf((*MockLinkPreviewService)(nil).Unfurl), ctx, link)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: post_fingerprint_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	helper "post-api/helper"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPostFingerprintRepository is a mock of PostFingerprintRepository interface.
+type MockPostFingerprintRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostFingerprintRepositoryMockRecorder
+}
+
+// MockPostFingerprintRepositoryMockRecorder is the mock recorder for MockPostFingerprintRepository.
+type MockPostFingerprintRepositoryMockRecorder struct {
+	mock *MockPostFingerprintRepository
+}
+
+// NewMockPostFingerprintRepository creates a new mock instance.
+func NewMockPostFingerprintRepository(ctrl *gomock.Controller) *MockPostFingerprintRepository {
+	mock := &MockPostFingerprintRepository{ctrl: ctrl}
+	mock.recorder = &MockPostFingerprintRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostFingerprintRepository) EXPECT() *MockPostFingerprintRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindNearest mocks base method.
+func (m *MockPostFingerprintRepository) FindNearest(ctx context.Context, fingerprint uint64, authorID, excludePostID uuid.UUID, maxDistance int) (db.FingerprintMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearest", ctx, fingerprint, authorID, excludePostID, maxDistance)
+	ret0, _ := ret[0].(db.FingerprintMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearest indicates an expected call of FindNearest.
+func (mr *MockPostFingerprintRepositoryMockRecorder) FindNearest(ctx, fingerprint, authorID, excludePostID, maxDistance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearest", reflect.TypeOf((*MockPostFingerprintRepository)(nil).FindNearest), ctx, fingerprint, authorID, excludePostID, maxDistance)
+}
+
+// Save mocks base method.
+func (m *MockPostFingerprintRepository) Save(ctx context.Context, txn helper.Transaction, postID uuid.UUID, fingerprint uint64, duplicateOf *uuid.UUID, similarity *float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, txn, postID, fingerprint, duplicateOf, similarity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPostFingerprintRepositoryMockRecorder) Save(ctx, txn, postID, fingerprint, duplicateOf, similarity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPostFingerprintRepository)(nil).Save), ctx, txn, postID, fingerprint, duplicateOf, similarity)
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: post_revision_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	helper "post-api/helper"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPostRevisionRepository is a mock of PostRevisionRepository interface.
+type MockPostRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostRevisionRepositoryMockRecorder
+}
+
+// MockPostRevisionRepositoryMockRecorder is the mock recorder for MockPostRevisionRepository.
+type MockPostRevisionRepositoryMockRecorder struct {
+	mock *MockPostRevisionRepository
+}
+
+// NewMockPostRevisionRepository creates a new mock instance.
+func NewMockPostRevisionRepository(ctrl *gomock.Controller) *MockPostRevisionRepository {
+	mock := &MockPostRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockPostRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostRevisionRepository) EXPECT() *MockPostRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRevisions mocks base method.
+func (m *MockPostRevisionRepository) GetRevisions(ctx context.Context, postID uuid.UUID) ([]db.PostRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, postID)
+	ret0, _ := ret[0].([]db.PostRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockPostRevisionRepositoryMockRecorder) GetRevisions(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockPostRevisionRepository)(nil).GetRevisions), ctx, postID)
+}
+
+// Save mocks base method.
+func (m *MockPostRevisionRepository) Save(ctx context.Context, txn helper.Transaction, postID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, txn, postID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPostRevisionRepositoryMockRecorder) Save(ctx, txn, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPostRevisionRepository)(nil).Save), ctx, txn, postID)
+}
@@ -6,6 +6,9 @@ package mocks
 
 import (
 	context "context"
+	db "post-api/story/models/db"
+	request "post-api/story/models/request"
+	response "post-api/story/models/response"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +39,213 @@ func (m *MockPostService) EXPECT() *MockPostServiceMockRecorder {
 	return m.recorder
 }
 
+// Comment mocks base method.
+func (m *MockPostService) Comment(ctx context.Context, comment request.Comment) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Comment", ctx, comment)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// Comment indicates an expected call of Comment.
+func (mr *MockPostServiceMockRecorder) Comment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comment", reflect.TypeOf((*MockPostService)(nil).Comment), ctx, comment)
+}
+
+// Delete mocks base method.
+func (m *MockPostService) Delete(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPostServiceMockRecorder) Delete(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostService)(nil).Delete), ctx, postID, userID)
+}
+
+// DeleteComment mocks base method.
+func (m *MockPostService) DeleteComment(ctx context.Context, commentID, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, commentID, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockPostServiceMockRecorder) DeleteComment(ctx, commentID, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockPostService)(nil).DeleteComment), ctx, commentID, postID, userID)
+}
+
+// EditPost mocks base method.
+func (m *MockPostService) EditPost(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditPost", ctx, postID, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// EditPost indicates an expected call of EditPost.
+func (mr *MockPostServiceMockRecorder) EditPost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditPost", reflect.TypeOf((*MockPostService)(nil).EditPost), ctx, postID, userID)
+}
+
+// ExportMarkdown mocks base method.
+func (m *MockPostService) ExportMarkdown(ctx context.Context, postID, userID uuid.UUID) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMarkdown", ctx, postID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// ExportMarkdown indicates an expected call of ExportMarkdown.
+func (mr *MockPostServiceMockRecorder) ExportMarkdown(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMarkdown", reflect.TypeOf((*MockPostService)(nil).ExportMarkdown), ctx, postID, userID)
+}
+
+// FetchPostsByInterests mocks base method.
+func (m *MockPostService) FetchPostsByInterests(ctx context.Context, interestRequest request.InterestRequest, userID uuid.UUID) ([]response.PostView, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPostsByInterests", ctx, interestRequest, userID)
+	ret0, _ := ret[0].([]response.PostView)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// FetchPostsByInterests indicates an expected call of FetchPostsByInterests.
+func (mr *MockPostServiceMockRecorder) FetchPostsByInterests(ctx, interestRequest, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPostsByInterests", reflect.TypeOf((*MockPostService)(nil).FetchPostsByInterests), ctx, interestRequest, userID)
+}
+
+// FetchSavedPosts mocks base method.
+func (m *MockPostService) FetchSavedPosts(ctx context.Context, postRequest request.PostRequest) ([]response.PostView, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSavedPosts", ctx, postRequest)
+	ret0, _ := ret[0].([]response.PostView)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// FetchSavedPosts indicates an expected call of FetchSavedPosts.
+func (mr *MockPostServiceMockRecorder) FetchSavedPosts(ctx, postRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSavedPosts", reflect.TypeOf((*MockPostService)(nil).FetchSavedPosts), ctx, postRequest)
+}
+
+// FetchViewedPosts mocks base method.
+func (m *MockPostService) FetchViewedPosts(ctx context.Context, postRequest request.PostRequest) ([]response.PostView, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchViewedPosts", ctx, postRequest)
+	ret0, _ := ret[0].([]response.PostView)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// FetchViewedPosts indicates an expected call of FetchViewedPosts.
+func (mr *MockPostServiceMockRecorder) FetchViewedPosts(ctx, postRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchViewedPosts", reflect.TypeOf((*MockPostService)(nil).FetchViewedPosts), ctx, postRequest)
+}
+
+// GetComments mocks base method.
+func (m *MockPostService) GetComments(ctx context.Context, commentsRequest request.FetchComments) ([]response.Comment, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, commentsRequest)
+	ret0, _ := ret[0].([]response.Comment)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockPostServiceMockRecorder) GetComments(ctx, commentsRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockPostService)(nil).GetComments), ctx, commentsRequest)
+}
+
+// GetHomeFeed mocks base method.
+func (m *MockPostService) GetHomeFeed(ctx context.Context, userID uuid.UUID, limit, offset int) ([]db.HomeFeedPost, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHomeFeed", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]db.HomeFeedPost)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetHomeFeed indicates an expected call of GetHomeFeed.
+func (mr *MockPostServiceMockRecorder) GetHomeFeed(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHomeFeed", reflect.TypeOf((*MockPostService)(nil).GetHomeFeed), ctx, userID, limit, offset)
+}
+
+// GetPost mocks base method.
+func (m *MockPostService) GetPost(ctx context.Context, postId, userId uuid.UUID, withHTML bool) (response.Post, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPost", ctx, postId, userId, withHTML)
+	ret0, _ := ret[0].(response.Post)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPost indicates an expected call of GetPost.
+func (mr *MockPostServiceMockRecorder) GetPost(ctx, postId, userId, withHTML interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockPostService)(nil).GetPost), ctx, postId, userId, withHTML)
+}
+
+// GetPostRevisions mocks base method.
+func (m *MockPostService) GetPostRevisions(ctx context.Context, postID, userID uuid.UUID) ([]db.PostRevision, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostRevisions", ctx, postID, userID)
+	ret0, _ := ret[0].([]db.PostRevision)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPostRevisions indicates an expected call of GetPostRevisions.
+func (mr *MockPostServiceMockRecorder) GetPostRevisions(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostRevisions", reflect.TypeOf((*MockPostService)(nil).GetPostRevisions), ctx, postID, userID)
+}
+
+// GetPublishedPostByUser mocks base method.
+func (m *MockPostService) GetPublishedPostByUser(ctx context.Context, request request.GetPublishedPostRequest) ([]response.PublishedPost, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedPostByUser", ctx, request)
+	ret0, _ := ret[0].([]response.PublishedPost)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPublishedPostByUser indicates an expected call of GetPublishedPostByUser.
+func (mr *MockPostServiceMockRecorder) GetPublishedPostByUser(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedPostByUser", reflect.TypeOf((*MockPostService)(nil).GetPublishedPostByUser), ctx, request)
+}
+
+// GetReplies mocks base method.
+func (m *MockPostService) GetReplies(ctx context.Context, repliesRequest request.FetchReplies) ([]response.Comment, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", ctx, repliesRequest)
+	ret0, _ := ret[0].([]response.Comment)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetReplies indicates an expected call of GetReplies.
+func (mr *MockPostServiceMockRecorder) GetReplies(ctx, repliesRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockPostService)(nil).GetReplies), ctx, repliesRequest)
+}
+
 // LikePost mocks base method.
 func (m *MockPostService) LikePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
 	m.ctrl.T.Helper()
@@ -50,16 +260,132 @@ func (mr *MockPostServiceMockRecorder) LikePost(ctx, postID, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikePost", reflect.TypeOf((*MockPostService)(nil).LikePost), ctx, postID, userID)
 }
 
-// PublishPost mocks base method.
-func (m *MockPostService) PublishPost(ctx context.Context, draftUID, userUUID uuid.UUID) *golaerror.Error {
+// MarkAsViewed mocks base method.
+func (m *MockPostService) MarkAsViewed(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishPost", ctx, draftUID, userUUID)
+	ret := m.ctrl.Call(m, "MarkAsViewed", ctx, postID, userID)
 	ret0, _ := ret[0].(*golaerror.Error)
 	return ret0
 }
 
+// MarkAsViewed indicates an expected call of MarkAsViewed.
+func (mr *MockPostServiceMockRecorder) MarkAsViewed(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsViewed", reflect.TypeOf((*MockPostService)(nil).MarkAsViewed), ctx, postID, userID)
+}
+
+// PublishPost mocks base method.
+func (m *MockPostService) PublishPost(ctx context.Context, draftUID, userUUID uuid.UUID) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishPost", ctx, draftUID, userUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
 // PublishPost indicates an expected call of PublishPost.
 func (mr *MockPostServiceMockRecorder) PublishPost(ctx, draftUID, userUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishPost", reflect.TypeOf((*MockPostService)(nil).PublishPost), ctx, draftUID, userUUID)
 }
+
+// PublishToPublication mocks base method.
+func (m *MockPostService) PublishToPublication(ctx context.Context, draftUID, userUUID, publicationID uuid.UUID, version int64) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishToPublication", ctx, draftUID, userUUID, publicationID, version)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// PublishToPublication indicates an expected call of PublishToPublication.
+func (mr *MockPostServiceMockRecorder) PublishToPublication(ctx, draftUID, userUUID, publicationID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishToPublication", reflect.TypeOf((*MockPostService)(nil).PublishToPublication), ctx, draftUID, userUUID, publicationID, version)
+}
+
+// RemovePostBookmark mocks base method.
+func (m *MockPostService) RemovePostBookmark(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePostBookmark", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// RemovePostBookmark indicates an expected call of RemovePostBookmark.
+func (mr *MockPostServiceMockRecorder) RemovePostBookmark(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePostBookmark", reflect.TypeOf((*MockPostService)(nil).RemovePostBookmark), ctx, postID, userID)
+}
+
+// RepublishPost mocks base method.
+func (m *MockPostService) RepublishPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepublishPost", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// RepublishPost indicates an expected call of RepublishPost.
+func (mr *MockPostServiceMockRecorder) RepublishPost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepublishPost", reflect.TypeOf((*MockPostService)(nil).RepublishPost), ctx, postID, userID)
+}
+
+// ResolvePost mocks base method.
+func (m *MockPostService) ResolvePost(ctx context.Context, url string, userID uuid.UUID, withHTML bool) (response.ResolvedPost, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePost", ctx, url, userID, withHTML)
+	ret0, _ := ret[0].(response.ResolvedPost)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// ResolvePost indicates an expected call of ResolvePost.
+func (mr *MockPostServiceMockRecorder) ResolvePost(ctx, url, userID, withHTML interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePost", reflect.TypeOf((*MockPostService)(nil).ResolvePost), ctx, url, userID, withHTML)
+}
+
+// SavePost mocks base method.
+func (m *MockPostService) SavePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePost", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// SavePost indicates an expected call of SavePost.
+func (mr *MockPostServiceMockRecorder) SavePost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePost", reflect.TypeOf((*MockPostService)(nil).SavePost), ctx, postID, userID)
+}
+
+// SetSlug mocks base method.
+func (m *MockPostService) SetSlug(ctx context.Context, postID, userID uuid.UUID, slug string) (string, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSlug", ctx, postID, userID, slug)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// SetSlug indicates an expected call of SetSlug.
+func (mr *MockPostServiceMockRecorder) SetSlug(ctx, postID, userID, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSlug", reflect.TypeOf((*MockPostService)(nil).SetSlug), ctx, postID, userID, slug)
+}
+
+// UnLikePost mocks base method.
+func (m *MockPostService) UnLikePost(ctx context.Context, postUID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnLikePost", ctx, postUID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// UnLikePost indicates an expected call of UnLikePost.
+func (mr *MockPostServiceMockRecorder) UnLikePost(ctx, postUID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnLikePost", reflect.TypeOf((*MockPostService)(nil).UnLikePost), ctx, postUID, userID)
+}
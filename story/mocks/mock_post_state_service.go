@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: post_state_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockPostStateService is a mock of PostStateService interface.
+type MockPostStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostStateServiceMockRecorder
+}
+
+// MockPostStateServiceMockRecorder is the mock recorder for MockPostStateService.
+type MockPostStateServiceMockRecorder struct {
+	mock *MockPostStateService
+}
+
+// NewMockPostStateService creates a new mock instance.
+func NewMockPostStateService(ctrl *gomock.Controller) *MockPostStateService {
+	mock := &MockPostStateService{ctrl: ctrl}
+	mock.recorder = &MockPostStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostStateService) EXPECT() *MockPostStateServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPosts mocks base method.
+func (m *MockPostStateService) GetPosts(ctx context.Context, userID uuid.UUID, state string) ([]db.AuthorPost, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosts", ctx, userID, state)
+	ret0, _ := ret[0].([]db.AuthorPost)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPosts indicates an expected call of GetPosts.
+func (mr *MockPostStateServiceMockRecorder) GetPosts(ctx, userID, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosts", reflect.TypeOf((*MockPostStateService)(nil).GetPosts), ctx, userID, state)
+}
+
+// RestorePost mocks base method.
+func (m *MockPostStateService) RestorePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePost", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// RestorePost indicates an expected call of RestorePost.
+func (mr *MockPostStateServiceMockRecorder) RestorePost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePost", reflect.TypeOf((*MockPostStateService)(nil).RestorePost), ctx, postID, userID)
+}
+
+// SetState mocks base method.
+func (m *MockPostStateService) SetState(ctx context.Context, postID, userID uuid.UUID, state string) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetState", ctx, postID, userID, state)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// SetState indicates an expected call of SetState.
+func (mr *MockPostStateServiceMockRecorder) SetState(ctx, postID, userID, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetState", reflect.TypeOf((*MockPostStateService)(nil).SetState), ctx, postID, userID, state)
+}
@@ -7,8 +7,12 @@ package mocks
 import (
 	context "context"
 	helper "post-api/helper"
+	models "post-api/story/models"
 	db "post-api/story/models/db"
+	request "post-api/story/models/request"
+	response "post-api/story/models/response"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -51,6 +55,49 @@ func (mr *MockPostsRepositoryMockRecorder) AddInterests(ctx, transaction, postID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInterests", reflect.TypeOf((*MockPostsRepository)(nil).AddInterests), ctx, transaction, postID, interests)
 }
 
+// BookmarkPost mocks base method.
+func (m *MockPostsRepository) BookmarkPost(ctx context.Context, postID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookmarkPost", ctx, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BookmarkPost indicates an expected call of BookmarkPost.
+func (mr *MockPostsRepositoryMockRecorder) BookmarkPost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookmarkPost", reflect.TypeOf((*MockPostsRepository)(nil).BookmarkPost), ctx, postID, userID)
+}
+
+// ClearPins mocks base method.
+func (m *MockPostsRepository) ClearPins(ctx context.Context, txn helper.Transaction, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPins", ctx, txn, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPins indicates an expected call of ClearPins.
+func (mr *MockPostsRepositoryMockRecorder) ClearPins(ctx, txn, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPins", reflect.TypeOf((*MockPostsRepository)(nil).ClearPins), ctx, txn, userID)
+}
+
+// Comment mocks base method.
+func (m *MockPostsRepository) Comment(ctx context.Context, tx helper.Transaction, comment request.Comment) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Comment", ctx, tx, comment)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Comment indicates an expected call of Comment.
+func (mr *MockPostsRepositoryMockRecorder) Comment(ctx, tx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comment", reflect.TypeOf((*MockPostsRepository)(nil).Comment), ctx, tx, comment)
+}
+
 // CreatePost mocks base method.
 func (m *MockPostsRepository) CreatePost(ctx context.Context, tx helper.Transaction, post db.PublishPost) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +113,289 @@ func (mr *MockPostsRepositoryMockRecorder) CreatePost(ctx, tx, post interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockPostsRepository)(nil).CreatePost), ctx, tx, post)
 }
 
+// Delete mocks base method.
+func (m *MockPostsRepository) Delete(ctx context.Context, postID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPostsRepositoryMockRecorder) Delete(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostsRepository)(nil).Delete), ctx, postID, userID)
+}
+
+// DeleteComment mocks base method.
+func (m *MockPostsRepository) DeleteComment(ctx context.Context, tx helper.Transaction, commentID, postID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, tx, commentID, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockPostsRepositoryMockRecorder) DeleteComment(ctx, tx, commentID, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockPostsRepository)(nil).DeleteComment), ctx, tx, commentID, postID, userID)
+}
+
+// FetchComments mocks base method.
+func (m *MockPostsRepository) FetchComments(ctx context.Context, commentsRequest request.FetchComments, maxDisplayDepth int) ([]response.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchComments", ctx, commentsRequest, maxDisplayDepth)
+	ret0, _ := ret[0].([]response.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchComments indicates an expected call of FetchComments.
+func (mr *MockPostsRepositoryMockRecorder) FetchComments(ctx, commentsRequest, maxDisplayDepth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchComments", reflect.TypeOf((*MockPostsRepository)(nil).FetchComments), ctx, commentsRequest, maxDisplayDepth)
+}
+
+// FetchPost mocks base method.
+func (m *MockPostsRepository) FetchPost(ctx context.Context, postId, userId uuid.UUID) (response.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPost", ctx, postId, userId)
+	ret0, _ := ret[0].(response.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPost indicates an expected call of FetchPost.
+func (mr *MockPostsRepositoryMockRecorder) FetchPost(ctx, postId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPost", reflect.TypeOf((*MockPostsRepository)(nil).FetchPost), ctx, postId, userId)
+}
+
+// FetchPostsByInterests mocks base method.
+func (m *MockPostsRepository) FetchPostsByInterests(ctx context.Context, interestRequest request.InterestRequest, userID uuid.UUID) ([]response.PostView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPostsByInterests", ctx, interestRequest, userID)
+	ret0, _ := ret[0].([]response.PostView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPostsByInterests indicates an expected call of FetchPostsByInterests.
+func (mr *MockPostsRepositoryMockRecorder) FetchPostsByInterests(ctx, interestRequest, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPostsByInterests", reflect.TypeOf((*MockPostsRepository)(nil).FetchPostsByInterests), ctx, interestRequest, userID)
+}
+
+// FetchReadLater mocks base method.
+func (m *MockPostsRepository) FetchReadLater(ctx context.Context, postRequest request.PostRequest) ([]response.PostView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchReadLater", ctx, postRequest)
+	ret0, _ := ret[0].([]response.PostView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchReadLater indicates an expected call of FetchReadLater.
+func (mr *MockPostsRepositoryMockRecorder) FetchReadLater(ctx, postRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchReadLater", reflect.TypeOf((*MockPostsRepository)(nil).FetchReadLater), ctx, postRequest)
+}
+
+// FetchReplies mocks base method.
+func (m *MockPostsRepository) FetchReplies(ctx context.Context, repliesRequest request.FetchReplies, maxDisplayDepth int) ([]response.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchReplies", ctx, repliesRequest, maxDisplayDepth)
+	ret0, _ := ret[0].([]response.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchReplies indicates an expected call of FetchReplies.
+func (mr *MockPostsRepositoryMockRecorder) FetchReplies(ctx, repliesRequest, maxDisplayDepth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchReplies", reflect.TypeOf((*MockPostsRepository)(nil).FetchReplies), ctx, repliesRequest, maxDisplayDepth)
+}
+
+// FetchThreadReplies mocks base method.
+func (m *MockPostsRepository) FetchThreadReplies(ctx context.Context, repliesRequest request.FetchReplies) ([]response.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchThreadReplies", ctx, repliesRequest)
+	ret0, _ := ret[0].([]response.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchThreadReplies indicates an expected call of FetchThreadReplies.
+func (mr *MockPostsRepositoryMockRecorder) FetchThreadReplies(ctx, repliesRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchThreadReplies", reflect.TypeOf((*MockPostsRepository)(nil).FetchThreadReplies), ctx, repliesRequest)
+}
+
+// FetchViewedPosts mocks base method.
+func (m *MockPostsRepository) FetchViewedPosts(ctx context.Context, postRequest request.PostRequest) ([]response.PostView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchViewedPosts", ctx, postRequest)
+	ret0, _ := ret[0].([]response.PostView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchViewedPosts indicates an expected call of FetchViewedPosts.
+func (mr *MockPostsRepositoryMockRecorder) FetchViewedPosts(ctx, postRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchViewedPosts", reflect.TypeOf((*MockPostsRepository)(nil).FetchViewedPosts), ctx, postRequest)
+}
+
+// GetComment mocks base method.
+func (m *MockPostsRepository) GetComment(ctx context.Context, commentID, postID uuid.UUID) (response.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", ctx, commentID, postID)
+	ret0, _ := ret[0].(response.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockPostsRepositoryMockRecorder) GetComment(ctx, commentID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockPostsRepository)(nil).GetComment), ctx, commentID, postID)
+}
+
+// GetHomeFeed mocks base method.
+func (m *MockPostsRepository) GetHomeFeed(ctx context.Context, userID uuid.UUID, limit, offset int) ([]db.HomeFeedPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHomeFeed", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]db.HomeFeedPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHomeFeed indicates an expected call of GetHomeFeed.
+func (mr *MockPostsRepositoryMockRecorder) GetHomeFeed(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHomeFeed", reflect.TypeOf((*MockPostsRepository)(nil).GetHomeFeed), ctx, userID, limit, offset)
+}
+
+// GetLastPinnedPosition mocks base method.
+func (m *MockPostsRepository) GetLastPinnedPosition(ctx context.Context, txn helper.Transaction, userID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastPinnedPosition", ctx, txn, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastPinnedPosition indicates an expected call of GetLastPinnedPosition.
+func (mr *MockPostsRepositoryMockRecorder) GetLastPinnedPosition(ctx, txn, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastPinnedPosition", reflect.TypeOf((*MockPostsRepository)(nil).GetLastPinnedPosition), ctx, txn, userID)
+}
+
+// GetPinnedPostIDs mocks base method.
+func (m *MockPostsRepository) GetPinnedPostIDs(ctx context.Context, txn helper.Transaction, userID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPinnedPostIDs", ctx, txn, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPinnedPostIDs indicates an expected call of GetPinnedPostIDs.
+func (mr *MockPostsRepositoryMockRecorder) GetPinnedPostIDs(ctx, txn, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPinnedPostIDs", reflect.TypeOf((*MockPostsRepository)(nil).GetPinnedPostIDs), ctx, txn, userID)
+}
+
+// GetPostDraftID mocks base method.
+func (m *MockPostsRepository) GetPostDraftID(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostDraftID", ctx, postID, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostDraftID indicates an expected call of GetPostDraftID.
+func (mr *MockPostsRepositoryMockRecorder) GetPostDraftID(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostDraftID", reflect.TypeOf((*MockPostsRepository)(nil).GetPostDraftID), ctx, postID, userID)
+}
+
+// GetPostPublicationID mocks base method.
+func (m *MockPostsRepository) GetPostPublicationID(ctx context.Context, postID uuid.UUID) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostPublicationID", ctx, postID)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostPublicationID indicates an expected call of GetPostPublicationID.
+func (mr *MockPostsRepositoryMockRecorder) GetPostPublicationID(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostPublicationID", reflect.TypeOf((*MockPostsRepository)(nil).GetPostPublicationID), ctx, postID)
+}
+
+// GetPostsByState mocks base method.
+func (m *MockPostsRepository) GetPostsByState(ctx context.Context, userID uuid.UUID, state string) ([]db.AuthorPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsByState", ctx, userID, state)
+	ret0, _ := ret[0].([]db.AuthorPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByState indicates an expected call of GetPostsByState.
+func (mr *MockPostsRepositoryMockRecorder) GetPostsByState(ctx, userID, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByState", reflect.TypeOf((*MockPostsRepository)(nil).GetPostsByState), ctx, userID, state)
+}
+
+// GetPublicationPostID mocks base method.
+func (m *MockPostsRepository) GetPublicationPostID(ctx context.Context, draftID, publicationID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicationPostID", ctx, draftID, publicationID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicationPostID indicates an expected call of GetPublicationPostID.
+func (mr *MockPostsRepositoryMockRecorder) GetPublicationPostID(ctx, draftID, publicationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicationPostID", reflect.TypeOf((*MockPostsRepository)(nil).GetPublicationPostID), ctx, draftID, publicationID)
+}
+
+// GetPublishedPostByUser mocks base method.
+func (m *MockPostsRepository) GetPublishedPostByUser(ctx context.Context, request request.GetPublishedPostRequest) ([]response.PublishedPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedPostByUser", ctx, request)
+	ret0, _ := ret[0].([]response.PublishedPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedPostByUser indicates an expected call of GetPublishedPostByUser.
+func (mr *MockPostsRepositoryMockRecorder) GetPublishedPostByUser(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedPostByUser", reflect.TypeOf((*MockPostsRepository)(nil).GetPublishedPostByUser), ctx, request)
+}
+
+// GetVisibleAuthorID mocks base method.
+func (m *MockPostsRepository) GetVisibleAuthorID(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibleAuthorID", ctx, postID, userID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisibleAuthorID indicates an expected call of GetVisibleAuthorID.
+func (mr *MockPostsRepositoryMockRecorder) GetVisibleAuthorID(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibleAuthorID", reflect.TypeOf((*MockPostsRepository)(nil).GetVisibleAuthorID), ctx, postID, userID)
+}
+
 // Like mocks base method.
 func (m *MockPostsRepository) Like(ctx context.Context, postID, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -80,6 +410,104 @@ func (mr *MockPostsRepositoryMockRecorder) Like(ctx, postID, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Like", reflect.TypeOf((*MockPostsRepository)(nil).Like), ctx, postID, userID)
 }
 
+// LockPins mocks base method.
+func (m *MockPostsRepository) LockPins(ctx context.Context, txn helper.Transaction, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPins", ctx, txn, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPins indicates an expected call of LockPins.
+func (mr *MockPostsRepositoryMockRecorder) LockPins(ctx, txn, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPins", reflect.TypeOf((*MockPostsRepository)(nil).LockPins), ctx, txn, userID)
+}
+
+// MarkAsViewed mocks base method.
+func (m *MockPostsRepository) MarkAsViewed(ctx context.Context, postID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsViewed", ctx, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsViewed indicates an expected call of MarkAsViewed.
+func (mr *MockPostsRepositoryMockRecorder) MarkAsViewed(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsViewed", reflect.TypeOf((*MockPostsRepository)(nil).MarkAsViewed), ctx, postID, userID)
+}
+
+// Pin mocks base method.
+func (m *MockPostsRepository) Pin(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, txn, postID, userID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin.
+func (mr *MockPostsRepositoryMockRecorder) Pin(ctx, txn, postID, userID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockPostsRepository)(nil).Pin), ctx, txn, postID, userID, position)
+}
+
+// RemoveInterests mocks base method.
+func (m *MockPostsRepository) RemoveInterests(ctx context.Context, txn helper.Transaction, postID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveInterests", ctx, txn, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveInterests indicates an expected call of RemoveInterests.
+func (mr *MockPostsRepositoryMockRecorder) RemoveInterests(ctx, txn, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInterests", reflect.TypeOf((*MockPostsRepository)(nil).RemoveInterests), ctx, txn, postID)
+}
+
+// RemovePostBookmark mocks base method.
+func (m *MockPostsRepository) RemovePostBookmark(ctx context.Context, postID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePostBookmark", ctx, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePostBookmark indicates an expected call of RemovePostBookmark.
+func (mr *MockPostsRepositoryMockRecorder) RemovePostBookmark(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePostBookmark", reflect.TypeOf((*MockPostsRepository)(nil).RemovePostBookmark), ctx, postID, userID)
+}
+
+// Restore mocks base method.
+func (m *MockPostsRepository) Restore(ctx context.Context, postID, userID uuid.UUID, retention time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, postID, userID, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockPostsRepositoryMockRecorder) Restore(ctx, postID, userID, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockPostsRepository)(nil).Restore), ctx, postID, userID, retention)
+}
+
+// SetState mocks base method.
+func (m *MockPostsRepository) SetState(ctx context.Context, postID, userID uuid.UUID, state string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetState", ctx, postID, userID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetState indicates an expected call of SetState.
+func (mr *MockPostsRepositoryMockRecorder) SetState(ctx, postID, userID, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetState", reflect.TypeOf((*MockPostsRepository)(nil).SetState), ctx, postID, userID, state)
+}
+
 // UnLike mocks base method.
 func (m *MockPostsRepository) UnLike(ctx context.Context, postID, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -93,3 +521,31 @@ func (mr *MockPostsRepositoryMockRecorder) UnLike(ctx, postID, userID interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnLike", reflect.TypeOf((*MockPostsRepository)(nil).UnLike), ctx, postID, userID)
 }
+
+// Unpin mocks base method.
+func (m *MockPostsRepository) Unpin(ctx context.Context, postID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin.
+func (mr *MockPostsRepositoryMockRecorder) Unpin(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockPostsRepository)(nil).Unpin), ctx, postID, userID)
+}
+
+// UpdatePost mocks base method.
+func (m *MockPostsRepository) UpdatePost(ctx context.Context, txn helper.Transaction, postID uuid.UUID, data models.JSONString) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePost", ctx, txn, postID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePost indicates an expected call of UpdatePost.
+func (mr *MockPostsRepositoryMockRecorder) UpdatePost(ctx, txn, postID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockPostsRepository)(nil).UpdatePost), ctx, txn, postID, data)
+}
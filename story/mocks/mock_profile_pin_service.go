@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile_pin_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockProfilePinService is a mock of ProfilePinService interface.
+type MockProfilePinService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfilePinServiceMockRecorder
+}
+
+// MockProfilePinServiceMockRecorder is the mock recorder for MockProfilePinService.
+type MockProfilePinServiceMockRecorder struct {
+	mock *MockProfilePinService
+}
+
+// NewMockProfilePinService creates a new mock instance.
+func NewMockProfilePinService(ctrl *gomock.Controller) *MockProfilePinService {
+	mock := &MockProfilePinService{ctrl: ctrl}
+	mock.recorder = &MockProfilePinServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfilePinService) EXPECT() *MockProfilePinServiceMockRecorder {
+	return m.recorder
+}
+
+// PinPost mocks base method.
+func (m *MockProfilePinService) PinPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinPost", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// PinPost indicates an expected call of PinPost.
+func (mr *MockProfilePinServiceMockRecorder) PinPost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinPost", reflect.TypeOf((*MockProfilePinService)(nil).PinPost), ctx, postID, userID)
+}
+
+// SetPins mocks base method.
+func (m *MockProfilePinService) SetPins(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPins", ctx, userID, postIDs)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// SetPins indicates an expected call of SetPins.
+func (mr *MockProfilePinServiceMockRecorder) SetPins(ctx, userID, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPins", reflect.TypeOf((*MockProfilePinService)(nil).SetPins), ctx, userID, postIDs)
+}
+
+// UnpinPost mocks base method.
+func (m *MockProfilePinService) UnpinPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinPost", ctx, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// UnpinPost indicates an expected call of UnpinPost.
+func (mr *MockProfilePinServiceMockRecorder) UnpinPost(ctx, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinPost", reflect.TypeOf((*MockProfilePinService)(nil).UnpinPost), ctx, postID, userID)
+}
@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: publication_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	response "post-api/story/models/response"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPublicationRepository is a mock of PublicationRepository interface.
+type MockPublicationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicationRepositoryMockRecorder
+}
+
+// MockPublicationRepositoryMockRecorder is the mock recorder for MockPublicationRepository.
+type MockPublicationRepositoryMockRecorder struct {
+	mock *MockPublicationRepository
+}
+
+// NewMockPublicationRepository creates a new mock instance.
+func NewMockPublicationRepository(ctrl *gomock.Controller) *MockPublicationRepository {
+	mock := &MockPublicationRepository{ctrl: ctrl}
+	mock.recorder = &MockPublicationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicationRepository) EXPECT() *MockPublicationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockPublicationRepository) AcceptInvite(ctx context.Context, publicationID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, publicationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockPublicationRepositoryMockRecorder) AcceptInvite(ctx, publicationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockPublicationRepository)(nil).AcceptInvite), ctx, publicationID, userID)
+}
+
+// Create mocks base method.
+func (m *MockPublicationRepository) Create(ctx context.Context, publication db.Publication) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, publication)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPublicationRepositoryMockRecorder) Create(ctx, publication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPublicationRepository)(nil).Create), ctx, publication)
+}
+
+// CreateSubmission mocks base method.
+func (m *MockPublicationRepository) CreateSubmission(ctx context.Context, submission db.PublicationSubmission) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubmission", ctx, submission)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubmission indicates an expected call of CreateSubmission.
+func (mr *MockPublicationRepositoryMockRecorder) CreateSubmission(ctx, submission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubmission", reflect.TypeOf((*MockPublicationRepository)(nil).CreateSubmission), ctx, submission)
+}
+
+// GetMember mocks base method.
+func (m *MockPublicationRepository) GetMember(ctx context.Context, publicationID, userID uuid.UUID) (db.PublicationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, publicationID, userID)
+	ret0, _ := ret[0].(db.PublicationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockPublicationRepositoryMockRecorder) GetMember(ctx, publicationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockPublicationRepository)(nil).GetMember), ctx, publicationID, userID)
+}
+
+// GetMembers mocks base method.
+func (m *MockPublicationRepository) GetMembers(ctx context.Context, publicationID uuid.UUID) ([]db.PublicationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, publicationID)
+	ret0, _ := ret[0].([]db.PublicationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockPublicationRepositoryMockRecorder) GetMembers(ctx, publicationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockPublicationRepository)(nil).GetMembers), ctx, publicationID)
+}
+
+// GetPublication mocks base method.
+func (m *MockPublicationRepository) GetPublication(ctx context.Context, publicationID uuid.UUID) (db.Publication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublication", ctx, publicationID)
+	ret0, _ := ret[0].(db.Publication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublication indicates an expected call of GetPublication.
+func (mr *MockPublicationRepositoryMockRecorder) GetPublication(ctx, publicationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublication", reflect.TypeOf((*MockPublicationRepository)(nil).GetPublication), ctx, publicationID)
+}
+
+// GetPublicationPosts mocks base method.
+func (m *MockPublicationRepository) GetPublicationPosts(ctx context.Context, publicationID uuid.UUID, limit, offset int) ([]response.PublishedPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicationPosts", ctx, publicationID, limit, offset)
+	ret0, _ := ret[0].([]response.PublishedPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicationPosts indicates an expected call of GetPublicationPosts.
+func (mr *MockPublicationRepositoryMockRecorder) GetPublicationPosts(ctx, publicationID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicationPosts", reflect.TypeOf((*MockPublicationRepository)(nil).GetPublicationPosts), ctx, publicationID, limit, offset)
+}
+
+// GetSubmission mocks base method.
+func (m *MockPublicationRepository) GetSubmission(ctx context.Context, publicationID, submissionID uuid.UUID) (db.PublicationSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmission", ctx, publicationID, submissionID)
+	ret0, _ := ret[0].(db.PublicationSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmission indicates an expected call of GetSubmission.
+func (mr *MockPublicationRepositoryMockRecorder) GetSubmission(ctx, publicationID, submissionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmission", reflect.TypeOf((*MockPublicationRepository)(nil).GetSubmission), ctx, publicationID, submissionID)
+}
+
+// GetSubmissionDraft mocks base method.
+func (m *MockPublicationRepository) GetSubmissionDraft(ctx context.Context, publicationID, submissionID uuid.UUID) (db.Draft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissionDraft", ctx, publicationID, submissionID)
+	ret0, _ := ret[0].(db.Draft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmissionDraft indicates an expected call of GetSubmissionDraft.
+func (mr *MockPublicationRepositoryMockRecorder) GetSubmissionDraft(ctx, publicationID, submissionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissionDraft", reflect.TypeOf((*MockPublicationRepository)(nil).GetSubmissionDraft), ctx, publicationID, submissionID)
+}
+
+// GetSubmissions mocks base method.
+func (m *MockPublicationRepository) GetSubmissions(ctx context.Context, publicationID uuid.UUID, status string) ([]db.PublicationSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissions", ctx, publicationID, status)
+	ret0, _ := ret[0].([]db.PublicationSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmissions indicates an expected call of GetSubmissions.
+func (mr *MockPublicationRepositoryMockRecorder) GetSubmissions(ctx, publicationID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissions", reflect.TypeOf((*MockPublicationRepository)(nil).GetSubmissions), ctx, publicationID, status)
+}
+
+// GetSubmissionsByAuthor mocks base method.
+func (m *MockPublicationRepository) GetSubmissionsByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.PublicationSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissionsByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]db.PublicationSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubmissionsByAuthor indicates an expected call of GetSubmissionsByAuthor.
+func (mr *MockPublicationRepositoryMockRecorder) GetSubmissionsByAuthor(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissionsByAuthor", reflect.TypeOf((*MockPublicationRepository)(nil).GetSubmissionsByAuthor), ctx, authorID)
+}
+
+// HasPendingSubmission mocks base method.
+func (m *MockPublicationRepository) HasPendingSubmission(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPendingSubmission", ctx, draftID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPendingSubmission indicates an expected call of HasPendingSubmission.
+func (mr *MockPublicationRepositoryMockRecorder) HasPendingSubmission(ctx, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPendingSubmission", reflect.TypeOf((*MockPublicationRepository)(nil).HasPendingSubmission), ctx, draftID)
+}
+
+// InviteMember mocks base method.
+func (m *MockPublicationRepository) InviteMember(ctx context.Context, member db.PublicationMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteMember indicates an expected call of InviteMember.
+func (mr *MockPublicationRepositoryMockRecorder) InviteMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteMember", reflect.TypeOf((*MockPublicationRepository)(nil).InviteMember), ctx, member)
+}
+
+// UpdateSubmissionStatus mocks base method.
+func (m *MockPublicationRepository) UpdateSubmissionStatus(ctx context.Context, submissionID uuid.UUID, fromStatus, toStatus string, reviewerID uuid.UUID, feedback *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubmissionStatus", ctx, submissionID, fromStatus, toStatus, reviewerID, feedback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubmissionStatus indicates an expected call of UpdateSubmissionStatus.
+func (mr *MockPublicationRepositoryMockRecorder) UpdateSubmissionStatus(ctx, submissionID, fromStatus, toStatus, reviewerID, feedback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubmissionStatus", reflect.TypeOf((*MockPublicationRepository)(nil).UpdateSubmissionStatus), ctx, submissionID, fromStatus, toStatus, reviewerID, feedback)
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: publication_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	request "post-api/story/models/request"
+	response "post-api/story/models/response"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockPublicationService is a mock of PublicationService interface.
+type MockPublicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicationServiceMockRecorder
+}
+
+// MockPublicationServiceMockRecorder is the mock recorder for MockPublicationService.
+type MockPublicationServiceMockRecorder struct {
+	mock *MockPublicationService
+}
+
+// NewMockPublicationService creates a new mock instance.
+func NewMockPublicationService(ctrl *gomock.Controller) *MockPublicationService {
+	mock := &MockPublicationService{ctrl: ctrl}
+	mock.recorder = &MockPublicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicationService) EXPECT() *MockPublicationServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockPublicationService) AcceptInvite(ctx context.Context, publicationID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, publicationID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockPublicationServiceMockRecorder) AcceptInvite(ctx, publicationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockPublicationService)(nil).AcceptInvite), ctx, publicationID, userID)
+}
+
+// CreatePublication mocks base method.
+func (m *MockPublicationService) CreatePublication(ctx context.Context, publicationRequest request.CreatePublicationRequest) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePublication", ctx, publicationRequest)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// CreatePublication indicates an expected call of CreatePublication.
+func (mr *MockPublicationServiceMockRecorder) CreatePublication(ctx, publicationRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePublication", reflect.TypeOf((*MockPublicationService)(nil).CreatePublication), ctx, publicationRequest)
+}
+
+// GetPublication mocks base method.
+func (m *MockPublicationService) GetPublication(ctx context.Context, publicationID uuid.UUID) (response.Publication, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublication", ctx, publicationID)
+	ret0, _ := ret[0].(response.Publication)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPublication indicates an expected call of GetPublication.
+func (mr *MockPublicationServiceMockRecorder) GetPublication(ctx, publicationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublication", reflect.TypeOf((*MockPublicationService)(nil).GetPublication), ctx, publicationID)
+}
+
+// GetPublicationPosts mocks base method.
+func (m *MockPublicationService) GetPublicationPosts(ctx context.Context, publicationID uuid.UUID, limit, offset int) ([]response.PublishedPost, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicationPosts", ctx, publicationID, limit, offset)
+	ret0, _ := ret[0].([]response.PublishedPost)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetPublicationPosts indicates an expected call of GetPublicationPosts.
+func (mr *MockPublicationServiceMockRecorder) GetPublicationPosts(ctx, publicationID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicationPosts", reflect.TypeOf((*MockPublicationService)(nil).GetPublicationPosts), ctx, publicationID, limit, offset)
+}
+
+// GetSubmissionDraft mocks base method.
+func (m *MockPublicationService) GetSubmissionDraft(ctx context.Context, publicationID, submissionID, userID uuid.UUID) (db.Draft, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissionDraft", ctx, publicationID, submissionID, userID)
+	ret0, _ := ret[0].(db.Draft)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetSubmissionDraft indicates an expected call of GetSubmissionDraft.
+func (mr *MockPublicationServiceMockRecorder) GetSubmissionDraft(ctx, publicationID, submissionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissionDraft", reflect.TypeOf((*MockPublicationService)(nil).GetSubmissionDraft), ctx, publicationID, submissionID, userID)
+}
+
+// GetSubmissions mocks base method.
+func (m *MockPublicationService) GetSubmissions(ctx context.Context, publicationID, userID uuid.UUID, status string) ([]db.PublicationSubmission, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissions", ctx, publicationID, userID, status)
+	ret0, _ := ret[0].([]db.PublicationSubmission)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetSubmissions indicates an expected call of GetSubmissions.
+func (mr *MockPublicationServiceMockRecorder) GetSubmissions(ctx, publicationID, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissions", reflect.TypeOf((*MockPublicationService)(nil).GetSubmissions), ctx, publicationID, userID, status)
+}
+
+// GetSubmissionsByAuthor mocks base method.
+func (m *MockPublicationService) GetSubmissionsByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.PublicationSubmission, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubmissionsByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]db.PublicationSubmission)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetSubmissionsByAuthor indicates an expected call of GetSubmissionsByAuthor.
+func (mr *MockPublicationServiceMockRecorder) GetSubmissionsByAuthor(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissionsByAuthor", reflect.TypeOf((*MockPublicationService)(nil).GetSubmissionsByAuthor), ctx, authorID)
+}
+
+// InviteMember mocks base method.
+func (m *MockPublicationService) InviteMember(ctx context.Context, publicationID, inviterID uuid.UUID, inviteRequest request.InviteMemberRequest) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteMember", ctx, publicationID, inviterID, inviteRequest)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// InviteMember indicates an expected call of InviteMember.
+func (mr *MockPublicationServiceMockRecorder) InviteMember(ctx, publicationID, inviterID, inviteRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteMember", reflect.TypeOf((*MockPublicationService)(nil).InviteMember), ctx, publicationID, inviterID, inviteRequest)
+}
+
+// ReviewSubmission mocks base method.
+func (m *MockPublicationService) ReviewSubmission(ctx context.Context, publicationID, submissionID, reviewerID uuid.UUID, reviewRequest request.ReviewSubmissionRequest) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewSubmission", ctx, publicationID, submissionID, reviewerID, reviewRequest)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// ReviewSubmission indicates an expected call of ReviewSubmission.
+func (mr *MockPublicationServiceMockRecorder) ReviewSubmission(ctx, publicationID, submissionID, reviewerID, reviewRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewSubmission", reflect.TypeOf((*MockPublicationService)(nil).ReviewSubmission), ctx, publicationID, submissionID, reviewerID, reviewRequest)
+}
+
+// SubmitDraft mocks base method.
+func (m *MockPublicationService) SubmitDraft(ctx context.Context, publicationID, userID, draftID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDraft", ctx, publicationID, userID, draftID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// SubmitDraft indicates an expected call of SubmitDraft.
+func (mr *MockPublicationServiceMockRecorder) SubmitDraft(ctx, publicationID, userID, draftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDraft", reflect.TypeOf((*MockPublicationService)(nil).SubmitDraft), ctx, publicationID, userID, draftID)
+}
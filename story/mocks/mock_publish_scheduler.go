@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: publish_scheduler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPublishScheduler is a mock of PublishScheduler interface.
+type MockPublishScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublishSchedulerMockRecorder
+}
+
+// MockPublishSchedulerMockRecorder is the mock recorder for MockPublishScheduler.
+type MockPublishSchedulerMockRecorder struct {
+	mock *MockPublishScheduler
+}
+
+// NewMockPublishScheduler creates a new mock instance.
+func NewMockPublishScheduler(ctrl *gomock.Controller) *MockPublishScheduler {
+	mock := &MockPublishScheduler{ctrl: ctrl}
+	mock.recorder = &MockPublishSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublishScheduler) EXPECT() *MockPublishSchedulerMockRecorder {
+	return m.recorder
+}
+
+// PublishDue mocks base method.
+func (m *MockPublishScheduler) PublishDue(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PublishDue", ctx)
+}
+
+// PublishDue indicates an expected call of PublishDue.
+func (mr *MockPublishSchedulerMockRecorder) PublishDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockPublishScheduler)(nil).PublishDue), ctx)
+}
+
+// Start mocks base method.
+func (m *MockPublishScheduler) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockPublishSchedulerMockRecorder) Start(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockPublishScheduler)(nil).Start), ctx)
+}
@@ -0,0 +1,184 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: series_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	helper "post-api/helper"
+	db "post-api/story/models/db"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSeriesRepository is a mock of SeriesRepository interface.
+type MockSeriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesRepositoryMockRecorder
+}
+
+// MockSeriesRepositoryMockRecorder is the mock recorder for MockSeriesRepository.
+type MockSeriesRepositoryMockRecorder struct {
+	mock *MockSeriesRepository
+}
+
+// NewMockSeriesRepository creates a new mock instance.
+func NewMockSeriesRepository(ctrl *gomock.Controller) *MockSeriesRepository {
+	mock := &MockSeriesRepository{ctrl: ctrl}
+	mock.recorder = &MockSeriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesRepository) EXPECT() *MockSeriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPost mocks base method.
+func (m *MockSeriesRepository) AddPost(ctx context.Context, txn helper.Transaction, seriesID, postID, authorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPost", ctx, txn, seriesID, postID, authorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPost indicates an expected call of AddPost.
+func (mr *MockSeriesRepositoryMockRecorder) AddPost(ctx, txn, seriesID, postID, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPost", reflect.TypeOf((*MockSeriesRepository)(nil).AddPost), ctx, txn, seriesID, postID, authorID)
+}
+
+// Create mocks base method.
+func (m *MockSeriesRepository) Create(ctx context.Context, series db.Series) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, series)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeriesRepositoryMockRecorder) Create(ctx, series interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeriesRepository)(nil).Create), ctx, series)
+}
+
+// GetPositions mocks base method.
+func (m *MockSeriesRepository) GetPositions(ctx context.Context, txn helper.Transaction, seriesID uuid.UUID) ([]db.SeriesPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositions", ctx, txn, seriesID)
+	ret0, _ := ret[0].([]db.SeriesPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockSeriesRepositoryMockRecorder) GetPositions(ctx, txn, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockSeriesRepository)(nil).GetPositions), ctx, txn, seriesID)
+}
+
+// GetSeries mocks base method.
+func (m *MockSeriesRepository) GetSeries(ctx context.Context, seriesID uuid.UUID) (db.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", ctx, seriesID)
+	ret0, _ := ret[0].(db.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockSeriesRepositoryMockRecorder) GetSeries(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockSeriesRepository)(nil).GetSeries), ctx, seriesID)
+}
+
+// GetSeriesByAuthor mocks base method.
+func (m *MockSeriesRepository) GetSeriesByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]db.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesByAuthor indicates an expected call of GetSeriesByAuthor.
+func (mr *MockSeriesRepositoryMockRecorder) GetSeriesByAuthor(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesByAuthor", reflect.TypeOf((*MockSeriesRepository)(nil).GetSeriesByAuthor), ctx, authorID)
+}
+
+// GetSeriesForPost mocks base method.
+func (m *MockSeriesRepository) GetSeriesForPost(ctx context.Context, postID uuid.UUID) (db.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesForPost", ctx, postID)
+	ret0, _ := ret[0].(db.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesForPost indicates an expected call of GetSeriesForPost.
+func (mr *MockSeriesRepositoryMockRecorder) GetSeriesForPost(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesForPost", reflect.TypeOf((*MockSeriesRepository)(nil).GetSeriesForPost), ctx, postID)
+}
+
+// GetSeriesPosts mocks base method.
+func (m *MockSeriesRepository) GetSeriesPosts(ctx context.Context, seriesID uuid.UUID) ([]db.SeriesPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesPosts", ctx, seriesID)
+	ret0, _ := ret[0].([]db.SeriesPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesPosts indicates an expected call of GetSeriesPosts.
+func (mr *MockSeriesRepositoryMockRecorder) GetSeriesPosts(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesPosts", reflect.TypeOf((*MockSeriesRepository)(nil).GetSeriesPosts), ctx, seriesID)
+}
+
+// Lock mocks base method.
+func (m *MockSeriesRepository) Lock(ctx context.Context, txn helper.Transaction, seriesID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, txn, seriesID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockSeriesRepositoryMockRecorder) Lock(ctx, txn, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockSeriesRepository)(nil).Lock), ctx, txn, seriesID)
+}
+
+// RemovePost mocks base method.
+func (m *MockSeriesRepository) RemovePost(ctx context.Context, txn helper.Transaction, seriesID, postID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePost", ctx, txn, seriesID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePost indicates an expected call of RemovePost.
+func (mr *MockSeriesRepositoryMockRecorder) RemovePost(ctx, txn, seriesID, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePost", reflect.TypeOf((*MockSeriesRepository)(nil).RemovePost), ctx, txn, seriesID, postID)
+}
+
+// UpdatePosition mocks base method.
+func (m *MockSeriesRepository) UpdatePosition(ctx context.Context, txn helper.Transaction, seriesID, postID uuid.UUID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePosition", ctx, txn, seriesID, postID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePosition indicates an expected call of UpdatePosition.
+func (mr *MockSeriesRepositoryMockRecorder) UpdatePosition(ctx, txn, seriesID, postID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePosition", reflect.TypeOf((*MockSeriesRepository)(nil).UpdatePosition), ctx, txn, seriesID, postID, position)
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: series_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	db "post-api/story/models/db"
+	request "post-api/story/models/request"
+	response "post-api/story/models/response"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	golaerror "github.com/inclusi-blog/gola-utils/golaerror"
+)
+
+// MockSeriesService is a mock of SeriesService interface.
+type MockSeriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesServiceMockRecorder
+}
+
+// MockSeriesServiceMockRecorder is the mock recorder for MockSeriesService.
+type MockSeriesServiceMockRecorder struct {
+	mock *MockSeriesService
+}
+
+// NewMockSeriesService creates a new mock instance.
+func NewMockSeriesService(ctrl *gomock.Controller) *MockSeriesService {
+	mock := &MockSeriesService{ctrl: ctrl}
+	mock.recorder = &MockSeriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesService) EXPECT() *MockSeriesServiceMockRecorder {
+	return m.recorder
+}
+
+// AddPost mocks base method.
+func (m *MockSeriesService) AddPost(ctx context.Context, seriesID, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPost", ctx, seriesID, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// AddPost indicates an expected call of AddPost.
+func (mr *MockSeriesServiceMockRecorder) AddPost(ctx, seriesID, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPost", reflect.TypeOf((*MockSeriesService)(nil).AddPost), ctx, seriesID, postID, userID)
+}
+
+// CreateSeries mocks base method.
+func (m *MockSeriesService) CreateSeries(ctx context.Context, seriesRequest request.CreateSeriesRequest) (uuid.UUID, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", ctx, seriesRequest)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockSeriesServiceMockRecorder) CreateSeries(ctx, seriesRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockSeriesService)(nil).CreateSeries), ctx, seriesRequest)
+}
+
+// GetNavigation mocks base method.
+func (m *MockSeriesService) GetNavigation(ctx context.Context, postID uuid.UUID) (*response.SeriesNavigation, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNavigation", ctx, postID)
+	ret0, _ := ret[0].(*response.SeriesNavigation)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetNavigation indicates an expected call of GetNavigation.
+func (mr *MockSeriesServiceMockRecorder) GetNavigation(ctx, postID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNavigation", reflect.TypeOf((*MockSeriesService)(nil).GetNavigation), ctx, postID)
+}
+
+// GetSeries mocks base method.
+func (m *MockSeriesService) GetSeries(ctx context.Context, seriesID uuid.UUID) (response.Series, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", ctx, seriesID)
+	ret0, _ := ret[0].(response.Series)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockSeriesServiceMockRecorder) GetSeries(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockSeriesService)(nil).GetSeries), ctx, seriesID)
+}
+
+// GetSeriesByAuthor mocks base method.
+func (m *MockSeriesService) GetSeriesByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.Series, *golaerror.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesByAuthor", ctx, authorID)
+	ret0, _ := ret[0].([]db.Series)
+	ret1, _ := ret[1].(*golaerror.Error)
+	return ret0, ret1
+}
+
+// GetSeriesByAuthor indicates an expected call of GetSeriesByAuthor.
+func (mr *MockSeriesServiceMockRecorder) GetSeriesByAuthor(ctx, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesByAuthor", reflect.TypeOf((*MockSeriesService)(nil).GetSeriesByAuthor), ctx, authorID)
+}
+
+// RemovePost mocks base method.
+func (m *MockSeriesService) RemovePost(ctx context.Context, seriesID, postID, userID uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePost", ctx, seriesID, postID, userID)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// RemovePost indicates an expected call of RemovePost.
+func (mr *MockSeriesServiceMockRecorder) RemovePost(ctx, seriesID, postID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePost", reflect.TypeOf((*MockSeriesService)(nil).RemovePost), ctx, seriesID, postID, userID)
+}
+
+// ReorderPosts mocks base method.
+func (m *MockSeriesService) ReorderPosts(ctx context.Context, seriesID, userID uuid.UUID, postIDs []uuid.UUID) *golaerror.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderPosts", ctx, seriesID, userID, postIDs)
+	ret0, _ := ret[0].(*golaerror.Error)
+	return ret0
+}
+
+// ReorderPosts indicates an expected call of ReorderPosts.
+func (mr *MockSeriesServiceMockRecorder) ReorderPosts(ctx, seriesID, userID, postIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderPosts", reflect.TypeOf((*MockSeriesService)(nil).ReorderPosts), ctx, seriesID, userID, postIDs)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash_purger.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTrashPurger is a mock of TrashPurger interface.
+type MockTrashPurger struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashPurgerMockRecorder
+}
+
+// MockTrashPurgerMockRecorder is the mock recorder for MockTrashPurger.
+type MockTrashPurgerMockRecorder struct {
+	mock *MockTrashPurger
+}
+
+// NewMockTrashPurger creates a new mock instance.
+func NewMockTrashPurger(ctrl *gomock.Controller) *MockTrashPurger {
+	mock := &MockTrashPurger{ctrl: ctrl}
+	mock.recorder = &MockTrashPurgerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashPurger) EXPECT() *MockTrashPurgerMockRecorder {
+	return m.recorder
+}
+
+// PurgeExpired mocks base method.
+func (m *MockTrashPurger) PurgeExpired(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PurgeExpired", ctx)
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockTrashPurgerMockRecorder) PurgeExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockTrashPurger)(nil).PurgeExpired), ctx)
+}
+
+// Start mocks base method.
+func (m *MockTrashPurger) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockTrashPurgerMockRecorder) Start(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTrashPurger)(nil).Start), ctx)
+}
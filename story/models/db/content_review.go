@@ -0,0 +1,32 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"time"
+)
+
+const (
+	ReviewPost    = "post"
+	ReviewComment = "comment"
+	ReviewAbout   = "about"
+
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// ContentReview is content held back by the content filter until a moderator looks at it. Content that
+// is not stored elsewhere, like the about text of a profile, is kept with the review. A post is reviewed
+// at the version of its draft that was held, so an approval does not carry over to later edits.
+type ContentReview struct {
+	ID             uuid.UUID      `json:"id" db:"id"`
+	ContentType    string         `json:"content_type" db:"content_type"`
+	ContentID      uuid.UUID      `json:"content_id" db:"content_id"`
+	ContentVersion *int64         `json:"content_version" db:"content_version"`
+	UserID         uuid.UUID      `json:"user_id" db:"user_id"`
+	Rules          pq.StringArray `json:"rules" db:"rules"`
+	Content        *string        `json:"content" db:"content"`
+	Status         string         `json:"status" db:"status"`
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+}
@@ -16,9 +16,35 @@ type Draft struct {
 	Tagline      *string           `json:"tagline" db:"tagline"`
 	Interests    *string           `json:"-" db:"interests"`
 	CreatedAt    *time.Time        `json:"created_at" db:"created_at"`
+	Version      int64             `json:"version" db:"version"`
+	IsPublished  bool              `json:"is_published" db:"is_published"`
+	InReview     bool              `json:"in_review" db:"in_review"`
+	PublishAt    *time.Time        `json:"publish_at" db:"publish_at"`
+	PublishError *string           `json:"publish_error" db:"publish_error"`
 	InterestTags []Interests       `json:"interests"`
 }
 
+type ScheduledDraft struct {
+	DraftID         uuid.UUID         `json:"draft_id" db:"id"`
+	UserID          uuid.UUID         `json:"-" db:"user_id"`
+	Data            models.JSONString `json:"-" db:"data"`
+	Title           string            `json:"title"`
+	PublishAt       time.Time         `json:"publish_at" db:"publish_at"`
+	PublishError    *string           `json:"publish_error" db:"publish_error"`
+	PublishAttempts int               `json:"-" db:"publish_attempts"`
+}
+
+// TrashedDraft is a deleted draft waiting in the trash until it is restored or purged.
+type TrashedDraft struct {
+	DraftID      uuid.UUID         `json:"draft_id" db:"id"`
+	UserID       uuid.UUID         `json:"-" db:"user_id"`
+	Data         models.JSONString `json:"-" db:"data"`
+	PreviewImage *string           `json:"-" db:"preview_image"`
+	Title        string            `json:"title"`
+	DeletedAt    time.Time         `json:"deleted_at" db:"deleted_at"`
+	PurgeAt      time.Time         `json:"purge_at"`
+}
+
 type DraftPreview struct {
 	DraftID   uuid.UUID         `json:"id"`
 	UserID    uuid.UUID         `json:"-"`
@@ -39,3 +65,9 @@ func (draft *Draft) ConvertInterests(onSuccess func(interests []string) *golaerr
 	}
 	return nil
 }
+
+type DraftImage struct {
+	ID       uuid.UUID `db:"id"`
+	DraftID  uuid.UUID `db:"draft_id"`
+	UploadID string    `db:"upload_id"`
+}
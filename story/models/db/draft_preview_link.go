@@ -0,0 +1,16 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type DraftPreviewLink struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	DraftID      uuid.UUID  `json:"draft_id" db:"draft_id"`
+	UserID       uuid.UUID  `json:"-" db:"user_id"`
+	ExpiresAt    time.Time  `json:"expires_at" db:"expires_at"`
+	OpenCount    int64      `json:"open_count" db:"open_count"`
+	LastOpenedAt *time.Time `json:"last_opened_at" db:"last_opened_at"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+}
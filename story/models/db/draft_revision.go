@@ -0,0 +1,22 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"post-api/story/models"
+	"time"
+)
+
+type DraftRevision struct {
+	ID        uuid.UUID         `json:"id" db:"id"`
+	DraftID   uuid.UUID         `json:"draft_id" db:"draft_id"`
+	Revision  int64             `json:"revision" db:"revision"`
+	Data      models.JSONString `json:"data" db:"data"`
+	CreatedAt time.Time         `json:"created_at" db:"created_at"`
+}
+
+type DraftRevisionSummary struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	DraftID   uuid.UUID `json:"draft_id" db:"draft_id"`
+	Revision  int64     `json:"revision" db:"revision"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
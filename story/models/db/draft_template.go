@@ -0,0 +1,29 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"post-api/story/models"
+	"time"
+)
+
+type DraftTemplate struct {
+	ID          uuid.UUID         `json:"id" db:"id"`
+	UserID      uuid.UUID         `json:"user_id" db:"user_id"`
+	Name        string            `json:"name" db:"name"`
+	Description *string           `json:"description" db:"description"`
+	Data        models.JSONString `json:"data" db:"data"`
+	Tagline     *string           `json:"tagline" db:"tagline"`
+	Interests   pq.StringArray    `json:"interests" db:"interests"`
+	IsPublic    bool              `json:"is_public" db:"is_public"`
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+}
+
+type DraftTemplateSummary struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	UserID      uuid.UUID `json:"user_id" db:"user_id"`
+	Name        string    `json:"name" db:"name"`
+	Description *string   `json:"description" db:"description"`
+	IsPublic    bool      `json:"is_public" db:"is_public"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,13 @@
+package db
+
+import "github.com/google/uuid"
+
+// FingerprintMatch is the stored post whose fingerprint is closest to the one compared, with the number of
+// bits the fingerprints differ in.
+type FingerprintMatch struct {
+	PostID   uuid.UUID `db:"post_id"`
+	Simhash  int64     `db:"simhash"`
+	AuthorID uuid.UUID `db:"author_id"`
+	State    string    `db:"state"`
+	Distance int       `db:"distance"`
+}
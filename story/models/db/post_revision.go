@@ -0,0 +1,20 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"post-api/story/models"
+	"time"
+)
+
+type PostRevision struct {
+	ID           uuid.UUID         `json:"id" db:"id"`
+	PostID       uuid.UUID         `json:"post_id" db:"post_id"`
+	Revision     int64             `json:"revision" db:"revision"`
+	Data         models.JSONString `json:"data" db:"data"`
+	Title        string            `json:"title" db:"title"`
+	Tagline      string            `json:"tagline" db:"tagline"`
+	PreviewImage string            `json:"preview_image" db:"preview_image"`
+	ViewTime     int64             `json:"view_time" db:"view_time"`
+	PublishedAt  time.Time         `json:"published_at" db:"published_at"`
+	CreatedAt    time.Time         `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Published posts are listed everywhere. Unlisted posts are left out of feeds and profiles but open to
+// anyone with the link, archived posts are only open to their author and deleted posts can be restored
+// by their author until the retention runs out.
+const (
+	PostPublished = "published"
+	PostUnlisted  = "unlisted"
+	PostArchived  = "archived"
+	PostDeleted   = "deleted"
+)
+
+type AuthorPost struct {
+	PostID       uuid.UUID  `json:"post_id" db:"id"`
+	Title        string     `json:"title" db:"title"`
+	URL          string     `json:"url" db:"url"`
+	State        string     `json:"state" db:"state"`
+	PublishedAt  time.Time  `json:"published_at" db:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	RestoreUntil *time.Time `json:"restore_until,omitempty"`
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"post-api/story/models"
 	"time"
 )
 
@@ -14,6 +15,8 @@ type AbstractPost struct {
 	PreviewImage string    `json:"preview_image" db:"preview_image"`
 	ViewTime     int64     `json:"view_time" db:"view_time"`
 	URL          string    `json:"url" db:"url"`
+	// TableOfContents is derived from the header blocks whenever the post is published or republished.
+	TableOfContents models.TableOfContents `json:"table_of_contents" db:"table_of_contents"`
 }
 
 type HomeFeedPost struct {
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	PublicationOwner  = "owner"
+	PublicationEditor = "editor"
+	PublicationWriter = "writer"
+
+	MemberInvited = "invited"
+	MemberActive  = "active"
+
+	SubmissionPending          = "pending"
+	SubmissionChangesRequested = "changes_requested"
+	SubmissionRejected         = "rejected"
+	SubmissionApproved         = "approved"
+)
+
+type Publication struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	Name         string    `json:"name" db:"name"`
+	Description  *string   `json:"description" db:"description"`
+	OwnerID      uuid.UUID `json:"owner_id" db:"owner_id"`
+	PostsCount   int64     `json:"posts_count" db:"posts_count"`
+	MembersCount int64     `json:"members_count" db:"members_count"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+type PublicationMember struct {
+	PublicationID uuid.UUID  `json:"publication_id" db:"publication_id"`
+	UserID        uuid.UUID  `json:"user_id" db:"user_id"`
+	Username      string     `json:"username" db:"username"`
+	Role          string     `json:"role" db:"role"`
+	Status        string     `json:"status" db:"status"`
+	InvitedBy     *uuid.UUID `json:"invited_by" db:"invited_by"`
+}
+
+// IsEditor reports whether the member can review submissions.
+func (member PublicationMember) IsEditor() bool {
+	return member.Status == MemberActive && (member.Role == PublicationOwner || member.Role == PublicationEditor)
+}
+
+type PublicationSubmission struct {
+	ID            uuid.UUID  `json:"id" db:"id"`
+	PublicationID uuid.UUID  `json:"publication_id" db:"publication_id"`
+	DraftID       uuid.UUID  `json:"draft_id" db:"draft_id"`
+	DraftVersion  int64      `json:"draft_version" db:"draft_version"`
+	AuthorID      uuid.UUID  `json:"author_id" db:"author_id"`
+	Status        string     `json:"status" db:"status"`
+	Feedback      *string    `json:"feedback" db:"feedback"`
+	ReviewedBy    *uuid.UUID `json:"reviewed_by" db:"reviewed_by"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at" db:"updated_at"`
+}
@@ -6,9 +6,10 @@ import (
 )
 
 type PublishPost struct {
-	UserID   uuid.UUID         `json:"author_id" db:"author_id"`
-	PostData models.JSONString `json:"data" db:"data"`
-	DraftID  uuid.UUID         `json:"draft_id" db:"draft_id"`
+	UserID        uuid.UUID         `json:"author_id" db:"author_id"`
+	PostData      models.JSONString `json:"data" db:"data"`
+	DraftID       uuid.UUID         `json:"draft_id" db:"draft_id"`
+	PublicationID *uuid.UUID        `json:"publication_id" db:"publication_id"`
 }
 
 type LikedByRes struct {
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Series struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	AuthorID    uuid.UUID `json:"author_id" db:"author_id"`
+	Title       string    `json:"title" db:"title"`
+	Description *string   `json:"description" db:"description"`
+	PostsCount  int64     `json:"posts_count" db:"posts_count"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+type SeriesPost struct {
+	PostID   uuid.UUID `json:"post_id" db:"post_id"`
+	Position int       `json:"position" db:"position"`
+	Title    string    `json:"title" db:"title"`
+	URL      string    `json:"url" db:"url"`
+	State    string    `json:"-" db:"state"`
+}
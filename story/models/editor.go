@@ -1,6 +1,9 @@
 package models
 
-import "github.com/mitchellh/mapstructure"
+import (
+	"encoding/json"
+	"github.com/mitchellh/mapstructure"
+)
 
 type ElementType string
 
@@ -36,13 +39,22 @@ type Editor struct {
 }
 
 type ImageElement struct {
-	File struct {
-		Url string `json:"url"`
-	} `json:"file"`
-	Caption        string `json:"caption"`
-	WithBorder     bool   `json:"withBorder"`
-	Stretched      bool   `json:"stretched"`
-	WithBackground bool   `json:"withBackground"`
+	File           ImageFile `json:"file"`
+	Caption        string    `json:"caption"`
+	WithBorder     bool      `json:"withBorder"`
+	Stretched      bool      `json:"stretched"`
+	WithBackground bool      `json:"withBackground"`
+}
+
+// ImageFile is filled by the image uploader, so fields other than the url are ignored instead of
+// rejected.
+type ImageFile struct {
+	Url string `json:"url"`
+}
+
+func (file *ImageFile) UnmarshalJSON(data []byte) error {
+	type imageFile ImageFile
+	return json.Unmarshal(data, (*imageFile)(file))
 }
 
 type ParagraphElement struct {
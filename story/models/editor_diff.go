@@ -0,0 +1,145 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type BlockChange string
+
+const (
+	BlockAdded     BlockChange = "added"
+	BlockRemoved   BlockChange = "removed"
+	BlockModified  BlockChange = "modified"
+	BlockMoved     BlockChange = "moved"
+	BlockUnchanged BlockChange = "unchanged"
+)
+
+type BlockDiff struct {
+	BlockID string      `json:"block_id"`
+	Type    ElementType `json:"type"`
+	Change  BlockChange `json:"change"`
+	Before  *Block      `json:"before,omitempty"`
+	After   *Block      `json:"after,omitempty"`
+}
+
+// DiffBlocks compares two editor states block by block using the Editor.js block ids. Blocks are
+// reported in the order of the newer editor, with removed blocks placed after the block that
+// preceded them in the older editor. A block is only reported as moved when it falls outside the
+// longest run of blocks that kept their relative order.
+func DiffBlocks(from, to Editor) []BlockDiff {
+	fromKeys := blockKeys(from.Blocks)
+	toKeys := blockKeys(to.Blocks)
+
+	fromIndex := make(map[string]int, len(fromKeys))
+	for i, key := range fromKeys {
+		fromIndex[key] = i
+	}
+	toIndex := make(map[string]int, len(toKeys))
+	for i, key := range toKeys {
+		toIndex[key] = i
+	}
+
+	var fromCommon, toCommon []string
+	for _, key := range fromKeys {
+		if _, ok := toIndex[key]; ok {
+			fromCommon = append(fromCommon, key)
+		}
+	}
+	for _, key := range toKeys {
+		if _, ok := fromIndex[key]; ok {
+			toCommon = append(toCommon, key)
+		}
+	}
+	stable := longestCommonSubsequence(fromCommon, toCommon)
+
+	removedAfter := map[string][]int{}
+	var leadingRemoved []int
+	for i, key := range fromKeys {
+		if _, ok := toIndex[key]; ok {
+			continue
+		}
+		if i == 0 {
+			leadingRemoved = append(leadingRemoved, i)
+			continue
+		}
+		removedAfter[fromKeys[i-1]] = append(removedAfter[fromKeys[i-1]], i)
+	}
+
+	var diffs []BlockDiff
+	var appendRemoved func(indexes []int)
+	appendRemoved = func(indexes []int) {
+		for _, i := range indexes {
+			before := from.Blocks[i]
+			diffs = append(diffs, BlockDiff{BlockID: before.ID, Type: before.Type, Change: BlockRemoved, Before: &before})
+			appendRemoved(removedAfter[fromKeys[i]])
+		}
+	}
+
+	appendRemoved(leadingRemoved)
+	for i, key := range toKeys {
+		after := to.Blocks[i]
+		j, existed := fromIndex[key]
+		if !existed {
+			diffs = append(diffs, BlockDiff{BlockID: after.ID, Type: after.Type, Change: BlockAdded, After: &after})
+			continue
+		}
+
+		before := from.Blocks[j]
+		change := BlockUnchanged
+		if before.Type != after.Type || !reflect.DeepEqual(before.Data, after.Data) {
+			change = BlockModified
+		} else if !stable[key] {
+			change = BlockMoved
+		}
+		diffs = append(diffs, BlockDiff{BlockID: after.ID, Type: after.Type, Change: change, Before: &before, After: &after})
+		appendRemoved(removedAfter[fromKeys[j]])
+	}
+
+	return diffs
+}
+
+func blockKeys(blocks []Block) []string {
+	keys := make([]string, len(blocks))
+	for i, block := range blocks {
+		if block.ID == "" {
+			keys[i] = fmt.Sprintf("#%d", i)
+			continue
+		}
+		keys[i] = block.ID
+	}
+	return keys
+}
+
+func longestCommonSubsequence(a, b []string) map[string]bool {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	common := map[string]bool{}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			common[a[i]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return common
+}
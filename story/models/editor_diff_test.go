@@ -0,0 +1,52 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func changesOf(diffs []BlockDiff) map[string]BlockChange {
+	changes := map[string]BlockChange{}
+	for _, diff := range diffs {
+		changes[diff.BlockID] = diff.Change
+	}
+	return changes
+}
+
+func TestDiffBlocksReportsAddedRemovedAndModified(t *testing.T) {
+	from := Editor{Blocks: []Block{
+		{ID: "a", Type: Header, Data: map[string]interface{}{"text": "Title", "level": 1}},
+		{ID: "b", Type: Paragraph, Data: map[string]interface{}{"text": "removed"}},
+		{ID: "c", Type: Paragraph, Data: map[string]interface{}{"text": "old"}},
+	}}
+	to := Editor{Blocks: []Block{
+		{ID: "a", Type: Header, Data: map[string]interface{}{"text": "Title", "level": 1}},
+		{ID: "c", Type: Paragraph, Data: map[string]interface{}{"text": "new"}},
+		{ID: "d", Type: Separator, Data: map[string]interface{}{}},
+	}}
+
+	diffs := DiffBlocks(from, to)
+
+	assert.Len(t, diffs, 4)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, []string{diffs[0].BlockID, diffs[1].BlockID, diffs[2].BlockID, diffs[3].BlockID})
+	assert.Equal(t, map[string]BlockChange{"a": BlockUnchanged, "b": BlockRemoved, "c": BlockModified, "d": BlockAdded}, changesOf(diffs))
+	assert.Nil(t, diffs[1].After)
+	assert.Nil(t, diffs[3].Before)
+}
+
+func TestDiffBlocksOnlyReportsTheMovedBlock(t *testing.T) {
+	from := Editor{Blocks: []Block{
+		{ID: "a", Type: Paragraph, Data: map[string]interface{}{"text": "a"}},
+		{ID: "b", Type: Paragraph, Data: map[string]interface{}{"text": "b"}},
+		{ID: "c", Type: Paragraph, Data: map[string]interface{}{"text": "c"}},
+	}}
+	to := Editor{Blocks: []Block{
+		{ID: "b", Type: Paragraph, Data: map[string]interface{}{"text": "b"}},
+		{ID: "c", Type: Paragraph, Data: map[string]interface{}{"text": "c"}},
+		{ID: "a", Type: Paragraph, Data: map[string]interface{}{"text": "a"}},
+	}}
+
+	diffs := DiffBlocks(from, to)
+
+	assert.Equal(t, map[string]BlockChange{"a": BlockMoved, "b": BlockUnchanged, "c": BlockUnchanged}, changesOf(diffs))
+}
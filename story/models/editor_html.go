@@ -0,0 +1,182 @@
+package models
+
+import (
+	"fmt"
+	"golang.org/x/net/html"
+	"strings"
+)
+
+// RenderHTML renders the editor blocks as semantic HTML, with the anchors of the table of contents as
+// header ids. Text that the editor stores with inline markup is sanitized, everything else is escaped.
+// Blocks of unknown type are skipped.
+func (e Editor) RenderHTML() string {
+	var builder strings.Builder
+	anchors := e.headerAnchors()
+	for index, block := range e.Blocks {
+		block.renderHTML(&builder, anchors[index])
+	}
+	return builder.String()
+}
+
+// renderHTML renders the block. The anchor is set as the id of headers so sections can be linked to.
+func (block Block) renderHTML(builder *strings.Builder, anchor string) {
+	data := block.Data
+	switch block.Type {
+	case Paragraph:
+		builder.WriteString("<p>" + inlineHTML(data, "text") + "</p>")
+	case Header:
+		level := headerLevel(intField(data, "level"))
+		if anchor == "" {
+			fmt.Fprintf(builder, "<h%d>%s</h%d>", level, inlineHTML(data, "text"), level)
+			break
+		}
+		fmt.Fprintf(builder, `<h%d id="%s">%s</h%d>`, level, html.EscapeString(anchor), inlineHTML(data, "text"), level)
+	case Table:
+		renderTable(builder, data)
+	case List:
+		tag := "ul"
+		if stringField(data, "style") == "ordered" {
+			tag = "ol"
+		}
+		renderListItems(builder, tag, data["items"])
+	case Quote:
+		builder.WriteString("<figure><blockquote><p>" + inlineHTML(data, "text") + "</p></blockquote>")
+		if caption := inlineHTML(data, "caption"); caption != "" {
+			builder.WriteString("<figcaption><cite>" + caption + "</cite></figcaption>")
+		}
+		builder.WriteString("</figure>")
+	case CheckList:
+		renderCheckList(builder, data)
+	case Warning:
+		builder.WriteString(`<aside role="note"><strong>` + inlineHTML(data, "title") + "</strong><p>" + inlineHTML(data, "message") + "</p></aside>")
+	case Code:
+		builder.WriteString("<pre><code>" + html.EscapeString(stringField(data, "code")) + "</code></pre>")
+	case LinkTool:
+		renderLinkTool(builder, data)
+	case Image:
+		renderImage(builder, data)
+	case RawHTML:
+		builder.WriteString(rawPolicy.sanitize(stringField(data, "html")))
+	case Separator:
+		builder.WriteString("<hr>")
+	}
+}
+
+func renderTable(builder *strings.Builder, data map[string]interface{}) {
+	rows, _ := data["content"].([]interface{})
+	builder.WriteString("<table>")
+	if withHeadings, _ := data["withHeadings"].(bool); withHeadings && len(rows) > 0 {
+		builder.WriteString("<thead>")
+		renderTableRow(builder, "th", rows[0])
+		builder.WriteString("</thead>")
+		rows = rows[1:]
+	}
+	builder.WriteString("<tbody>")
+	for _, row := range rows {
+		renderTableRow(builder, "td", row)
+	}
+	builder.WriteString("</tbody></table>")
+}
+
+func renderTableRow(builder *strings.Builder, cellTag string, row interface{}) {
+	cells, _ := row.([]interface{})
+	builder.WriteString("<tr>")
+	for _, cell := range cells {
+		text, _ := cell.(string)
+		builder.WriteString("<" + cellTag + ">" + inlinePolicy.sanitize(text) + "</" + cellTag + ">")
+	}
+	builder.WriteString("</tr>")
+}
+
+// renderListItems handles both the flat list format, where items are strings, and the nested list
+// format, where each item carries its content and its own items.
+func renderListItems(builder *strings.Builder, tag string, value interface{}) {
+	items, _ := value.([]interface{})
+	if len(items) == 0 {
+		return
+	}
+	builder.WriteString("<" + tag + ">")
+	for _, item := range items {
+		builder.WriteString("<li>")
+		switch item := item.(type) {
+		case string:
+			builder.WriteString(inlinePolicy.sanitize(item))
+		case map[string]interface{}:
+			builder.WriteString(inlineHTML(item, "content"))
+			renderListItems(builder, tag, item["items"])
+		}
+		builder.WriteString("</li>")
+	}
+	builder.WriteString("</" + tag + ">")
+}
+
+func renderCheckList(builder *strings.Builder, data map[string]interface{}) {
+	items, _ := data["items"].([]interface{})
+	builder.WriteString(`<ul class="checklist">`)
+	for _, item := range items {
+		entry, _ := item.(map[string]interface{})
+		checked := ""
+		if isChecked, _ := entry["checked"].(bool); isChecked {
+			checked = " checked"
+		}
+		builder.WriteString(`<li><input type="checkbox" disabled` + checked + "> " + inlineHTML(entry, "text") + "</li>")
+	}
+	builder.WriteString("</ul>")
+}
+
+func renderLinkTool(builder *strings.Builder, data map[string]interface{}) {
+	link := stringField(data, "link")
+	if link == "" || !isSafeURL(link) {
+		return
+	}
+	meta, _ := data["meta"].(map[string]interface{})
+	title := plainText(stringField(meta, "title"))
+	if title == "" {
+		title = link
+	}
+
+	builder.WriteString(`<a class="link-preview" href="` + html.EscapeString(link) + `" rel="nofollow noopener noreferrer">`)
+	builder.WriteString("<strong>" + html.EscapeString(title) + "</strong>")
+	if description := plainText(stringField(meta, "description")); description != "" {
+		builder.WriteString("<span>" + html.EscapeString(description) + "</span>")
+	}
+	builder.WriteString("</a>")
+}
+
+func renderImage(builder *strings.Builder, data map[string]interface{}) {
+	file, _ := data["file"].(map[string]interface{})
+	src := stringField(file, "url")
+	if src == "" {
+		src = stringField(data, "url")
+	}
+	if src == "" || !isSafeURL(src) {
+		return
+	}
+	caption := stringField(data, "caption")
+
+	builder.WriteString(`<figure><img src="` + html.EscapeString(src) + `" alt="` + html.EscapeString(plainText(caption)) + `">`)
+	if caption != "" {
+		builder.WriteString("<figcaption>" + inlinePolicy.sanitize(caption) + "</figcaption>")
+	}
+	builder.WriteString("</figure>")
+}
+
+func inlineHTML(data map[string]interface{}, key string) string {
+	return inlinePolicy.sanitize(stringField(data, key))
+}
+
+func stringField(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
+
+// intField reads numbers that were either decoded from JSON as float64 or built in code as int.
+func intField(data map[string]interface{}, key string) int {
+	switch value := data[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+	return 0
+}
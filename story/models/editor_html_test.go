@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRenderHTMLRendersEveryBlockType(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{Type: Header, Data: map[string]interface{}{"text": "Title", "level": float64(1)}},
+		{Type: Paragraph, Data: map[string]interface{}{"text": "some <b>bold</b> text"}},
+		{Type: List, Data: map[string]interface{}{"style": "ordered", "items": []interface{}{"one", map[string]interface{}{"content": "two", "items": []interface{}{}}}}},
+		{Type: Table, Data: map[string]interface{}{"withHeadings": true, "content": []interface{}{[]interface{}{"a"}, []interface{}{"1"}}}},
+		{Type: Quote, Data: map[string]interface{}{"text": "quoted", "caption": "someone"}},
+		{Type: CheckList, Data: map[string]interface{}{"items": []interface{}{map[string]interface{}{"text": "done", "checked": true}}}},
+		{Type: Warning, Data: map[string]interface{}{"title": "Note", "message": "careful"}},
+		{Type: Code, Data: map[string]interface{}{"code": "if a < b {}"}},
+		{Type: LinkTool, Data: map[string]interface{}{"link": "https://example.com", "meta": map[string]interface{}{"title": "Example"}}},
+		{Type: Image, Data: map[string]interface{}{"file": map[string]interface{}{"url": "https://cdn.example.com/a.png"}, "caption": "an <i>image</i>"}},
+		{Type: Separator, Data: map[string]interface{}{}},
+		{Type: "unknown", Data: map[string]interface{}{"text": "skipped"}},
+	}}
+
+	assert.Equal(t, `<h1 id="title">Title</h1>`+
+		"<p>some <b>bold</b> text</p>"+
+		"<ol><li>one</li><li>two</li></ol>"+
+		"<table><thead><tr><th>a</th></tr></thead><tbody><tr><td>1</td></tr></tbody></table>"+
+		"<figure><blockquote><p>quoted</p></blockquote><figcaption><cite>someone</cite></figcaption></figure>"+
+		`<ul class="checklist"><li><input type="checkbox" disabled checked> done</li></ul>`+
+		`<aside role="note"><strong>Note</strong><p>careful</p></aside>`+
+		"<pre><code>if a &lt; b {}</code></pre>"+
+		`<a class="link-preview" href="https://example.com" rel="nofollow noopener noreferrer"><strong>Example</strong></a>`+
+		`<figure><img src="https://cdn.example.com/a.png" alt="an image"><figcaption>an <i>image</i></figcaption></figure>`+
+		"<hr>", editor.RenderHTML())
+}
+
+func TestRenderHTMLStripsUnsafeMarkup(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{Type: Paragraph, Data: map[string]interface{}{"text": `<a href="javascript:alert(1)" onclick="x()">link</a><script>alert(1)</script>`}},
+		{Type: RawHTML, Data: map[string]interface{}{"html": `<div style="x"><img src="data:image/png;base64,AA" onerror="x()"><p>kept`}},
+		{Type: Image, Data: map[string]interface{}{"file": map[string]interface{}{"url": "javascript:alert(1)"}}},
+	}}
+
+	assert.Equal(t, `<p><a rel="nofollow noopener noreferrer">link</a></p>`+
+		"<div><img><p>kept</p></div>", editor.RenderHTML())
+}
@@ -0,0 +1,265 @@
+package models
+
+import (
+	"fmt"
+	"golang.org/x/net/html"
+	"strings"
+)
+
+// RenderMarkdown exports the editor blocks as CommonMark with GFM tables and task lists. Warnings
+// and link previews have no Markdown equivalent and are exported as a quote and a link.
+func (e Editor) RenderMarkdown() string {
+	var blocks []string
+	for _, block := range e.Blocks {
+		if markdown := block.renderMarkdown(); markdown != "" {
+			blocks = append(blocks, markdown)
+		}
+	}
+	if len(blocks) == 0 {
+		return ""
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+func (block Block) renderMarkdown() string {
+	data := block.Data
+	switch block.Type {
+	case Paragraph:
+		return escapeBlockStart(htmlToMarkdown(stringField(data, "text")))
+	case Header:
+		level := intField(data, "level")
+		if level < 1 || level > 6 {
+			level = 2
+		}
+		return strings.Repeat("#", level) + " " + htmlToMarkdown(stringField(data, "text"))
+	case Table:
+		return tableMarkdown(data)
+	case List:
+		return strings.TrimRight(listMarkdown(data["items"], stringField(data, "style") == "ordered", ""), "\n")
+	case Quote:
+		text := strings.ReplaceAll(htmlToMarkdown(stringField(data, "text")), "<br>", "\n\n")
+		if caption := htmlToMarkdown(stringField(data, "caption")); caption != "" {
+			text += "\n\n— " + caption
+		}
+		return quoteMarkdown(text)
+	case CheckList:
+		items, _ := data["items"].([]interface{})
+		var lines []string
+		for _, item := range items {
+			entry, _ := item.(map[string]interface{})
+			marker := "- [ ] "
+			if checked, _ := entry["checked"].(bool); checked {
+				marker = "- [x] "
+			}
+			lines = append(lines, marker+htmlToMarkdown(stringField(entry, "text")))
+		}
+		return strings.Join(lines, "\n")
+	case Warning:
+		return quoteMarkdown("**" + htmlToMarkdown(stringField(data, "title")) + "**\n\n" + htmlToMarkdown(stringField(data, "message")))
+	case Code:
+		code := stringField(data, "code")
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+		return fence + "\n" + code + "\n" + fence
+	case LinkTool:
+		link := stringField(data, "link")
+		if link == "" {
+			return ""
+		}
+		meta, _ := data["meta"].(map[string]interface{})
+		title := escapeMarkdown(plainText(stringField(meta, "title")))
+		if title == "" {
+			title = escapeMarkdown(link)
+		}
+		return "[" + title + "](" + markdownDestination(link) + ")"
+	case Image:
+		file, _ := data["file"].(map[string]interface{})
+		url := stringField(file, "url")
+		if url == "" {
+			url = stringField(data, "url")
+		}
+		if url == "" {
+			return ""
+		}
+		return "![" + escapeMarkdown(plainText(stringField(data, "caption"))) + "](" + markdownDestination(url) + ")"
+	case RawHTML:
+		return stringField(data, "html")
+	case Separator:
+		return "---"
+	}
+	return ""
+}
+
+func tableMarkdown(data map[string]interface{}) string {
+	rows, _ := data["content"].([]interface{})
+	if len(rows) == 0 {
+		return ""
+	}
+	header, _ := rows[0].([]interface{})
+	width := len(header)
+	withHeadings, _ := data["withHeadings"].(bool)
+	if !withHeadings {
+		header = make([]interface{}, width)
+	} else {
+		rows = rows[1:]
+	}
+
+	lines := []string{tableRowMarkdown(header, width), "|" + strings.Repeat(" --- |", width)}
+	for _, row := range rows {
+		cells, _ := row.([]interface{})
+		lines = append(lines, tableRowMarkdown(cells, width))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func tableRowMarkdown(cells []interface{}, width int) string {
+	var builder strings.Builder
+	builder.WriteString("|")
+	for i := 0; i < width; i++ {
+		text := ""
+		if i < len(cells) {
+			text, _ = cells[i].(string)
+		}
+		builder.WriteString(" " + strings.ReplaceAll(htmlToMarkdown(text), "|", `\|`) + " |")
+	}
+	return builder.String()
+}
+
+func listMarkdown(value interface{}, ordered bool, indent string) string {
+	items, _ := value.([]interface{})
+	var builder strings.Builder
+	for index, item := range items {
+		marker := "-"
+		if ordered {
+			marker = fmt.Sprintf("%d.", index+1)
+		}
+		builder.WriteString(indent + marker + " ")
+		switch item := item.(type) {
+		case string:
+			builder.WriteString(htmlToMarkdown(item) + "\n")
+		case map[string]interface{}:
+			builder.WriteString(htmlToMarkdown(stringField(item, "content")) + "\n")
+			builder.WriteString(listMarkdown(item["items"], ordered, indent+strings.Repeat(" ", len(marker)+1)))
+		}
+	}
+	return builder.String()
+}
+
+func quoteMarkdown(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// htmlToMarkdown converts the inline HTML the editor stores into Markdown. Tags without a Markdown
+// equivalent, like mark or sub, are kept as inline HTML.
+func htmlToMarkdown(input string) string {
+	var builder strings.Builder
+	var links []string
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	inCode := false
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return strings.TrimSpace(builder.String())
+		}
+		token := tokenizer.Token()
+
+		switch tokenType {
+		case html.TextToken:
+			if inCode {
+				builder.WriteString(token.Data)
+				continue
+			}
+			builder.WriteString(escapeMarkdown(strings.ReplaceAll(token.Data, "\n", " ")))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.Data {
+			case "b", "strong":
+				builder.WriteString("**")
+			case "i", "em":
+				builder.WriteString("*")
+			case "s", "del", "strike":
+				builder.WriteString("~~")
+			case "code":
+				builder.WriteString(codeDelimiter(input))
+				inCode = true
+			case "a":
+				builder.WriteString("[")
+				links = append(links, attribute(token, "href"))
+			case "br":
+				builder.WriteString("<br>")
+			case "mark", "u", "sub", "sup":
+				builder.WriteString("<" + token.Data + ">")
+			}
+		case html.EndTagToken:
+			switch token.Data {
+			case "b", "strong":
+				builder.WriteString("**")
+			case "i", "em":
+				builder.WriteString("*")
+			case "s", "del", "strike":
+				builder.WriteString("~~")
+			case "code":
+				builder.WriteString(codeDelimiter(input))
+				inCode = false
+			case "a":
+				if len(links) == 0 {
+					continue
+				}
+				builder.WriteString("](" + markdownDestination(links[len(links)-1]) + ")")
+				links = links[:len(links)-1]
+			case "mark", "u", "sub", "sup":
+				builder.WriteString("</" + token.Data + ">")
+			}
+		}
+	}
+}
+
+// codeDelimiter picks a backtick run longer than any run inside the content so that code spans
+// never close early.
+func codeDelimiter(content string) string {
+	delimiter := "`"
+	for strings.Contains(content, delimiter) {
+		delimiter += "`"
+	}
+	return delimiter
+}
+
+func attribute(token html.Token, key string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func markdownDestination(url string) string {
+	if strings.ContainsAny(url, " ()<>") {
+		return "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(url) + ">"
+	}
+	return url
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "<", `\<`, "~", `\~`)
+
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// escapeBlockStart keeps a paragraph from being read back as a heading, quote, list or break.
+func escapeBlockStart(text string) string {
+	if startsBlock(text) || setextPattern.MatchString(text) {
+		if match := listItemPattern.FindStringSubmatch(text); match != nil && len(match[2]) > 1 {
+			marker := match[2]
+			return fmt.Sprintf("%s\\%s", marker[:len(marker)-1], text[len(marker)-1:])
+		}
+		return `\` + text
+	}
+	return text
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func withoutIDs(editor Editor) []Block {
+	blocks := editor.Blocks
+	for i := range blocks {
+		blocks[i].ID = ""
+	}
+	return blocks
+}
+
+func TestParseMarkdownConvertsBlocks(t *testing.T) {
+	markdown := "# Title *with* `code`\n\n" +
+		"Some **bold** and _italic_ text with [a link](https://example.com \"title\") and ~~struck~~.  \nNext line\n\n" +
+		"- one\n- two\n  - nested\n\n" +
+		"1. first\n2. second\n\n" +
+		"- [ ] todo\n- [x] done\n\n" +
+		"> quoted\n> text\n>\n> — someone\n\n" +
+		"```go\nfmt.Println(\"<b>\")\n```\n\n" +
+		"| a | b |\n|---|:-:|\n| 1 | 2 \\| 3 |\n\n" +
+		"![a cat](https://example.com/cat.png)\n\n" +
+		"---\n\n" +
+		"<div>raw</div>\n"
+
+	editor := ParseMarkdown(markdown)
+	assert.Empty(t, editor.Validate())
+
+	assert.Equal(t, []Block{
+		{Type: Header, Data: map[string]interface{}{"text": "Title <i>with</i> <code>code</code>", "level": 1}},
+		{Type: Paragraph, Data: map[string]interface{}{"text": `Some <b>bold</b> and <i>italic</i> text with <a href="https://example.com" rel="nofollow noopener noreferrer">a link</a> and <s>struck</s>.<br>Next line`}},
+		{Type: List, Data: map[string]interface{}{"style": "unordered", "items": []interface{}{
+			map[string]interface{}{"content": "one", "items": []interface{}{}},
+			map[string]interface{}{"content": "two", "items": []interface{}{map[string]interface{}{"content": "nested", "items": []interface{}{}}}},
+		}}},
+		{Type: List, Data: map[string]interface{}{"style": "ordered", "items": []interface{}{"first", "second"}}},
+		{Type: CheckList, Data: map[string]interface{}{"items": []interface{}{
+			map[string]interface{}{"text": "todo", "checked": false},
+			map[string]interface{}{"text": "done", "checked": true},
+		}}},
+		{Type: Quote, Data: map[string]interface{}{"text": "quoted text", "caption": "someone", "alignment": "left"}},
+		{Type: Code, Data: map[string]interface{}{"code": `fmt.Println("<b>")`}},
+		{Type: Table, Data: map[string]interface{}{"withHeadings": true, "content": []interface{}{[]interface{}{"a", "b"}, []interface{}{"1", "2 | 3"}}}},
+		{Type: Image, Data: map[string]interface{}{"file": map[string]interface{}{"url": "https://example.com/cat.png"}, "caption": "a cat", "withBorder": false, "stretched": false, "withBackground": false}},
+		{Type: Separator, Data: map[string]interface{}{}},
+		{Type: RawHTML, Data: map[string]interface{}{"html": "<div>raw</div>"}},
+	}, withoutIDs(editor))
+}
+
+func TestParseMarkdownKeepsUnmatchedDelimitersAsText(t *testing.T) {
+	blocks := withoutIDs(ParseMarkdown("snake_case and 2 * 3 and **open *a **b** c*"))
+
+	assert.Equal(t, "snake_case and 2 * 3 and **open <i>a <b>b</b> c</i>", blocks[0].Data["text"])
+}
+
+func TestRenderMarkdownRoundTrips(t *testing.T) {
+	markdown := "## Heading\n\n" +
+		"Some **bold**, *italic* and `code` with [a link](https://example.com).\n\n" +
+		"1. first\n2. second\n   1. nested\n\n" +
+		"- [ ] todo\n- [x] done\n\n" +
+		"> quoted\n>\n> — someone\n\n" +
+		"```\nif a < b {}\n```\n\n" +
+		"|  |  |\n| --- | --- |\n| 1 | 2 |\n\n" +
+		"![a cat](https://example.com/cat.png)\n\n" +
+		"---\n"
+
+	exported := ParseMarkdown(markdown).RenderMarkdown()
+
+	assert.Equal(t, markdown, exported)
+	assert.Equal(t, withoutIDs(ParseMarkdown(markdown)), withoutIDs(ParseMarkdown(exported)))
+}
+
+func TestRenderMarkdownEscapesParagraphsThatLookLikeBlocks(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{Type: Paragraph, Data: map[string]interface{}{"text": "# not a heading"}},
+		{Type: Paragraph, Data: map[string]interface{}{"text": "1. not a list and a_b *c*"}},
+	}}
+
+	exported := editor.RenderMarkdown()
+
+	assert.Equal(t, "\\# not a heading\n\n1\\. not a list and a\\_b \\*c\\*\n", exported)
+	assert.Equal(t, "1. not a list and a_b *c*", ParseMarkdown(exported).Blocks[1].Data["text"])
+}
@@ -0,0 +1,123 @@
+package models
+
+import "fmt"
+
+type BlockOperationType string
+
+const (
+	InsertBlock BlockOperationType = "insert"
+	UpdateBlock BlockOperationType = "update"
+	MoveBlock   BlockOperationType = "move"
+	DeleteBlock BlockOperationType = "delete"
+)
+
+// BlockOperation is a single autosave change against a stored editor. BlockID names the block being
+// updated, moved or deleted. After names the block an inserted or moved block is placed behind, an
+// empty value places it first.
+type BlockOperation struct {
+	Op      BlockOperationType     `json:"op" binding:"required,oneof=insert update move delete"`
+	BlockID string                 `json:"block_id"`
+	After   string                 `json:"after"`
+	Block   *Block                 `json:"block"`
+	Data    map[string]interface{} `json:"data"`
+}
+
+type BlockOperationError struct {
+	Index   int    `json:"index"`
+	BlockID string `json:"block_id"`
+	Reason  string `json:"reason"`
+}
+
+func (err BlockOperationError) Error() string {
+	return fmt.Sprintf("operation %d on block %q: %s", err.Index, err.BlockID, err.Reason)
+}
+
+// ApplyOperations applies the operations in order. Either every operation applies or the editor is
+// left untouched and the first failing operation is reported.
+func (e *Editor) ApplyOperations(operations []BlockOperation) error {
+	blocks := make([]Block, len(e.Blocks))
+	copy(blocks, e.Blocks)
+
+	for i, operation := range operations {
+		var err error
+		blocks, err = applyOperation(blocks, operation)
+		if err != nil {
+			return BlockOperationError{Index: i, BlockID: operationBlockID(operation), Reason: err.Error()}
+		}
+	}
+
+	e.Blocks = blocks
+	return nil
+}
+
+func applyOperation(blocks []Block, operation BlockOperation) ([]Block, error) {
+	switch operation.Op {
+	case InsertBlock:
+		if operation.Block == nil || operation.Block.ID == "" {
+			return nil, fmt.Errorf("insert requires a block with an id")
+		}
+		if blockIndex(blocks, operation.Block.ID) != -1 {
+			return nil, fmt.Errorf("block already exists")
+		}
+		return insertAfter(blocks, operation.After, *operation.Block)
+	case UpdateBlock:
+		index := blockIndex(blocks, operation.BlockID)
+		if index == -1 {
+			return nil, fmt.Errorf("unknown block")
+		}
+		if operation.Data == nil {
+			return nil, fmt.Errorf("update requires data")
+		}
+		blocks[index].Data = operation.Data
+		return blocks, nil
+	case MoveBlock:
+		index := blockIndex(blocks, operation.BlockID)
+		if index == -1 {
+			return nil, fmt.Errorf("unknown block")
+		}
+		if operation.After == operation.BlockID {
+			return nil, fmt.Errorf("block cannot be moved after itself")
+		}
+		block := blocks[index]
+		return insertAfter(append(blocks[:index:index], blocks[index+1:]...), operation.After, block)
+	case DeleteBlock:
+		index := blockIndex(blocks, operation.BlockID)
+		if index == -1 {
+			return nil, fmt.Errorf("unknown block")
+		}
+		return append(blocks[:index:index], blocks[index+1:]...), nil
+	}
+	return nil, fmt.Errorf("unsupported operation %q", operation.Op)
+}
+
+func insertAfter(blocks []Block, after string, block Block) ([]Block, error) {
+	position := 0
+	if after != "" {
+		index := blockIndex(blocks, after)
+		if index == -1 {
+			return nil, fmt.Errorf("unknown block %q to insert after", after)
+		}
+		position = index + 1
+	}
+
+	result := make([]Block, 0, len(blocks)+1)
+	result = append(result, blocks[:position]...)
+	result = append(result, block)
+	return append(result, blocks[position:]...), nil
+}
+
+func blockIndex(blocks []Block, id string) int {
+	for i, block := range blocks {
+		if block.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func operationBlockID(operation BlockOperation) string {
+	if operation.Op == InsertBlock && operation.Block != nil {
+		return operation.Block.ID
+	}
+	return operation.BlockID
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func patchEditor() Editor {
+	return Editor{Blocks: []Block{
+		{ID: "a", Type: Header, Data: map[string]interface{}{"text": "Title", "level": 1}},
+		{ID: "b", Type: Paragraph, Data: map[string]interface{}{"text": "first"}},
+		{ID: "c", Type: Paragraph, Data: map[string]interface{}{"text": "second"}},
+	}}
+}
+
+func blockIDs(editor Editor) []string {
+	var ids []string
+	for _, block := range editor.Blocks {
+		ids = append(ids, block.ID)
+	}
+	return ids
+}
+
+func TestApplyOperationsInsertsUpdatesMovesAndDeletes(t *testing.T) {
+	editor := patchEditor()
+
+	err := editor.ApplyOperations([]BlockOperation{
+		{Op: InsertBlock, After: "a", Block: &Block{ID: "d", Type: Paragraph, Data: map[string]interface{}{"text": "new"}}},
+		{Op: UpdateBlock, BlockID: "b", Data: map[string]interface{}{"text": "edited"}},
+		{Op: MoveBlock, BlockID: "c", After: ""},
+		{Op: DeleteBlock, BlockID: "a"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"c", "d", "b"}, blockIDs(editor))
+	assert.Equal(t, "edited", editor.Blocks[2].Data["text"])
+}
+
+func TestApplyOperationsLeavesEditorUntouchedOnUnknownBlock(t *testing.T) {
+	editor := patchEditor()
+
+	err := editor.ApplyOperations([]BlockOperation{
+		{Op: UpdateBlock, BlockID: "b", Data: map[string]interface{}{"text": "edited"}},
+		{Op: DeleteBlock, BlockID: "missing"},
+	})
+
+	assert.Equal(t, BlockOperationError{Index: 1, BlockID: "missing", Reason: "unknown block"}, err)
+	assert.Equal(t, patchEditor(), editor)
+}
+
+func TestApplyOperationsRejectsInsertAfterUnknownBlock(t *testing.T) {
+	editor := patchEditor()
+
+	err := editor.ApplyOperations([]BlockOperation{
+		{Op: InsertBlock, After: "missing", Block: &Block{ID: "d", Type: Paragraph}},
+	})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, blockIDs(editor))
+}
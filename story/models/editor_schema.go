@@ -0,0 +1,222 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type TableElement struct {
+	WithHeadings bool       `json:"withHeadings"`
+	Stretched    bool       `json:"stretched"`
+	Content      [][]string `json:"content"`
+}
+
+type ListElement struct {
+	Style string     `json:"style"`
+	Items []ListItem `json:"items"`
+}
+
+// ListItem is either a plain string item of the flat list or an item of the nested list with its own
+// items. Both formats are stored by the editor.
+type ListItem struct {
+	Content string     `json:"content"`
+	Items   []ListItem `json:"items"`
+}
+
+func (item *ListItem) UnmarshalJSON(data []byte) error {
+	var content string
+	if err := json.Unmarshal(data, &content); err == nil {
+		*item = ListItem{Content: content}
+		return nil
+	}
+	type nestedItem ListItem
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode((*nestedItem)(item))
+}
+
+type CheckListElement struct {
+	Items []CheckListItem `json:"items"`
+}
+
+type CheckListItem struct {
+	Text    string `json:"text"`
+	Checked bool   `json:"checked"`
+}
+
+type QuoteElement struct {
+	Text      string `json:"text"`
+	Caption   string `json:"caption"`
+	Alignment string `json:"alignment"`
+}
+
+type WarningElement struct {
+	Title   string `json:"title"`
+	Message string `json:"message"`
+}
+
+type CodeElement struct {
+	Code string `json:"code"`
+}
+
+type LinkToolElement struct {
+	Link string   `json:"link"`
+	Meta LinkMeta `json:"meta"`
+}
+
+// LinkMeta is filled from the linked page, so fields other than the ones rendered are ignored instead
+// of rejected.
+type LinkMeta struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Image       struct {
+		URL string `json:"url"`
+	} `json:"image"`
+}
+
+func (meta *LinkMeta) UnmarshalJSON(data []byte) error {
+	type linkMeta LinkMeta
+	return json.Unmarshal(data, (*linkMeta)(meta))
+}
+
+type RawHTMLElement struct {
+	HTML string `json:"html"`
+}
+
+type DelimiterElement struct{}
+
+type BlockValidationError struct {
+	Index   int    `json:"index"`
+	BlockID string `json:"block_id"`
+	Reason  string `json:"reason"`
+}
+
+func (err BlockValidationError) Error() string {
+	return fmt.Sprintf("block %d %q: %s", err.Index, err.BlockID, err.Reason)
+}
+
+// Validate decodes every block into the typed element of its type and checks the element. Fields that
+// are not part of the element are rejected. All offending blocks are reported, in order.
+func (e Editor) Validate() []BlockValidationError {
+	var validationErrors []BlockValidationError
+	for index, block := range e.Blocks {
+		if reason := block.validate(); reason != "" {
+			validationErrors = append(validationErrors, BlockValidationError{Index: index, BlockID: block.ID, Reason: reason})
+		}
+	}
+	return validationErrors
+}
+
+func (block Block) validate() string {
+	switch block.Type {
+	case Paragraph:
+		var paragraph ParagraphElement
+		return decodeElement(block.Data, &paragraph, nil)
+	case Header:
+		var header HeaderElement
+		return decodeElement(block.Data, &header, func() string {
+			if header.Level < 1 || header.Level > 6 {
+				return "level must be between 1 and 6"
+			}
+			return ""
+		})
+	case Image:
+		var image ImageElement
+		return decodeElement(block.Data, &image, func() string {
+			if image.File.Url == "" {
+				return "file url is required"
+			}
+			return ""
+		})
+	case Table:
+		var table TableElement
+		return decodeElement(block.Data, &table, func() string { return table.validate() })
+	case List:
+		var list ListElement
+		return decodeElement(block.Data, &list, func() string { return list.validate() })
+	case CheckList:
+		var checkList CheckListElement
+		return decodeElement(block.Data, &checkList, func() string {
+			if len(checkList.Items) == 0 {
+				return "items must not be empty"
+			}
+			return ""
+		})
+	case Quote:
+		var quote QuoteElement
+		return decodeElement(block.Data, &quote, func() string {
+			if quote.Alignment != "" && quote.Alignment != "left" && quote.Alignment != "center" {
+				return "alignment must be left or center"
+			}
+			return ""
+		})
+	case Warning:
+		var warning WarningElement
+		return decodeElement(block.Data, &warning, nil)
+	case Code:
+		var code CodeElement
+		return decodeElement(block.Data, &code, nil)
+	case LinkTool:
+		var linkTool LinkToolElement
+		return decodeElement(block.Data, &linkTool, func() string {
+			parsed, err := url.Parse(linkTool.Link)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return "link must be an absolute http or https url"
+			}
+			return ""
+		})
+	case RawHTML:
+		var raw RawHTMLElement
+		return decodeElement(block.Data, &raw, nil)
+	case Separator:
+		var delimiter DelimiterElement
+		return decodeElement(block.Data, &delimiter, nil)
+	}
+	return fmt.Sprintf("unsupported block type %q", block.Type)
+}
+
+// decodeElement decodes the block data strictly into the element and then runs the element rule, if
+// there is one.
+func decodeElement(data map[string]interface{}, element interface{}, rule func() string) string {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err.Error()
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(element); err != nil {
+		if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+			return fmt.Sprintf("%s must be of type %s", typeErr.Field, typeErr.Type)
+		}
+		return strings.TrimPrefix(err.Error(), "json: ")
+	}
+	if rule == nil {
+		return ""
+	}
+	return rule()
+}
+
+func (table TableElement) validate() string {
+	if len(table.Content) == 0 {
+		return "content must not be empty"
+	}
+	for index, row := range table.Content {
+		if len(row) != len(table.Content[0]) {
+			return fmt.Sprintf("row %d has %d cells, expected %d", index, len(row), len(table.Content[0]))
+		}
+	}
+	return ""
+}
+
+func (list ListElement) validate() string {
+	if list.Style != "ordered" && list.Style != "unordered" {
+		return "style must be ordered or unordered"
+	}
+	if len(list.Items) == 0 {
+		return "items must not be empty"
+	}
+	return ""
+}
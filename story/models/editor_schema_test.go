@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateAcceptsWellFormedBlocks(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{ID: "a", Type: Header, Data: map[string]interface{}{"text": "Title", "level": float64(2)}},
+		{ID: "b", Type: Table, Data: map[string]interface{}{"withHeadings": true, "content": []interface{}{[]interface{}{"a", "b"}, []interface{}{"1", "2"}}}},
+		{ID: "c", Type: List, Data: map[string]interface{}{"style": "ordered", "items": []interface{}{"one", map[string]interface{}{"content": "two", "items": []interface{}{}}}}},
+		{ID: "d", Type: CheckList, Data: map[string]interface{}{"items": []interface{}{map[string]interface{}{"text": "done", "checked": true}}}},
+		{ID: "e", Type: Quote, Data: map[string]interface{}{"text": "quoted", "caption": "", "alignment": "left"}},
+		{ID: "f", Type: Warning, Data: map[string]interface{}{"title": "Note", "message": "careful"}},
+		{ID: "g", Type: Code, Data: map[string]interface{}{"code": "x := 1"}},
+		{ID: "h", Type: LinkTool, Data: map[string]interface{}{"link": "https://example.com", "meta": map[string]interface{}{"title": "Example", "site_name": "example"}}},
+		{ID: "i", Type: Image, Data: map[string]interface{}{"file": map[string]interface{}{"url": "https://cdn.example.com/a.png", "size": float64(10)}, "caption": ""}},
+		{ID: "j", Type: Separator, Data: map[string]interface{}{}},
+	}}
+
+	assert.Empty(t, editor.Validate())
+}
+
+func TestValidateReportsEveryInvalidBlock(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{ID: "a", Type: Table, Data: map[string]interface{}{"content": []interface{}{[]interface{}{"a", "b"}, []interface{}{"1"}}}},
+		{ID: "b", Type: Paragraph, Data: map[string]interface{}{"text": "fine"}},
+		{ID: "c", Type: CheckList, Data: map[string]interface{}{"items": []interface{}{map[string]interface{}{"text": "x", "checked": "yes"}}}},
+		{ID: "d", Type: Quote, Data: map[string]interface{}{"text": "quoted", "color": "red"}},
+		{ID: "e", Type: LinkTool, Data: map[string]interface{}{"link": "javascript:alert(1)"}},
+		{ID: "f", Type: Separator, Data: map[string]interface{}{"text": "x"}},
+		{ID: "g", Type: "video", Data: map[string]interface{}{}},
+	}}
+
+	validationErrors := editor.Validate()
+
+	var ids []string
+	for _, validationErr := range validationErrors {
+		ids = append(ids, validationErr.BlockID)
+	}
+	assert.Equal(t, []string{"a", "c", "d", "e", "f", "g"}, ids)
+	assert.Equal(t, BlockValidationError{Index: 0, BlockID: "a", Reason: "row 1 has 1 cells, expected 2"}, validationErrors[0])
+	assert.Contains(t, validationErrors[1].Reason, "checked must be of type bool")
+	assert.Equal(t, `unknown field "color"`, validationErrors[2].Reason)
+	assert.Equal(t, "link must be an absolute http or https url", validationErrors[3].Reason)
+	assert.Equal(t, `unknown field "text"`, validationErrors[4].Reason)
+	assert.Equal(t, `unsupported block type "video"`, validationErrors[5].Reason)
+}
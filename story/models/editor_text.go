@@ -0,0 +1,70 @@
+package models
+
+import "strings"
+
+// PlainText returns the readable text of the block without markup, one line per field. Code is
+// returned as written.
+func (block Block) PlainText() string {
+	data := block.Data
+	var lines []string
+	add := func(values ...string) {
+		for _, value := range values {
+			if text := strings.TrimSpace(plainText(value)); text != "" {
+				lines = append(lines, text)
+			}
+		}
+	}
+
+	switch block.Type {
+	case Paragraph, Header:
+		add(stringField(data, "text"))
+	case Quote:
+		add(stringField(data, "text"), stringField(data, "caption"))
+	case Warning:
+		add(stringField(data, "title"), stringField(data, "message"))
+	case Image:
+		add(stringField(data, "caption"))
+	case RawHTML:
+		add(stringField(data, "html"))
+	case Code:
+		if code := strings.TrimSpace(stringField(data, "code")); code != "" {
+			lines = append(lines, code)
+		}
+	case LinkTool:
+		meta, _ := data["meta"].(map[string]interface{})
+		add(stringField(meta, "title"), stringField(meta, "description"))
+	case CheckList:
+		items, _ := data["items"].([]interface{})
+		for _, item := range items {
+			entry, _ := item.(map[string]interface{})
+			add(stringField(entry, "text"))
+		}
+	case List:
+		add(listItemTexts(data["items"])...)
+	case Table:
+		rows, _ := data["content"].([]interface{})
+		for _, row := range rows {
+			cells, _ := row.([]interface{})
+			for _, cell := range cells {
+				text, _ := cell.(string)
+				add(text)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func listItemTexts(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var texts []string
+	for _, item := range items {
+		switch item := item.(type) {
+		case string:
+			texts = append(texts, item)
+		case map[string]interface{}:
+			texts = append(texts, stringField(item, "content"))
+			texts = append(texts, listItemTexts(item["items"])...)
+		}
+	}
+	return texts
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/mitchellh/mapstructure"
+	"strings"
+	"unicode"
+)
+
+// TOCEntry is a section of a post, linked from the table of contents through the anchor of its header.
+type TOCEntry struct {
+	Anchor string `json:"anchor"`
+	Text   string `json:"text"`
+	Level  int    `json:"level"`
+}
+
+// TableOfContents is stored as a JSON array.
+type TableOfContents []TOCEntry
+
+func (toc TableOfContents) Value() (driver.Value, error) {
+	if toc == nil {
+		return "[]", nil
+	}
+	value, err := json.Marshal(toc)
+	return string(value), err
+}
+
+func (toc *TableOfContents) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*toc = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(value, toc)
+	case string:
+		return json.Unmarshal([]byte(value), toc)
+	}
+	return errors.New("unsupported type for table of contents")
+}
+
+// TableOfContents lists the header blocks in order. Anchors are derived from the header text, so they
+// stay the same when other parts of the post are edited.
+func (e Editor) TableOfContents() TableOfContents {
+	anchors := e.headerAnchors()
+	toc := TableOfContents{}
+	for index, block := range e.Blocks {
+		anchor, ok := anchors[index]
+		if !ok {
+			continue
+		}
+		var header HeaderElement
+		if err := mapstructure.Decode(block.Data, &header); err != nil {
+			continue
+		}
+		toc = append(toc, TOCEntry{Anchor: anchor, Text: strings.TrimSpace(plainText(header.Text)), Level: headerLevel(header.Level)})
+	}
+	return toc
+}
+
+// headerAnchors returns the anchor of each header block with text, by block index. Headers with the same
+// text get a numbered suffix in the order they appear.
+func (e Editor) headerAnchors() map[int]string {
+	anchors := map[int]string{}
+	used := map[string]bool{}
+	for index, block := range e.Blocks {
+		if !block.Type.IsEqual(Header) {
+			continue
+		}
+		text := strings.TrimSpace(plainText(stringField(block.Data, "text")))
+		if text == "" {
+			continue
+		}
+		base := anchorSlug(text)
+		anchor := base
+		for suffix := 2; used[anchor]; suffix++ {
+			anchor = fmt.Sprintf("%s-%d", base, suffix)
+		}
+		used[anchor] = true
+		anchors[index] = anchor
+	}
+	return anchors
+}
+
+// anchorSlug keeps the letters and digits of the text in lower case, in any script, and joins the words
+// with hyphens.
+func anchorSlug(text string) string {
+	var builder strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			if pendingHyphen && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingHyphen = false
+			builder.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+	if builder.Len() == 0 {
+		return "section"
+	}
+	return builder.String()
+}
+
+// headerLevel falls back to a second level header for levels the editor does not produce.
+func headerLevel(level int) int {
+	if level < 1 || level > 6 {
+		return 2
+	}
+	return level
+}
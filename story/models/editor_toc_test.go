@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTableOfContentsListsHeadersWithUniqueAnchors(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{Type: Header, Data: map[string]interface{}{"text": "Getting <b>started</b>!", "level": float64(1)}},
+		{Type: Paragraph, Data: map[string]interface{}{"text": "Getting started"}},
+		{Type: Header, Data: map[string]interface{}{"text": "Setup", "level": float64(2)}},
+		{Type: Header, Data: map[string]interface{}{"text": "Setup", "level": float64(3)}},
+		{Type: Header, Data: map[string]interface{}{"text": "   ", "level": float64(2)}},
+		{Type: Header, Data: map[string]interface{}{"text": "தமிழ் பகுதி", "level": float64(9)}},
+		{Type: Header, Data: map[string]interface{}{"text": "???", "level": float64(2)}},
+	}}
+
+	assert.Equal(t, TableOfContents{
+		{Anchor: "getting-started", Text: "Getting started!", Level: 1},
+		{Anchor: "setup", Text: "Setup", Level: 2},
+		{Anchor: "setup-2", Text: "Setup", Level: 3},
+		{Anchor: "தமிழ்-பகுதி", Text: "தமிழ் பகுதி", Level: 2},
+		{Anchor: "section", Text: "???", Level: 2},
+	}, editor.TableOfContents())
+	assert.Equal(t, `<h1 id="getting-started">Getting <b>started</b>!</h1>`, Editor{Blocks: editor.Blocks[:1]}.RenderHTML())
+}
+
+func TestTableOfContentsIsStoredAsJSON(t *testing.T) {
+	value, err := TableOfContents(nil).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", value)
+
+	var toc TableOfContents
+	assert.Nil(t, toc.Scan([]byte(`[{"anchor":"setup","text":"Setup","level":2}]`)))
+	assert.Equal(t, TableOfContents{{Anchor: "setup", Text: "Setup", Level: 2}}, toc)
+	assert.Nil(t, toc.Scan(nil))
+	assert.Nil(t, toc)
+}
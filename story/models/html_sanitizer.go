@@ -0,0 +1,354 @@
+package models
+
+import (
+	"golang.org/x/net/html"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// SanitizePolicy lists the tags that survive sanitization and the attributes each of them may keep.
+// Tags outside the policy are dropped but their text is kept, except for the tags in droppedContent
+// whose content is never meant to be read.
+type SanitizePolicy map[string][]string
+
+// SanitizePolicies holds the policy for every block type whose data carries HTML. Blocks of other
+// types are left untouched.
+type SanitizePolicies map[ElementType]SanitizePolicy
+
+// StrippedMarkup is a tag, or an attribute of a tag, that sanitization removed.
+type StrippedMarkup struct {
+	Tag       string `json:"tag"`
+	Attribute string `json:"attribute,omitempty"`
+}
+
+type SanitizeReport struct {
+	Index    int              `json:"index"`
+	BlockID  string           `json:"block_id"`
+	Stripped []StrippedMarkup `json:"stripped"`
+}
+
+var droppedContent = map[string]bool{"script": true, "style": true, "iframe": true, "object": true, "embed": true, "template": true, "noscript": true}
+
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+var urlAttributes = map[string]bool{"href": true, "src": true}
+
+var inlinePolicy = SanitizePolicy{
+	"a":      {"href"},
+	"b":      nil,
+	"strong": nil,
+	"i":      nil,
+	"em":     nil,
+	"u":      nil,
+	"s":      nil,
+	"mark":   nil,
+	"code":   nil,
+	"sub":    nil,
+	"sup":    nil,
+	"br":     nil,
+}
+
+var rawPolicy = mergePolicies(inlinePolicy, SanitizePolicy{
+	"p":          nil,
+	"div":        nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"ul":         nil,
+	"ol":         nil,
+	"li":         nil,
+	"blockquote": nil,
+	"pre":        nil,
+	"hr":         nil,
+	"table":      nil,
+	"thead":      nil,
+	"tbody":      nil,
+	"tr":         nil,
+	"th":         nil,
+	"td":         nil,
+	"figure":     nil,
+	"figcaption": nil,
+	"cite":       nil,
+	"img":        {"src", "alt", "width", "height"},
+})
+
+// DefaultSanitizePolicies allows inline markup in every text field and a wider set of block markup
+// in raw blocks.
+func DefaultSanitizePolicies() SanitizePolicies {
+	return SanitizePolicies{
+		Paragraph: inlinePolicy,
+		Header:    inlinePolicy,
+		Table:     inlinePolicy,
+		List:      inlinePolicy,
+		Quote:     inlinePolicy,
+		CheckList: inlinePolicy,
+		Warning:   inlinePolicy,
+		Image:     inlinePolicy,
+		RawHTML:   rawPolicy,
+	}
+}
+
+func mergePolicies(policies ...SanitizePolicy) SanitizePolicy {
+	merged := SanitizePolicy{}
+	for _, policy := range policies {
+		for tag, attributes := range policy {
+			merged[tag] = attributes
+		}
+	}
+	return merged
+}
+
+// Sanitize sanitizes the HTML fields of every block in place with the policy of the block type and
+// reports the blocks that lost markup.
+func (e *Editor) Sanitize(policies SanitizePolicies) []SanitizeReport {
+	var reports []SanitizeReport
+	for index, block := range e.Blocks {
+		policy, ok := policies[block.Type]
+		if !ok {
+			continue
+		}
+		var stripped []StrippedMarkup
+		block.sanitizeFields(func(value string) string {
+			output, removed := policy.Sanitize(value)
+			stripped = appendStripped(stripped, removed...)
+			return output
+		})
+		if len(stripped) > 0 {
+			reports = append(reports, SanitizeReport{Index: index, BlockID: block.ID, Stripped: stripped})
+		}
+	}
+	return reports
+}
+
+// sanitizeFields replaces every field of the block that the editor stores as HTML.
+func (block Block) sanitizeFields(sanitize func(string) string) {
+	replace := func(data map[string]interface{}, keys ...string) {
+		for _, key := range keys {
+			if value, ok := data[key].(string); ok {
+				data[key] = sanitize(value)
+			}
+		}
+	}
+
+	switch block.Type {
+	case Paragraph, Header:
+		replace(block.Data, "text")
+	case Quote:
+		replace(block.Data, "text", "caption")
+	case Warning:
+		replace(block.Data, "title", "message")
+	case Image:
+		replace(block.Data, "caption")
+	case RawHTML:
+		replace(block.Data, "html")
+	case CheckList:
+		items, _ := block.Data["items"].([]interface{})
+		for _, item := range items {
+			if entry, ok := item.(map[string]interface{}); ok {
+				replace(entry, "text")
+			}
+		}
+	case List:
+		sanitizeListItems(block.Data["items"], sanitize, replace)
+	case Table:
+		rows, _ := block.Data["content"].([]interface{})
+		for _, row := range rows {
+			cells, _ := row.([]interface{})
+			for i, cell := range cells {
+				if text, ok := cell.(string); ok {
+					cells[i] = sanitize(text)
+				}
+			}
+		}
+	}
+}
+
+func sanitizeListItems(value interface{}, sanitize func(string) string, replace func(map[string]interface{}, ...string)) {
+	items, _ := value.([]interface{})
+	for i, item := range items {
+		switch item := item.(type) {
+		case string:
+			items[i] = sanitize(item)
+		case map[string]interface{}:
+			replace(item, "content")
+			sanitizeListItems(item["items"], sanitize, replace)
+		}
+	}
+}
+
+func appendStripped(stripped []StrippedMarkup, removed ...StrippedMarkup) []StrippedMarkup {
+	for _, markup := range removed {
+		seen := false
+		for _, existing := range stripped {
+			seen = seen || existing == markup
+		}
+		if !seen {
+			stripped = append(stripped, markup)
+		}
+	}
+	return stripped
+}
+
+// Sanitize returns the input with everything outside the policy removed, along with what was
+// removed. Sanitizing already sanitized output removes nothing.
+func (policy SanitizePolicy) Sanitize(input string) (string, []StrippedMarkup) {
+	var builder strings.Builder
+	var open []string
+	var stripped []StrippedMarkup
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	skipping := ""
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if tokenizer.Err() != io.EOF {
+				return builder.String(), stripped
+			}
+			break
+		}
+
+		token := tokenizer.Token()
+		if skipping != "" {
+			if tokenType == html.EndTagToken && token.Data == skipping {
+				skipping = ""
+			}
+			continue
+		}
+
+		switch tokenType {
+		case html.TextToken:
+			builder.WriteString(html.EscapeString(token.Data))
+		case html.CommentToken:
+			stripped = appendStripped(stripped, StrippedMarkup{Tag: "!--"})
+		case html.StartTagToken, html.SelfClosingTagToken:
+			attributes, allowed := policy[token.Data]
+			if !allowed || droppedContent[token.Data] {
+				stripped = appendStripped(stripped, StrippedMarkup{Tag: token.Data})
+				if droppedContent[token.Data] && tokenType == html.StartTagToken {
+					skipping = token.Data
+				}
+				continue
+			}
+			stripped = appendStripped(stripped, writeStartTag(&builder, token, attributes)...)
+			if tokenType == html.StartTagToken && !voidTags[token.Data] {
+				open = append(open, token.Data)
+			}
+		case html.EndTagToken:
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != token.Data {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					builder.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		builder.WriteString("</" + open[i] + ">")
+	}
+	return builder.String(), stripped
+}
+
+func (policy SanitizePolicy) sanitize(input string) string {
+	output, _ := policy.Sanitize(input)
+	return output
+}
+
+// writeStartTag writes the tag with its allowed attributes and returns the attributes it dropped.
+// Links always get a rel that keeps them from passing referrer or ranking, so a rel that was already
+// there is replaced rather than reported.
+func writeStartTag(builder *strings.Builder, token html.Token, allowedAttributes []string) []StrippedMarkup {
+	var stripped []StrippedMarkup
+	builder.WriteString("<" + token.Data)
+	for _, attribute := range token.Attr {
+		if token.Data == "a" && attribute.Key == "rel" {
+			continue
+		}
+		if attribute.Namespace != "" || !containsString(allowedAttributes, attribute.Key) || (urlAttributes[attribute.Key] && !isSafeURL(attribute.Val)) {
+			stripped = append(stripped, StrippedMarkup{Tag: token.Data, Attribute: attribute.Key})
+			continue
+		}
+		writeAttribute(builder, attribute.Key, attribute.Val)
+	}
+	if token.Data == "a" {
+		writeAttribute(builder, "rel", "nofollow noopener noreferrer")
+	}
+	builder.WriteString(">")
+	return stripped
+}
+
+func writeAttribute(builder *strings.Builder, key, value string) {
+	builder.WriteString(" " + key + "=\"" + html.EscapeString(value) + "\"")
+}
+
+// isSafeURL accepts relative URLs and absolute http, https and mailto URLs. Anything that does not
+// parse, such as schemes split by control characters, is rejected.
+func isSafeURL(raw string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// plainText returns the text of an HTML fragment without any markup, unescaped. Line breaks and
+// block tags become spaces so that the words on either side stay apart.
+func plainText(input string) string {
+	var builder strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return builder.String()
+		case html.TextToken:
+			builder.WriteString(tokenizer.Token().Data)
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			if _, inline := inlinePolicy[string(name)]; !inline || string(name) == "br" {
+				if builder.Len() > 0 && !strings.HasSuffix(builder.String(), " ") {
+					builder.WriteString(" ")
+				}
+			}
+		}
+	}
+}
+
+// MapText replaces the text between the tags of an HTML fragment with what mapText returns for it. Tags,
+// their attributes and character references are written back exactly as they were.
+func MapText(input string, mapText func(string) string) string {
+	var builder strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return builder.String()
+		case html.TextToken:
+			builder.WriteString(mapText(string(tokenizer.Raw())))
+		default:
+			builder.Write(tokenizer.Raw())
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSanitizePolicyReportsStrippedMarkup(t *testing.T) {
+	output, stripped := inlinePolicy.Sanitize(`<b onclick="x()">bold</b><script>alert(1)</script><a href="javascript:alert(1)">link</a><!-- note --><span>text</span>`)
+
+	assert.Equal(t, `<b>bold</b><a rel="nofollow noopener noreferrer">link</a>text`, output)
+	assert.Equal(t, []StrippedMarkup{
+		{Tag: "b", Attribute: "onclick"},
+		{Tag: "script"},
+		{Tag: "a", Attribute: "href"},
+		{Tag: "!--"},
+		{Tag: "span"},
+	}, stripped)
+}
+
+func TestSanitizePolicyIsIdempotent(t *testing.T) {
+	once, _ := rawPolicy.Sanitize(`<p>see <a href="https://example.com" rel="opener">this</a> & <img src="/a.png" onerror="x()"></p>`)
+
+	twice, stripped := rawPolicy.Sanitize(once)
+
+	assert.Equal(t, once, twice)
+	assert.Empty(t, stripped)
+}
+
+func TestEditorSanitizeAppliesPolicyOfEachBlockType(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{ID: "a", Type: Paragraph, Data: map[string]interface{}{"text": "<p>inline <i>only</i></p>"}},
+		{ID: "b", Type: RawHTML, Data: map[string]interface{}{"html": "<p>kept</p><iframe src=\"https://evil.example\"></iframe>"}},
+		{ID: "c", Type: Code, Data: map[string]interface{}{"code": "<script>shown as code</script>"}},
+		{ID: "d", Type: List, Data: map[string]interface{}{"style": "unordered", "items": []interface{}{
+			map[string]interface{}{"content": "<u>one</u>", "items": []interface{}{map[string]interface{}{"content": "<img src=x>two", "items": []interface{}{}}}},
+		}}},
+		{ID: "e", Type: Table, Data: map[string]interface{}{"content": []interface{}{[]interface{}{"<b>cell</b>", "<div>cell</div>"}}}},
+	}}
+
+	reports := editor.Sanitize(DefaultSanitizePolicies())
+
+	assert.Equal(t, []SanitizeReport{
+		{Index: 0, BlockID: "a", Stripped: []StrippedMarkup{{Tag: "p"}}},
+		{Index: 1, BlockID: "b", Stripped: []StrippedMarkup{{Tag: "iframe"}}},
+		{Index: 3, BlockID: "d", Stripped: []StrippedMarkup{{Tag: "img"}}},
+		{Index: 4, BlockID: "e", Stripped: []StrippedMarkup{{Tag: "div"}}},
+	}, reports)
+	assert.Equal(t, "inline <i>only</i>", editor.Blocks[0].Data["text"])
+	assert.Equal(t, "<p>kept</p>", editor.Blocks[1].Data["html"])
+	assert.Equal(t, "<script>shown as code</script>", editor.Blocks[2].Data["code"])
+	nested := editor.Blocks[3].Data["items"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "<u>one</u>", nested["content"])
+	assert.Equal(t, "two", nested["items"].([]interface{})[0].(map[string]interface{})["content"])
+	assert.Equal(t, []interface{}{"<b>cell</b>", "cell"}, editor.Blocks[4].Data["content"].([]interface{})[0])
+}
+
+func TestEditorSanitizeUsesConfiguredPolicy(t *testing.T) {
+	editor := Editor{Blocks: []Block{
+		{ID: "a", Type: Paragraph, Data: map[string]interface{}{"text": "<b>bold</b> <a href=\"/x\">link</a>"}},
+	}}
+
+	reports := editor.Sanitize(SanitizePolicies{Paragraph: {"b": nil}})
+
+	assert.Equal(t, []SanitizeReport{{Index: 0, BlockID: "a", Stripped: []StrippedMarkup{{Tag: "a"}}}}, reports)
+	assert.Equal(t, "<b>bold</b> link", editor.Blocks[0].Data["text"])
+}
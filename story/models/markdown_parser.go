@@ -0,0 +1,684 @@
+package models
+
+import (
+	"crypto/rand"
+	"golang.org/x/net/html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	atxHeadingPattern     = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	thematicBreakPattern  = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	setextPattern         = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	fencePattern          = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})(.*)$")
+	listItemPattern       = regexp.MustCompile(`^( {0,3})([-+*]|\d{1,9}[.)])([ \t]+|$)(.*)$`)
+	taskPattern           = regexp.MustCompile(`^\[([ xX])\][ \t]+(.*)$`)
+	tableDelimiterPattern = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	imageOnlyPattern      = regexp.MustCompile(`^!\[(.*)\]\(<?([^ \t<>]+)>?(?:[ \t]+"[^"]*")?\)$`)
+	htmlBlockPattern      = regexp.MustCompile(`^ {0,3}(?:<!--|</?(?i:address|article|aside|blockquote|details|div|dl|figure|footer|h[1-6]|header|hr|iframe|ol|p|pre|section|table|ul|video|audio|script|style)(?:[ \t/>]|$))`)
+	inlineTagPattern      = regexp.MustCompile(`^</?[a-zA-Z][a-zA-Z0-9-]*(?:\s+[a-zA-Z_:][a-zA-Z0-9_.:-]*(?:\s*=\s*(?:"[^"]*"|'[^']*'|[^\s"'=<>` + "`" + `]+))?)*\s*/?>`)
+	autolinkPattern       = regexp.MustCompile(`^<((?:https?|mailto):[^\s<>]*)>`)
+	entityPattern         = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[a-zA-Z][a-zA-Z0-9]{1,31});`)
+)
+
+// ParseMarkdown converts CommonMark with the GFM table and task list extensions into editor blocks.
+// Inline markup is converted to the HTML the editor stores and then sanitized. Markdown that has no
+// block equivalent, such as a list inside a quote, is flattened into the text of the enclosing block.
+func ParseMarkdown(source string) Editor {
+	source = strings.ReplaceAll(strings.ReplaceAll(source, "\r\n", "\n"), "\r", "\n")
+	return Editor{Blocks: parseMarkdownBlocks(strings.Split(source, "\n"))}
+}
+
+func parseMarkdownBlocks(lines []string) []Block {
+	var blocks []Block
+	var paragraph []string
+
+	flushParagraph := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, paragraphBlock(paragraph))
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := expandTabs(lines[i])
+
+		if strings.TrimSpace(line) == "" {
+			flushParagraph()
+			continue
+		}
+
+		if len(paragraph) > 0 {
+			if match := setextPattern.FindStringSubmatch(line); match != nil {
+				level := 2
+				if match[1][0] == '=' {
+					level = 1
+				}
+				blocks = append(blocks, headerBlock(strings.Join(trimLines(paragraph), "\n"), level))
+				paragraph = nil
+				continue
+			}
+		}
+
+		if len(paragraph) == 0 && strings.HasPrefix(line, "    ") {
+			var code []string
+			for ; i < len(lines); i++ {
+				current := expandTabs(lines[i])
+				if strings.TrimSpace(current) != "" && !strings.HasPrefix(current, "    ") {
+					break
+				}
+				code = append(code, strings.TrimPrefix(current, "    "))
+			}
+			i--
+			blocks = append(blocks, codeBlock(strings.TrimRight(strings.Join(code, "\n"), "\n")))
+			continue
+		}
+
+		if match := fencePattern.FindStringSubmatch(line); match != nil && !(match[2][0] == '`' && strings.Contains(match[3], "`")) {
+			indent, fence := len(match[1]), match[2]
+			var code []string
+			for i++; i < len(lines); i++ {
+				current := expandTabs(lines[i])
+				trimmed := strings.TrimSpace(current)
+				if strings.HasPrefix(trimmed, fence[:1]) && strings.Trim(trimmed, fence[:1]) == "" && len(trimmed) >= len(fence) {
+					break
+				}
+				code = append(code, trimIndent(current, indent))
+			}
+			flushParagraph()
+			blocks = append(blocks, codeBlock(strings.Join(code, "\n")))
+			continue
+		}
+
+		if match := atxHeadingPattern.FindStringSubmatch(line); match != nil {
+			flushParagraph()
+			blocks = append(blocks, headerBlock(match[2], len(match[1])))
+			continue
+		}
+
+		if thematicBreakPattern.MatchString(line) {
+			flushParagraph()
+			blocks = append(blocks, newBlock(Separator, map[string]interface{}{}))
+			continue
+		}
+
+		if quoted, ok := stripQuoteMarker(line); ok {
+			flushParagraph()
+			quote := []string{quoted}
+			for i++; i < len(lines); i++ {
+				current := expandTabs(lines[i])
+				if quoted, ok := stripQuoteMarker(current); ok {
+					quote = append(quote, quoted)
+					continue
+				}
+				if strings.TrimSpace(current) == "" || startsBlock(current) || strings.TrimSpace(quote[len(quote)-1]) == "" {
+					break
+				}
+				quote = append(quote, current)
+			}
+			i--
+			blocks = append(blocks, quoteBlock(quote))
+			continue
+		}
+
+		if match := listItemPattern.FindStringSubmatch(line); match != nil && (len(paragraph) == 0 || canInterruptParagraph(match)) {
+			flushParagraph()
+			end := listEnd(lines, i)
+			blocks = append(blocks, listBlock(lines[i:end]))
+			i = end - 1
+			continue
+		}
+
+		if htmlBlockPattern.MatchString(line) {
+			flushParagraph()
+			var raw []string
+			for ; i < len(lines) && strings.TrimSpace(lines[i]) != ""; i++ {
+				raw = append(raw, lines[i])
+			}
+			blocks = append(blocks, newBlock(RawHTML, map[string]interface{}{"html": strings.Join(raw, "\n")}))
+			continue
+		}
+
+		if strings.Contains(line, "|") && i+1 < len(lines) && tableDelimiterPattern.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "|") {
+			flushParagraph()
+			header := splitTableRow(line)
+			rows := [][]string{header}
+			for i += 2; i < len(lines) && strings.TrimSpace(lines[i]) != "" && !startsBlock(expandTabs(lines[i])); i++ {
+				rows = append(rows, splitTableRow(lines[i]))
+			}
+			i--
+			blocks = append(blocks, tableBlock(rows))
+			continue
+		}
+
+		paragraph = append(paragraph, line)
+	}
+
+	flushParagraph()
+	return blocks
+}
+
+func startsBlock(line string) bool {
+	if atxHeadingPattern.MatchString(line) || thematicBreakPattern.MatchString(line) || fencePattern.MatchString(line) || htmlBlockPattern.MatchString(line) {
+		return true
+	}
+	if _, ok := stripQuoteMarker(line); ok {
+		return true
+	}
+	match := listItemPattern.FindStringSubmatch(line)
+	return match != nil && canInterruptParagraph(match)
+}
+
+// canInterruptParagraph follows CommonMark: only a non empty item, and for ordered lists only one
+// starting at 1, may start a list directly below a paragraph line.
+func canInterruptParagraph(match []string) bool {
+	if strings.TrimSpace(match[4]) == "" {
+		return false
+	}
+	marker := match[2]
+	return !unicode.IsDigit(rune(marker[0])) || strings.TrimLeft(marker[:len(marker)-1], "0") == "1"
+}
+
+func stripQuoteMarker(line string) (string, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || !strings.HasPrefix(trimmed, ">") {
+		return "", false
+	}
+	trimmed = trimmed[1:]
+	return strings.TrimPrefix(trimmed, " "), true
+}
+
+func paragraphBlock(lines []string) Block {
+	text := strings.TrimSpace(strings.Join(trimLines(lines), "\n"))
+	if match := imageOnlyPattern.FindStringSubmatch(text); match != nil {
+		return newBlock(Image, map[string]interface{}{
+			"file":           map[string]interface{}{"url": match[2]},
+			"caption":        markdownInlineToHTML(match[1]),
+			"withBorder":     false,
+			"stretched":      false,
+			"withBackground": false,
+		})
+	}
+	return newBlock(Paragraph, map[string]interface{}{"text": markdownInlineToHTML(text)})
+}
+
+func headerBlock(text string, level int) Block {
+	return newBlock(Header, map[string]interface{}{"text": markdownInlineToHTML(strings.TrimSpace(text)), "level": level})
+}
+
+func codeBlock(code string) Block {
+	return newBlock(Code, map[string]interface{}{"code": code})
+}
+
+// quoteBlock joins the paragraphs of a quote with line breaks. A last line starting with an em dash
+// is the caption, which is how quotes are exported.
+func quoteBlock(lines []string) Block {
+	caption := ""
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if last := len(lines) - 1; last > 0 && strings.HasPrefix(strings.TrimSpace(lines[last]), "— ") {
+		caption = markdownInlineToHTML(strings.TrimPrefix(strings.TrimSpace(lines[last]), "— "))
+		lines = lines[:last]
+	}
+
+	var paragraphs []string
+	var current []string
+	for _, line := range append(lines, "") {
+		if strings.TrimSpace(line) != "" {
+			current = append(current, line)
+			continue
+		}
+		if len(current) > 0 {
+			paragraphs = append(paragraphs, markdownInlineToHTML(strings.Join(trimLines(current), "\n")))
+			current = nil
+		}
+	}
+	return newBlock(Quote, map[string]interface{}{"text": strings.Join(paragraphs, "<br>"), "caption": caption, "alignment": "left"})
+}
+
+type markdownListItem struct {
+	content  string
+	checked  *bool
+	children []markdownListItem
+}
+
+// listEnd returns the index of the first line after the list starting at start.
+func listEnd(lines []string, start int) int {
+	first := listItemPattern.FindStringSubmatch(expandTabs(lines[start]))
+	baseIndent := len(first[1])
+	ordered := unicode.IsDigit(rune(first[2][0]))
+	previousBlank := false
+
+	i := start + 1
+	for ; i < len(lines); i++ {
+		line := expandTabs(lines[i])
+		if strings.TrimSpace(line) == "" {
+			previousBlank = true
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent > baseIndent {
+			previousBlank = false
+			continue
+		}
+		if match := listItemPattern.FindStringSubmatch(line); match != nil && unicode.IsDigit(rune(match[2][0])) == ordered {
+			previousBlank = false
+			continue
+		}
+		if previousBlank || startsBlock(line) {
+			break
+		}
+	}
+	for i > start && strings.TrimSpace(lines[i-1]) == "" {
+		i--
+	}
+	return i
+}
+
+func parseListItems(lines []string) ([]markdownListItem, bool) {
+	var items []markdownListItem
+	var itemLines [][]string
+	ordered := false
+	contentIndent := 0
+
+	for index, line := range lines {
+		line = expandTabs(line)
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		match := listItemPattern.FindStringSubmatch(line)
+		if match != nil && (len(itemLines) == 0 || indent < contentIndent) {
+			if index == 0 {
+				ordered = unicode.IsDigit(rune(match[2][0]))
+			}
+			contentIndent = len(match[1]) + len(match[2]) + len(match[3])
+			if match[3] == "" || len(match[3]) > 4 {
+				contentIndent = len(match[1]) + len(match[2]) + 1
+			}
+			itemLines = append(itemLines, []string{match[4]})
+			continue
+		}
+		if len(itemLines) > 0 {
+			itemLines[len(itemLines)-1] = append(itemLines[len(itemLines)-1], trimIndent(line, contentIndent))
+		}
+	}
+
+	for _, current := range itemLines {
+		items = append(items, parseListItem(current))
+	}
+	return items, ordered
+}
+
+func parseListItem(lines []string) markdownListItem {
+	var text []string
+	nested := len(lines)
+	for index, line := range lines {
+		if index > 0 && listItemPattern.MatchString(expandTabs(line)) {
+			nested = index
+			break
+		}
+		if strings.TrimSpace(line) != "" {
+			text = append(text, line)
+		}
+	}
+
+	item := markdownListItem{}
+	content := strings.Join(trimLines(text), "\n")
+	if match := taskPattern.FindStringSubmatch(content); match != nil {
+		checked := match[1] != " "
+		item.checked = &checked
+		content = match[2]
+	}
+	item.content = markdownInlineToHTML(content)
+	if nested < len(lines) {
+		item.children, _ = parseListItems(lines[nested:])
+	}
+	return item
+}
+
+// listBlock turns a list into a checklist when every item is a task, into a flat list when no item
+// has children and into a nested list otherwise.
+func listBlock(lines []string) Block {
+	items, ordered := parseListItems(lines)
+
+	allTasks, nested := !ordered, false
+	for _, item := range items {
+		allTasks = allTasks && item.checked != nil && len(item.children) == 0
+		nested = nested || len(item.children) > 0
+	}
+
+	if allTasks {
+		var checklist []interface{}
+		for _, item := range items {
+			checklist = append(checklist, map[string]interface{}{"text": item.content, "checked": *item.checked})
+		}
+		return newBlock(CheckList, map[string]interface{}{"items": checklist})
+	}
+
+	style := "unordered"
+	if ordered {
+		style = "ordered"
+	}
+	return newBlock(List, map[string]interface{}{"style": style, "items": listItemsData(items, nested)})
+}
+
+func listItemsData(items []markdownListItem, nested bool) []interface{} {
+	data := []interface{}{}
+	for _, item := range items {
+		content := item.content
+		if item.checked != nil {
+			marker := "[ ] "
+			if *item.checked {
+				marker = "[x] "
+			}
+			content = marker + content
+		}
+		if !nested {
+			data = append(data, content)
+			continue
+		}
+		data = append(data, map[string]interface{}{"content": content, "items": listItemsData(item.children, true)})
+	}
+	return data
+}
+
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' && i+1 < len(line) && line[i+1] == '|' {
+			cell.WriteByte('|')
+			i++
+			continue
+		}
+		if line[i] == '|' {
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+			continue
+		}
+		cell.WriteByte(line[i])
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// tableBlock pads or cuts every row to the width of the header. A header of empty cells is how a
+// table without headings is exported, so it is dropped again on import.
+func tableBlock(rows [][]string) Block {
+	width := len(rows[0])
+	withHeadings := false
+	for _, cell := range rows[0] {
+		withHeadings = withHeadings || cell != ""
+	}
+	if !withHeadings {
+		rows = rows[1:]
+	}
+
+	content := []interface{}{}
+	for _, row := range rows {
+		cells := make([]interface{}, width)
+		for i := range cells {
+			cells[i] = ""
+			if i < len(row) {
+				cells[i] = markdownInlineToHTML(row[i])
+			}
+		}
+		content = append(content, cells)
+	}
+	return newBlock(Table, map[string]interface{}{"withHeadings": withHeadings, "content": content})
+}
+
+// markdownInlineToHTML converts emphasis, strikethrough, code spans, links, autolinks and hard line
+// breaks to HTML. Inline HTML is passed through and the result is sanitized with the inline policy.
+func markdownInlineToHTML(text string) string {
+	return inlinePolicy.sanitize(convertInline(text))
+}
+
+func convertInline(text string) string {
+	var builder strings.Builder
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && text[i+1] == '\n':
+			builder.WriteString("<br>")
+			i += 2
+			continue
+		case c == '\\' && i+1 < len(text) && isASCIIPunctuation(text[i+1]):
+			builder.WriteString(html.EscapeString(text[i+1 : i+2]))
+			i += 2
+			continue
+		case c == '\n':
+			if strings.HasSuffix(builder.String(), "  ") {
+				trimmed := strings.TrimRight(builder.String(), " ")
+				builder.Reset()
+				builder.WriteString(trimmed + "<br>")
+			} else {
+				builder.WriteString(" ")
+			}
+			i++
+			continue
+		case c == '`':
+			if code, next, ok := codeSpan(text, i); ok {
+				builder.WriteString("<code>" + html.EscapeString(code) + "</code>")
+				i = next
+				continue
+			}
+		case c == '!' && i+1 < len(text) && text[i+1] == '[':
+			if label, destination, next, ok := linkAt(text, i+1); ok {
+				builder.WriteString(`<a href="` + html.EscapeString(destination) + `">` + html.EscapeString(plainText(convertInline(label))) + "</a>")
+				i = next
+				continue
+			}
+		case c == '[':
+			if label, destination, next, ok := linkAt(text, i); ok {
+				builder.WriteString(`<a href="` + html.EscapeString(destination) + `">` + convertInline(label) + "</a>")
+				i = next
+				continue
+			}
+		case c == '&':
+			if entity := entityPattern.FindString(text[i:]); entity != "" {
+				builder.WriteString(entity)
+				i += len(entity)
+				continue
+			}
+		case c == '<':
+			if match := autolinkPattern.FindStringSubmatch(text[i:]); match != nil {
+				builder.WriteString(`<a href="` + html.EscapeString(match[1]) + `">` + html.EscapeString(match[1]) + "</a>")
+				i += len(match[0])
+				continue
+			}
+			if tag := inlineTagPattern.FindString(text[i:]); tag != "" {
+				builder.WriteString(tag)
+				i += len(tag)
+				continue
+			}
+		case c == '*' || c == '_' || c == '~':
+			if converted, next, ok := emphasisAt(text, i); ok {
+				builder.WriteString(converted)
+				i = next
+				continue
+			}
+			run := delimiterRun(text, i)
+			builder.WriteString(text[i : i+run])
+			i += run
+			continue
+		}
+		builder.WriteString(html.EscapeString(text[i : i+1]))
+		i++
+	}
+	return builder.String()
+}
+
+func codeSpan(text string, start int) (string, int, bool) {
+	run := delimiterRun(text, start)
+	for j := start + run; j < len(text); {
+		if text[j] != '`' {
+			j++
+			continue
+		}
+		closing := delimiterRun(text, j)
+		if closing == run {
+			code := strings.ReplaceAll(text[start+run:j], "\n", " ")
+			if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			return code, j + closing, true
+		}
+		j += closing
+	}
+	return "", 0, false
+}
+
+// linkAt parses [label](destination "title") starting at the opening bracket.
+func linkAt(text string, start int) (string, string, int, bool) {
+	depth := 0
+	labelEnd := -1
+	for j := start; j < len(text) && labelEnd < 0; j++ {
+		switch text[j] {
+		case '\\':
+			j++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				labelEnd = j
+			}
+		}
+	}
+	if labelEnd < 0 || labelEnd+1 >= len(text) || text[labelEnd+1] != '(' {
+		return "", "", 0, false
+	}
+
+	depth = 0
+	for j := labelEnd + 1; j < len(text); j++ {
+		switch text[j] {
+		case '\\':
+			j++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			destination := strings.TrimSpace(text[labelEnd+2 : j])
+			if fields := strings.Fields(destination); len(fields) > 0 {
+				destination = fields[0]
+			}
+			destination = strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">")
+			return text[start+1 : labelEnd], destination, j + 1, true
+		}
+	}
+	return "", "", 0, false
+}
+
+// emphasisAt converts a delimiter run and its closing run of the same length into b, i or s tags.
+// Underscores only open and close at word boundaries, as in CommonMark.
+func emphasisAt(text string, start int) (string, int, bool) {
+	c := text[start]
+	run := delimiterRun(text, start)
+	if (c == '~' && run != 2) || run > 3 || start+run >= len(text) || isSpace(text[start+run]) {
+		return "", 0, false
+	}
+	if c == '_' && start > 0 && isWordByte(text[start-1]) {
+		return "", 0, false
+	}
+
+	for j := start + run; j < len(text); {
+		if text[j] == '`' {
+			if _, next, ok := codeSpan(text, j); ok {
+				j = next
+				continue
+			}
+		}
+		if text[j] != c {
+			j++
+			continue
+		}
+		closing := delimiterRun(text, j)
+		if isSpace(text[j-1]) {
+			if _, next, ok := emphasisAt(text, j); ok {
+				j = next
+				continue
+			}
+		}
+		if closing == run && !isSpace(text[j-1]) && (c != '_' || j+closing >= len(text) || !isWordByte(text[j+closing])) {
+			inner := convertInline(text[start+run : j])
+			switch {
+			case c == '~':
+				inner = "<s>" + inner + "</s>"
+			case run == 1:
+				inner = "<i>" + inner + "</i>"
+			case run == 2:
+				inner = "<b>" + inner + "</b>"
+			default:
+				inner = "<b><i>" + inner + "</i></b>"
+			}
+			return inner, j + closing, true
+		}
+		j += closing
+	}
+	return "", 0, false
+}
+
+func delimiterRun(text string, start int) int {
+	run := 0
+	for start+run < len(text) && text[start+run] == text[start] {
+		run++
+	}
+	return run
+}
+
+func isASCIIPunctuation(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isWordByte(c byte) bool {
+	return c >= 0x80 || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+func expandTabs(line string) string {
+	return strings.ReplaceAll(line, "\t", "    ")
+}
+
+func trimIndent(line string, indent int) string {
+	for i := 0; i < indent && strings.HasPrefix(line, " "); i++ {
+		line = line[1:]
+	}
+	return line
+}
+
+func trimLines(lines []string) []string {
+	trimmed := make([]string, len(lines))
+	for i, line := range lines {
+		trimmed[i] = strings.TrimLeft(line, " ")
+	}
+	return trimmed
+}
+
+func newBlock(blockType ElementType, data map[string]interface{}) Block {
+	return Block{ID: NewBlockID(), Type: blockType, Data: data}
+}
+
+const blockIDAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-"
+
+// NewBlockID returns a random ten character block id in the format the editor generates.
+func NewBlockID() string {
+	id := make([]byte, 10)
+	_, _ = rand.Read(id)
+	for i := range id {
+		id[i] = blockIDAlphabet[int(id[i])%len(blockIDAlphabet)]
+	}
+	return string(id)
+}
@@ -6,10 +6,28 @@ type Comment struct {
 	Data        string `json:"data" binding:"required" db:"data"`
 	PostID      uuid.UUID
 	CommentedBy uuid.UUID `db:"commented_by"`
+	// ParentID is the comment being replied to, and is empty for comments on the post itself.
+	ParentID *uuid.UUID `json:"parent_id" db:"parent_id"`
+	// HeldForReview hides the comment until a moderator approves it.
+	HeldForReview bool `json:"-" db:"held_for_review"`
 }
 
 type FetchComments struct {
 	PostID uuid.UUID
+	UserID uuid.UUID
 	Start  int `form:"start"`
 	Limit  int `form:"limit" binding:"required"`
 }
+
+type CommentURIRequest struct {
+	PostUID    string `uri:"post_id" binding:"required,validPostUID"`
+	CommentUID string `uri:"comment_id" binding:"required,validPostUID"`
+}
+
+type FetchReplies struct {
+	PostID    uuid.UUID
+	CommentID uuid.UUID
+	UserID    uuid.UUID
+	Start     int `form:"start"`
+	Limit     int `form:"limit" binding:"required"`
+}
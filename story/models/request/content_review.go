@@ -0,0 +1,19 @@
+package request
+
+const (
+	ApproveContent = "approve"
+	RejectContent  = "reject"
+)
+
+type ContentReviewsRequest struct {
+	Start int `form:"start"`
+	Limit int `form:"limit" binding:"required"`
+}
+
+type ContentReviewURIRequest struct {
+	ReviewID string `uri:"review_id" binding:"required,validPostUID"`
+}
+
+type ReviewContentRequest struct {
+	Decision string `json:"decision" binding:"required,oneof=approve reject"`
+}
@@ -6,6 +6,23 @@ type PostURIRequest struct {
 	PostUID string `uri:"post_id" binding:"required,validPostUID"`
 }
 
+// GetPostRequest selects the representation of a post. Format html adds the rendered post content to
+// the response alongside its JSON blocks.
+type GetPostRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=json html"`
+}
+
+type SetSlugRequest struct {
+	Slug string `json:"slug" binding:"required,max=100,validSlug"`
+}
+
+// ResolvePostRequest looks up a post by its url. The url may be a full link, a path or a slug, and may
+// be one the post had before it was renamed.
+type ResolvePostRequest struct {
+	URL    string `form:"url" binding:"required"`
+	Format string `form:"format" binding:"omitempty,oneof=json html"`
+}
+
 type PostLikeRequest struct {
 	PostUID string `uri:"post_id" binding:"required,validPostUID"`
 }
@@ -21,3 +38,16 @@ type PostRequest struct {
 	Start  int `form:"start"`
 	Limit  int `form:"limit" binding:"required"`
 }
+
+type PostStateRequest struct {
+	State string `json:"state" binding:"required,oneof=published unlisted archived"`
+}
+
+type PostsByStateRequest struct {
+	State string `form:"state" binding:"required,oneof=published unlisted archived deleted"`
+}
+
+// PinPostsRequest lists the posts to pin to the profile in the order they are shown.
+type PinPostsRequest struct {
+	PostIDs []uuid.UUID `json:"post_ids"`
+}
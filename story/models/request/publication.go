@@ -0,0 +1,42 @@
+package request
+
+import "github.com/google/uuid"
+
+const (
+	ApproveSubmission        = "approve"
+	RequestSubmissionChanges = "request_changes"
+	RejectSubmission         = "reject"
+)
+
+type CreatePublicationRequest struct {
+	OwnerID     uuid.UUID `json:"-"`
+	Name        string    `json:"name" binding:"required,max=100"`
+	Description *string   `json:"description"`
+}
+
+type PublicationURIRequest struct {
+	PublicationID string `uri:"publication_id" binding:"required,validPostUID"`
+}
+
+type SubmissionURIRequest struct {
+	PublicationID string `uri:"publication_id" binding:"required,validPostUID"`
+	SubmissionID  string `uri:"submission_id" binding:"required,validPostUID"`
+}
+
+type InviteMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+	Role   string    `json:"role" binding:"required,oneof=editor writer"`
+}
+
+type SubmitDraftRequest struct {
+	DraftID uuid.UUID `json:"draft_id" binding:"required"`
+}
+
+type SubmissionsRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending changes_requested rejected approved"`
+}
+
+type ReviewSubmissionRequest struct {
+	Decision string  `json:"decision" binding:"required,oneof=approve request_changes reject"`
+	Feedback *string `json:"feedback"`
+}
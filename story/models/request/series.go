@@ -0,0 +1,26 @@
+package request
+
+import "github.com/google/uuid"
+
+type CreateSeriesRequest struct {
+	AuthorID    uuid.UUID `json:"-"`
+	Title       string    `json:"title" binding:"required,max=300"`
+	Description *string   `json:"description"`
+}
+
+type SeriesURIRequest struct {
+	SeriesID string `uri:"series_id" binding:"required,validPostUID"`
+}
+
+type SeriesPostURIRequest struct {
+	SeriesID string `uri:"series_id" binding:"required,validPostUID"`
+	PostID   string `uri:"post_id" binding:"required,validPostUID"`
+}
+
+type AddSeriesPostRequest struct {
+	PostID uuid.UUID `json:"post_id" binding:"required"`
+}
+
+type ReorderSeriesRequest struct {
+	PostIDs []uuid.UUID `json:"post_ids" binding:"required,min=1"`
+}
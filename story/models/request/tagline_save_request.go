@@ -2,26 +2,76 @@ package request
 
 import (
 	"github.com/google/uuid"
+	"post-api/story/models"
+	"time"
 )
 
 type TaglineSaveRequest struct {
 	UserID  uuid.UUID `json:"-"`
 	DraftID uuid.UUID `json:"-"`
 	Tagline string    `json:"tagline" binding:"required" db:"tagline"`
+	Version int64     `json:"-"`
 }
 
 type InterestsSaveRequest struct {
 	UserID    uuid.UUID `json:"-"`
 	DraftID   uuid.UUID `json:"-"`
 	Interests []string  `json:"interests" binding:"required" db:"interest" `
+	Version   int64     `json:"-"`
 }
 
 type PreviewImageSaveRequest struct {
 	UserID   uuid.UUID `json:"-"`
 	DraftID  uuid.UUID `json:"-"`
 	UploadID string    `json:"upload_id" binding:"required" db:"preview_image"`
+	Version  int64     `json:"-"`
 }
 
 type DraftURIRequest struct {
 	DraftID string `uri:"draft_id" binding:"required,validPostUID"`
 }
+
+type RevisionURIRequest struct {
+	DraftID    string `uri:"draft_id" binding:"required,validPostUID"`
+	RevisionID string `uri:"revision_id" binding:"required,validPostUID"`
+}
+
+type RevisionDiffRequest struct {
+	From string `form:"from" binding:"required,validPostUID"`
+	To   string `form:"to" binding:"required,validPostUID"`
+}
+
+type DraftPatchRequest struct {
+	UserID     uuid.UUID               `json:"-"`
+	DraftID    uuid.UUID               `json:"-"`
+	Version    int64                   `json:"-"`
+	Operations []models.BlockOperation `json:"operations" binding:"required,min=1,dive"`
+}
+
+type SchedulePublishRequest struct {
+	UserID    uuid.UUID `json:"-"`
+	DraftID   uuid.UUID `json:"-"`
+	PublishAt time.Time `json:"publish_at" binding:"required"`
+}
+
+type MarkdownImportRequest struct {
+	UserID   uuid.UUID `json:"-"`
+	Markdown string    `json:"markdown" binding:"required"`
+}
+
+type CreatePreviewLinkRequest struct {
+	ExpiresInHours int `json:"expires_in_hours" binding:"omitempty,min=1"`
+}
+
+type PreviewLinkURIRequest struct {
+	DraftID string `uri:"draft_id" binding:"required,validPostUID"`
+	LinkID  string `uri:"link_id" binding:"required,validPostUID"`
+}
+
+type SharedDraftURIRequest struct {
+	Token string `uri:"token" binding:"required"`
+}
+
+type LinkPreviewRequest struct {
+	URL string `form:"url" binding:"required"`
+}
@@ -0,0 +1,19 @@
+package request
+
+import "github.com/google/uuid"
+
+type SaveTemplateRequest struct {
+	UserID      uuid.UUID `json:"-"`
+	DraftID     uuid.UUID `json:"draft_id" binding:"required"`
+	Name        string    `json:"name" binding:"required,max=100"`
+	Description *string   `json:"description"`
+	IsPublic    bool      `json:"is_public"`
+}
+
+type TemplateURIRequest struct {
+	TemplateID string `uri:"template_id" binding:"required,validPostUID"`
+}
+
+type TemplateVisibilityRequest struct {
+	IsPublic *bool `json:"is_public" binding:"required"`
+}
@@ -6,9 +6,17 @@ import (
 )
 
 type Comment struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	Data        string    `json:"data" db:"data"`
-	PostID      uuid.UUID `json:"post_id" db:"post_id"`
-	Username    string    `json:"username" db:"username"`
-	CommentedAt time.Time `json:"commented_at" db:"created_at"`
+	ID          uuid.UUID  `json:"id" db:"id"`
+	Data        string     `json:"data" db:"data"`
+	PostID      uuid.UUID  `json:"post_id" db:"post_id"`
+	Username    string     `json:"username" db:"username"`
+	CommentedAt time.Time  `json:"commented_at" db:"created_at"`
+	ParentID    *uuid.UUID `json:"parent_id" db:"parent_id"`
+	Depth       int        `json:"depth" db:"depth"`
+	ReplyCount  int64      `json:"reply_count" db:"reply_count"`
+	// IsDeleted marks a tombstone left in place of a deleted comment that has replies.
+	IsDeleted bool `json:"is_deleted" db:"is_deleted"`
+	// ReplyTo is the author of the comment replied to, set on replies shown in a flat list below the
+	// display depth.
+	ReplyTo *string `json:"reply_to,omitempty" db:"reply_to"`
 }
@@ -1,6 +1,10 @@
 package response
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"post-api/story/models"
+	"time"
+)
 
 type PreviewDraft struct {
 	DraftID      uuid.UUID `json:"draft_id" binding:"required"`
@@ -10,3 +14,34 @@ type PreviewDraft struct {
 	PreviewImage string    `json:"preview_image" binding:"required"`
 	AuthorName   string    `json:"author_name" binding:"required"`
 }
+
+type RevisionDiff struct {
+	DraftID uuid.UUID          `json:"draft_id"`
+	From    uuid.UUID          `json:"from"`
+	To      uuid.UUID          `json:"to"`
+	Blocks  []models.BlockDiff `json:"blocks"`
+}
+
+type DraftPreviewLink struct {
+	ID        uuid.UUID `json:"id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SharedDraft is a draft as shown to anyone holding one of its preview links. Draft images are
+// replaced with presigned urls, as the reader is not signed in.
+type SharedDraft struct {
+	DraftID      uuid.UUID         `json:"draft_id"`
+	Title        string            `json:"title"`
+	Tagline      string            `json:"tagline"`
+	PreviewImage string            `json:"preview_image"`
+	Data         models.JSONString `json:"data"`
+	HTML         string            `json:"html"`
+}
+
+// LinkPreview is shaped like the response the editor's link tool expects from its fetch endpoint.
+type LinkPreview struct {
+	Success int             `json:"success"`
+	Link    string          `json:"link"`
+	Meta    models.LinkMeta `json:"meta"`
+}
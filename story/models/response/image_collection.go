@@ -0,0 +1,21 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ImageCollectionReport lists the images a collection run found unreferenced past the grace period.
+// On a dry run nothing is deleted and Deleted stays zero.
+type ImageCollectionReport struct {
+	DryRun  bool            `json:"dry_run"`
+	Scanned int             `json:"scanned"`
+	Orphans []OrphanedImage `json:"orphans"`
+	Deleted int             `json:"deleted"`
+}
+
+type OrphanedImage struct {
+	Key           string      `json:"key"`
+	LastModified  time.Time   `json:"last_modified"`
+	DraftImageIDs []uuid.UUID `json:"draft_image_ids"`
+}
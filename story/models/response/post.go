@@ -7,19 +7,31 @@ import (
 )
 
 type Post struct {
-	PostID                 string            `json:"post_id" db:"id"`
-	PostData               models.JSONString `json:"post_data" db:"data"`
-	LikeCount              int64             `json:"like_count" db:"likes_count"`
-	CommentCount           int64             `json:"comment_count" db:"comments_count"`
-	Interests              models.JSONString `json:"interests" db:"interests"`
-	AuthorID               string            `json:"author_id" db:"author_id"`
-	AuthorName             string            `json:"author_name" db:"author_name"`
-	PreviewImage           string            `json:"preview_image" db:"preview_image"`
-	PublishedAt            time.Time         `json:"published_at" db:"published_at"`
-	URL                    string            `json:"url" db:"url"`
-	IsViewerLiked          bool              `json:"is_viewer_liked" db:"is_viewer_liked"`
-	IsViewerIsAuthor       bool              `json:"is_viewer_is_author" db:"is_viewer_is_author"`
-	IsViewerFollowedAuthor bool              `json:"is_viewer_followed_author"`
+	PostID                 string                 `json:"post_id" db:"id"`
+	PostData               models.JSONString      `json:"post_data" db:"data"`
+	LikeCount              int64                  `json:"like_count" db:"likes_count"`
+	CommentCount           int64                  `json:"comment_count" db:"comments_count"`
+	Interests              models.JSONString      `json:"interests" db:"interests"`
+	AuthorID               string                 `json:"author_id" db:"author_id"`
+	AuthorName             string                 `json:"author_name" db:"author_name"`
+	PreviewImage           string                 `json:"preview_image" db:"preview_image"`
+	PublishedAt            time.Time              `json:"published_at" db:"published_at"`
+	EditedAt               *time.Time             `json:"edited_at" db:"edited_at"`
+	IsEdited               bool                   `json:"is_edited"`
+	URL                    string                 `json:"url" db:"url"`
+	TableOfContents        models.TableOfContents `json:"table_of_contents" db:"table_of_contents"`
+	IsViewerLiked          bool                   `json:"is_viewer_liked" db:"is_viewer_liked"`
+	IsViewerIsAuthor       bool                   `json:"is_viewer_is_author" db:"is_viewer_is_author"`
+	IsViewerFollowedAuthor bool                   `json:"is_viewer_followed_author"`
+	Series                 *SeriesNavigation      `json:"series,omitempty"`
+	HTML                   string                 `json:"html,omitempty"`
+}
+
+// ResolvedPost is the post a url points to. Redirect is set when the url is one the post had before, and
+// the reader should move to the current url of the post.
+type ResolvedPost struct {
+	Redirect bool `json:"redirect"`
+	Post     Post `json:"post"`
 }
 
 type PublishedPost struct {
@@ -32,6 +44,7 @@ type PublishedPost struct {
 	Interests    models.JSONString `json:"interests" db:"interests"`
 	Username     string            `json:"username" db:"username"`
 	URL          string            `json:"url" db:"url"`
+	IsPinned     bool              `json:"is_pinned" db:"is_pinned"`
 }
 
 type PostView struct {
@@ -0,0 +1,8 @@
+package response
+
+import "post-api/story/models/db"
+
+type Publication struct {
+	db.Publication
+	Members []db.PublicationMember `json:"members"`
+}
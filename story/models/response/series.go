@@ -0,0 +1,32 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SeriesEntry struct {
+	PostID   uuid.UUID `json:"post_id"`
+	Position int       `json:"position"`
+	Title    string    `json:"title"`
+	URL      string    `json:"url"`
+}
+
+type Series struct {
+	ID          uuid.UUID     `json:"id"`
+	AuthorID    uuid.UUID     `json:"author_id"`
+	Title       string        `json:"title"`
+	Description *string       `json:"description"`
+	CreatedAt   time.Time     `json:"created_at"`
+	Posts       []SeriesEntry `json:"posts"`
+}
+
+// SeriesNavigation is attached to a post that is part of a series. Previous and Next are nil at the
+// start and end of the series.
+type SeriesNavigation struct {
+	ID       uuid.UUID     `json:"id"`
+	Title    string        `json:"title"`
+	Previous *SeriesEntry  `json:"previous"`
+	Next     *SeriesEntry  `json:"next"`
+	Contents []SeriesEntry `json:"contents"`
+}
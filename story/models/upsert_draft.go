@@ -6,6 +6,7 @@ type UpsertDraft struct {
 	DraftID uuid.UUID
 	UserID  uuid.UUID
 	Data    JSONString `json:"data" db:"data"`
+	Version int64      `json:"-"`
 }
 
 type GetAllDraftRequest struct {
@@ -14,7 +15,11 @@ type GetAllDraftRequest struct {
 	Limit      int `json:"limit"`
 }
 
+// CreateDraft holds the content of a new draft. Tagline and interests are only set when the draft is
+// created from a template.
 type CreateDraft struct {
-	Data   JSONString `json:"data"`
-	UserID uuid.UUID
+	Data      JSONString `json:"data"`
+	UserID    uuid.UUID
+	Tagline   *string  `json:"-"`
+	Interests []string `json:"-"`
 }
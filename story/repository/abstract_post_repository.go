@@ -4,15 +4,22 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"github.com/google/uuid"
 	"github.com/inclusi-blog/gola-utils/logging"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"post-api/helper"
 	"post-api/story/models/db"
 )
 
 type AbstractPostRepository interface {
 	Save(ctx context.Context, txn helper.Transaction, post db.AbstractPost) (uuid.UUID, error)
+	Update(ctx context.Context, txn helper.Transaction, post db.AbstractPost) error
+	UpdateGeneratedURL(ctx context.Context, txn helper.Transaction, postID uuid.UUID, url string) (bool, error)
+	SetCustomSlug(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, slug string) (string, error)
+	AddURL(ctx context.Context, txn helper.Transaction, postID uuid.UUID, url string) error
+	ResolveURL(ctx context.Context, urls []string, userID uuid.UUID) (uuid.UUID, string, error)
 }
 
 type abstractPostRepository struct {
@@ -20,7 +27,12 @@ type abstractPostRepository struct {
 }
 
 const (
-	SavePreviewPost = "INSERT INTO abstract_post (id, title, tagline, preview_image, view_time, post_id, url) VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6) RETURNING id"
+	SavePreviewPost    = "INSERT INTO abstract_post (id, title, tagline, preview_image, view_time, post_id, url, table_of_contents) VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5, $6, $7) RETURNING id"
+	UpdatePreviewPost  = "UPDATE abstract_post SET title = $1, tagline = $2, preview_image = $3, view_time = $4, table_of_contents = $5, updated_at = current_timestamp WHERE post_id = $6"
+	UpdateGeneratedURL = "update abstract_post set url = $1 where post_id = $2 and custom_slug is null and url is distinct from $3"
+	SetCustomSlug      = "update abstract_post set custom_slug = $1, url = users.username || '/' || $2 from posts inner join users on posts.author_id = users.id where abstract_post.post_id = posts.id and posts.id = $3 and posts.author_id = $4 and posts.state in ('published', 'unlisted') returning abstract_post.url"
+	AddPostURL         = "insert into post_urls (url, post_id) values ($1, $2) on conflict (url) do update set created_at = current_timestamp where post_urls.post_id = excluded.post_id"
+	ResolvePostURL     = "select post_urls.post_id, abstract_post.url from post_urls inner join posts on post_urls.post_id = posts.id inner join abstract_post on posts.id = abstract_post.post_id where post_urls.url = any($1) and (posts.state in ('published', 'unlisted') or (posts.state = 'archived' and posts.author_id = $2)) order by array_position($3, post_urls.url) limit 1"
 )
 
 func (repository abstractPostRepository) Save(ctx context.Context, txn helper.Transaction, post db.AbstractPost) (uuid.UUID, error) {
@@ -29,7 +41,7 @@ func (repository abstractPostRepository) Save(ctx context.Context, txn helper.Tr
 	id := post.PostID
 	logger.Infof("Inserting new preview post for post %v", id)
 	var abstractPostID uuid.UUID
-	err := txn.QueryRowContext(ctx, SavePreviewPost, post.Title, post.Tagline, post.PreviewImage, post.ViewTime, post.PostID, post.URL).Scan(&abstractPostID)
+	err := txn.QueryRowContext(ctx, SavePreviewPost, post.Title, post.Tagline, post.PreviewImage, post.ViewTime, post.PostID, post.URL, post.TableOfContents).Scan(&abstractPostID)
 
 	if err != nil {
 		logger.Errorf("Error occurred while inserting new preview post for post id %v .%v", id, err)
@@ -41,6 +53,99 @@ func (repository abstractPostRepository) Save(ctx context.Context, txn helper.Tr
 	return abstractPostID, nil
 }
 
+func (repository abstractPostRepository) Update(ctx context.Context, txn helper.Transaction, post db.AbstractPost) error {
+	logger := logging.GetLogger(ctx).WithField("class", "AbstractPostRepository").WithField("method", "Update")
+
+	id := post.PostID
+	logger.Infof("Updating preview post for post %v", id)
+	_, err := txn.ExecContext(ctx, UpdatePreviewPost, post.Title, post.Tagline, post.PreviewImage, post.ViewTime, post.TableOfContents, post.PostID)
+	if err != nil {
+		logger.Errorf("Error occurred while updating preview post for post id %v .%v", id, err)
+		return err
+	}
+
+	logger.Infof("Successfully updated preview post for post id %v", id)
+	return nil
+}
+
+// UpdateGeneratedURL points the post to a url generated from its new title. Posts with a custom slug keep
+// their url, and false is returned when the url has not changed.
+func (repository abstractPostRepository) UpdateGeneratedURL(ctx context.Context, txn helper.Transaction, postID uuid.UUID, url string) (bool, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "AbstractPostRepository").WithField("method", "UpdateGeneratedURL")
+
+	result, err := txn.ExecContext(ctx, UpdateGeneratedURL, url, postID, url)
+	if err != nil {
+		logger.Errorf("Error occurred while updating url of post %v .%v", postID, err)
+		return false, err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("Error occurred while fetching affected rows for post %v .%v", postID, err)
+		return false, err
+	}
+
+	return affectedRows == 1, nil
+}
+
+// SetCustomSlug sets the slug the author chose for the post and returns the url built from it, which is
+// the slug under the author's username. Only published and unlisted posts can take a slug.
+func (repository abstractPostRepository) SetCustomSlug(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, slug string) (string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "AbstractPostRepository").WithField("method", "SetCustomSlug")
+
+	var url string
+	err := txn.QueryRowContext(ctx, SetCustomSlug, slug, slug, postID, userID).Scan(&url)
+	if err != nil {
+		logger.Errorf("Error occurred while setting slug of post %v .%v", postID, err)
+		return "", err
+	}
+
+	logger.Infof("Successfully set slug of post %v", postID)
+	return url, nil
+}
+
+// AddURL records a url of the post so it keeps resolving after the post is renamed. A url already
+// recorded for another post returns sql.ErrNoRows.
+func (repository abstractPostRepository) AddURL(ctx context.Context, txn helper.Transaction, postID uuid.UUID, url string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "AbstractPostRepository").WithField("method", "AddURL")
+
+	result, err := txn.ExecContext(ctx, AddPostURL, url, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while adding url for post %v .%v", postID, err)
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("Error occurred while fetching affected rows for post %v .%v", postID, err)
+		return err
+	}
+
+	if affectedRows != 1 {
+		logger.Errorf("url %v is already used by another post", url)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ResolveURL returns the post of the first of the urls it has ever had, along with the current url of
+// that post. Only posts the user may read are resolved, which are published and unlisted posts and
+// the user's own archived posts.
+func (repository abstractPostRepository) ResolveURL(ctx context.Context, urls []string, userID uuid.UUID) (uuid.UUID, string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "AbstractPostRepository").WithField("method", "ResolveURL")
+
+	var postID uuid.UUID
+	var currentURL string
+	err := repository.db.QueryRowContext(ctx, ResolvePostURL, pq.Array(urls), userID, pq.Array(urls)).Scan(&postID, &currentURL)
+	if err != nil {
+		logger.Errorf("Error occurred while resolving urls %v .%v", urls, err)
+		return uuid.Nil, "", err
+	}
+
+	return postID, currentURL, nil
+}
+
 func NewAbstractPostRepository(db *sqlx.DB) AbstractPostRepository {
 	return abstractPostRepository{db: db}
 }
@@ -108,7 +108,8 @@ func (suite *PreviewPostsRepositoryIntegrationTest) createPost() (uuid.UUID, uui
 		Data:   models.JSONString{JSONText: types.JSONText(test_helper.LargeTextData)},
 		UserID: userID,
 	}
-	draftUUID, err := suite.draftHelper.CreateDraft(suite.goContext, draft)
+	transaction := suite.transaction.NewTransaction()
+	draftUUID, err := suite.draftHelper.CreateDraft(suite.goContext, transaction, draft)
 	suite.Nil(err)
 	post := db.PublishPost{
 		UserID: userID,
@@ -117,7 +118,6 @@ func (suite *PreviewPostsRepositoryIntegrationTest) createPost() (uuid.UUID, uui
 		},
 		DraftID: draftUUID,
 	}
-	transaction := suite.transaction.NewTransaction()
 	postUUID, err := suite.postsRepository.CreatePost(suite.goContext, transaction, post)
 	suite.Nil(err)
 	err = transaction.Commit()
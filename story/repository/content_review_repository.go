@@ -0,0 +1,178 @@
+package repository
+
+//go:generate mockgen -source=content_review_repository.go -destination=./../mocks/mock_content_review_repository.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	transaction "post-api/helper"
+	"post-api/story/models/db"
+)
+
+type ContentReviewRepository interface {
+	Save(ctx context.Context, review db.ContentReview) error
+	Hold(ctx context.Context, txn transaction.Transaction, review db.ContentReview) error
+	GetPending(ctx context.Context, limit, offset int) ([]db.ContentReview, error)
+	GetPostReviewStatus(ctx context.Context, draftID uuid.UUID, version int64) (string, error)
+	Resolve(ctx context.Context, txn transaction.Transaction, reviewID, reviewerID uuid.UUID, status string) (db.ContentReview, error)
+	ReleaseComment(ctx context.Context, txn transaction.Transaction, commentID uuid.UUID) error
+	ReleaseAbout(ctx context.Context, txn transaction.Transaction, userID uuid.UUID, about string) error
+	ReleasePost(ctx context.Context, txn transaction.Transaction, draftID uuid.UUID) error
+	IsModerator(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+type contentReviewRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	SaveContentReview     = "insert into content_reviews (content_type, content_id, content_version, user_id, rules, content) values ($1, $2, $3, $4, $5, $6) on conflict (content_type, content_id, content_version) where status = 'pending' do nothing"
+	FetchPendingReviews   = "select id, content_type, content_id, content_version, user_id, rules, content, status, created_at from content_reviews where status = 'pending' order by created_at limit $1 offset $2"
+	FetchPostReviewStatus = "select status from content_reviews where content_type = 'post' and content_id = $1 and content_version = $2 order by created_at desc limit 1"
+	ResolveContentReview  = "update content_reviews set status = $1, reviewed_by = $2, reviewed_at = current_timestamp where id = $3 and status = 'pending' returning id, content_type, content_id, content_version, user_id, rules, content, status, created_at"
+	ReleaseHeldComment    = "update comments set held_for_review = false where id = $1"
+	ReleaseHeldAbout      = "update users set about = $1 where id = $2"
+	ReleaseHeldPost       = "update drafts set publish_error = null, publish_attempts = 0 where id = $1 and publish_at is not null"
+	FetchIsModerator      = "select exists (select 1 from admin where id = $1)"
+)
+
+func (repository contentReviewRepository) Save(ctx context.Context, review db.ContentReview) error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "Save")
+
+	_, err := repository.db.ExecContext(ctx, SaveContentReview, review.ContentType, review.ContentID, review.ContentVersion, review.UserID, pq.Array(review.Rules), review.Content)
+	if err != nil {
+		logger.Errorf("Error occurred while holding %v %v for review .%v", review.ContentType, review.ContentID, err)
+		return err
+	}
+
+	logger.Infof("Successfully held %v %v for review", review.ContentType, review.ContentID)
+	return nil
+}
+
+// Hold records a review for content that is written in the same transaction, so the content is never
+// left hidden without a review to release it.
+func (repository contentReviewRepository) Hold(ctx context.Context, txn transaction.Transaction, review db.ContentReview) error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "Hold")
+
+	_, err := txn.ExecContext(ctx, SaveContentReview, review.ContentType, review.ContentID, review.ContentVersion, review.UserID, pq.Array(review.Rules), review.Content)
+	if err != nil {
+		logger.Errorf("Error occurred while holding %v %v for review .%v", review.ContentType, review.ContentID, err)
+		return err
+	}
+
+	logger.Infof("Successfully held %v %v for review", review.ContentType, review.ContentID)
+	return nil
+}
+
+func (repository contentReviewRepository) GetPending(ctx context.Context, limit, offset int) ([]db.ContentReview, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "GetPending")
+
+	var reviews []db.ContentReview
+	err := repository.db.SelectContext(ctx, &reviews, FetchPendingReviews, limit, offset)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching pending reviews .%v", err)
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// GetPostReviewStatus returns the status of the latest review of the draft at the given version.
+func (repository contentReviewRepository) GetPostReviewStatus(ctx context.Context, draftID uuid.UUID, version int64) (string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "GetPostReviewStatus")
+
+	var status string
+	err := repository.db.GetContext(ctx, &status, FetchPostReviewStatus, draftID, version)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching review of draft %v at version %v .%v", draftID, version, err)
+		return "", err
+	}
+
+	return status, nil
+}
+
+// Resolve moves a pending review to the given status and returns it.
+func (repository contentReviewRepository) Resolve(ctx context.Context, txn transaction.Transaction, reviewID, reviewerID uuid.UUID, status string) (db.ContentReview, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "Resolve")
+	logger.Infof("Moving review %v to %v", reviewID, status)
+
+	var review db.ContentReview
+	err := txn.GetContext(ctx, &review, ResolveContentReview, status, reviewerID, reviewID)
+	if err != nil {
+		logger.Errorf("Error occurred while resolving review %v .%v", reviewID, err)
+		return db.ContentReview{}, err
+	}
+
+	return review, nil
+}
+
+func (repository contentReviewRepository) ReleaseComment(ctx context.Context, txn transaction.Transaction, commentID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "ReleaseComment")
+
+	result, err := txn.ExecContext(ctx, ReleaseHeldComment, commentID)
+	if err != nil {
+		logger.Errorf("Error occurred while releasing comment %v .%v", commentID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no comment %v found", commentID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository contentReviewRepository) ReleaseAbout(ctx context.Context, txn transaction.Transaction, userID uuid.UUID, about string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "ReleaseAbout")
+
+	_, err := txn.ExecContext(ctx, ReleaseHeldAbout, about, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while releasing about of user %v .%v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// ReleasePost clears the publish failure of a scheduled draft so the scheduler picks it up again. A
+// draft that is not scheduled is left alone, since its author publishes it by hand.
+func (repository contentReviewRepository) ReleasePost(ctx context.Context, txn transaction.Transaction, draftID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "ReleasePost")
+
+	_, err := txn.ExecContext(ctx, ReleaseHeldPost, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while releasing scheduled draft %v .%v", draftID, err)
+		return err
+	}
+
+	return nil
+}
+
+// IsModerator reports whether the user is one of the admins, who moderate held content.
+func (repository contentReviewRepository) IsModerator(ctx context.Context, userID uuid.UUID) (bool, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewRepository").WithField("method", "IsModerator")
+
+	var isModerator bool
+	err := repository.db.GetContext(ctx, &isModerator, FetchIsModerator, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while checking whether user %v is a moderator .%v", userID, err)
+		return false, err
+	}
+
+	return isModerator, nil
+}
+
+func NewContentReviewRepository(db *sqlx.DB) ContentReviewRepository {
+	return contentReviewRepository{db: db}
+}
@@ -0,0 +1,100 @@
+package repository
+
+//go:generate mockgen -source=draft_preview_link_repository.go -destination=./../mocks/mock_draft_preview_link_repository.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"post-api/story/models/db"
+	"time"
+)
+
+type DraftPreviewLinkRepository interface {
+	Create(ctx context.Context, linkID, draftID, userID uuid.UUID, expiresAt time.Time) error
+	GetActiveLinks(ctx context.Context, draftID, userID uuid.UUID) ([]db.DraftPreviewLink, error)
+	Revoke(ctx context.Context, linkID, draftID, userID uuid.UUID) error
+	Open(ctx context.Context, linkID uuid.UUID) (uuid.UUID, error)
+}
+
+type draftPreviewLinkRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	CreatePreviewLink       = "insert into draft_preview_links (id, draft_id, user_id, expires_at) select $1, drafts.id, drafts.user_id, $2 from drafts where drafts.id = $3 and drafts.user_id = $4 and drafts.deleted_at is null returning id"
+	FetchActivePreviewLinks = "select id, draft_id, user_id, expires_at, open_count, last_opened_at, created_at from draft_preview_links where draft_id = $1 and user_id = $2 and revoked_at is null and expires_at > current_timestamp order by created_at desc"
+	RevokePreviewLink       = "update draft_preview_links set revoked_at = current_timestamp where id = $1 and draft_id = $2 and user_id = $3 and revoked_at is null"
+	OpenPreviewLink         = "update draft_preview_links set open_count = open_count + 1, last_opened_at = current_timestamp where id = $1 and revoked_at is null and expires_at > current_timestamp returning draft_id"
+)
+
+func (repository draftPreviewLinkRepository) Create(ctx context.Context, linkID, draftID, userID uuid.UUID, expiresAt time.Time) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewLinkRepository").WithField("method", "Create")
+	logger.Infof("Creating preview link %v for draft %v", linkID, draftID)
+
+	var createdID uuid.UUID
+	err := repository.db.GetContext(ctx, &createdID, CreatePreviewLink, linkID, expiresAt, draftID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while creating preview link for draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (repository draftPreviewLinkRepository) GetActiveLinks(ctx context.Context, draftID, userID uuid.UUID) ([]db.DraftPreviewLink, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewLinkRepository").WithField("method", "GetActiveLinks")
+
+	var links []db.DraftPreviewLink
+	err := repository.db.SelectContext(ctx, &links, FetchActivePreviewLinks, draftID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching preview links of draft %v. Error %v", draftID, err)
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (repository draftPreviewLinkRepository) Revoke(ctx context.Context, linkID, draftID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewLinkRepository").WithField("method", "Revoke")
+	logger.Infof("Revoking preview link %v", linkID)
+
+	result, err := repository.db.ExecContext(ctx, RevokePreviewLink, linkID, draftID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while revoking preview link %v. Error %v", linkID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no active preview link %v found for user %v", linkID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Open counts an opening of an active link and returns the draft it points to.
+func (repository draftPreviewLinkRepository) Open(ctx context.Context, linkID uuid.UUID) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewLinkRepository").WithField("method", "Open")
+
+	var draftID uuid.UUID
+	err := repository.db.GetContext(ctx, &draftID, OpenPreviewLink, linkID)
+	if err != nil {
+		logger.Errorf("Error occurred while opening preview link %v. Error %v", linkID, err)
+		return uuid.Nil, err
+	}
+
+	return draftID, nil
+}
+
+func NewDraftPreviewLinkRepository(db *sqlx.DB) DraftPreviewLinkRepository {
+	return draftPreviewLinkRepository{db: db}
+}
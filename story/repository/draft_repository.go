@@ -12,50 +12,82 @@ import (
 	"post-api/story/models"
 	"post-api/story/models/db"
 	"post-api/story/models/request"
+	"time"
 
 	"github.com/inclusi-blog/gola-utils/logging"
 	"github.com/jmoiron/sqlx"
 )
 
 type DraftRepository interface {
-	SavePostDraft(draft models.UpsertDraft, ctx context.Context) error
-	CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, error)
-	SaveTaglineToDraft(taglineSaveRequest request.TaglineSaveRequest, ctx context.Context) error
-	SaveInterestsToDraft(interestsSaveRequest request.InterestsSaveRequest, ctx context.Context) error
+	SavePostDraft(ctx context.Context, txn transaction.Transaction, draft models.UpsertDraft) (int64, error)
+	CreateDraft(ctx context.Context, txn transaction.Transaction, draft models.CreateDraft) (uuid.UUID, error)
+	CopyDraft(ctx context.Context, draftUID, userID uuid.UUID) (uuid.UUID, error)
+	SaveTaglineToDraft(taglineSaveRequest request.TaglineSaveRequest, ctx context.Context) (int64, error)
+	SaveInterestsToDraft(interestsSaveRequest request.InterestsSaveRequest, ctx context.Context) (int64, error)
 	GetDraftByUser(ctx context.Context, draftUID, userID uuid.UUID) (db.Draft, error)
 	GetAllDraft(ctx context.Context, allDraftReq models.GetAllDraftRequest) ([]db.Draft, error)
-	UpsertPreviewImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) error
+	UpsertPreviewImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) (int64, error)
 	UpsertImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) (string, error)
+	AddImage(ctx context.Context, txn transaction.Transaction, saveRequest request.PreviewImageSaveRequest) (string, error)
 	DeleteDraft(ctx context.Context, draftUID, userUUID uuid.UUID) error
 	UpdatePublishStatus(ctx context.Context, txn transaction.Transaction, draftUID, userID uuid.UUID, status bool) error
 	GetDraftImage(ctx context.Context, draftID, imageID uuid.UUID) (string, error)
-	DeleteDraftImages(ctx context.Context, draftUID uuid.UUID) error
 	GetDraft(ctx context.Context, draftID uuid.UUID) (*db.Draft, error)
+	SchedulePublish(ctx context.Context, draftID, userID uuid.UUID, publishAt time.Time) error
+	CancelScheduledPublish(ctx context.Context, draftID, userID uuid.UUID) error
+	GetScheduledDrafts(ctx context.Context, userID uuid.UUID) ([]db.ScheduledDraft, error)
+	GetDueScheduledDrafts(ctx context.Context, limit int) ([]db.ScheduledDraft, error)
+	ClaimScheduledDraft(ctx context.Context, draftID uuid.UUID, lease time.Duration) (bool, error)
+	UpdatePublishError(ctx context.Context, draftID uuid.UUID, publishError string) error
+	DelayPublish(ctx context.Context, draftID uuid.UUID, delay time.Duration) error
+	RestoreDraft(ctx context.Context, draftID, userID uuid.UUID, retention time.Duration) error
+	GetTrashedDrafts(ctx context.Context, userID uuid.UUID, retention time.Duration) ([]db.TrashedDraft, error)
+	GetExpiredTrashedDrafts(ctx context.Context, retention time.Duration, maxAttempts, limit int) ([]db.TrashedDraft, error)
+	RecordPurgeFailure(ctx context.Context, draftID uuid.UUID, reason string) error
+	GetUnsharedImageKeys(ctx context.Context, draftID uuid.UUID) ([]string, error)
+	PurgeDraft(ctx context.Context, txn transaction.Transaction, draftID uuid.UUID) error
 }
 
 const (
-	CreateDraft         = "insert into drafts (id, user_id, data) values(uuid_generate_v4(), $1, $2) returning id"
-	SavePostDraft       = "update drafts set data = $1, updated_at = current_timestamp where id = $2 and user_id = $3"
-	SaveTagline         = "update drafts set tagline = $1, updated_at = current_timestamp where id = $2 and user_id = $3"
-	SaveInterests       = "update drafts set interests = $1, updated_at = current_timestamp where id = $2 and user_id = $3"
-	FetchDraftByUser    = "select id, user_id, data, preview_image, tagline, interests from drafts where id = $1 and user_id = $2"
-	FetchDraft          = "select id, user_id, data, preview_image, tagline, interests from drafts where id = $1"
-	SavePreviewImage    = "update drafts set preview_image = $1, updated_at = current_timestamp where id = $2 and user_id = $3"
-	FetchAllDraft       = "select id, user_id, data, preview_image, tagline, interests, created_at from drafts where user_id = $1 and is_published is false order by created_at desc limit $2 offset $3"
-	DeleteDraft         = "delete from drafts where id = $1 and user_id = $2"
-	DeleteDraftImages   = "delete from draft_images where draft_id = $1"
+	CreateDraft         = "insert into drafts (id, user_id, data, tagline, interests) values(uuid_generate_v4(), $1, $2, $3, $4) returning id"
+	CopyDraft           = "insert into drafts (id, user_id, data, tagline, interests) select uuid_generate_v4(), user_id, data, tagline, interests from drafts where id = $1 and user_id = $2 and deleted_at is null returning id"
+	SavePostDraft       = "update drafts set data = $1, version = version + 1, updated_at = current_timestamp where id = $2 and user_id = $3 and version = $4 and deleted_at is null returning version"
+	SaveTagline         = "update drafts set tagline = $1, version = version + 1, updated_at = current_timestamp where id = $2 and user_id = $3 and version = $4 and deleted_at is null returning version"
+	SaveInterests       = "update drafts set interests = $1, version = version + 1, updated_at = current_timestamp where id = $2 and user_id = $3 and version = $4 and deleted_at is null returning version"
+	FetchDraftByUser    = "select id, user_id, data, preview_image, tagline, interests, version, is_published, exists (select 1 from publication_submissions where publication_submissions.draft_id = drafts.id and publication_submissions.status = 'pending') as in_review, publish_at, publish_error from drafts where id = $1 and user_id = $2 and deleted_at is null"
+	FetchDraft          = "select id, user_id, data, preview_image, tagline, interests, version, is_published, exists (select 1 from publication_submissions where publication_submissions.draft_id = drafts.id and publication_submissions.status = 'pending') as in_review, publish_at, publish_error from drafts where id = $1 and deleted_at is null"
+	SavePreviewImage    = "update drafts set preview_image = $1, version = version + 1, updated_at = current_timestamp where id = $2 and user_id = $3 and version = $4 and deleted_at is null returning version"
+	FetchAllDraft       = "select id, user_id, data, preview_image, tagline, interests, created_at from drafts where user_id = $1 and is_published is false and deleted_at is null order by created_at desc limit $2 offset $3"
+	DeleteDraft         = "update drafts set deleted_at = current_timestamp, publish_at = null, publish_error = null, publish_locked_until = null, purge_attempts = 0, purge_error = null where id = $1 and user_id = $2 and is_published is false and deleted_at is null"
 	UpdatePublishStatus = "update drafts set is_published = $1 where id = $2 and user_id = $3"
 	InsertDraftImage    = "insert into draft_images(id, draft_id, upload_id) values (uuid_generate_v4(), $1, $2) returning id"
 	GetDraftImage       = "select id, draft_id, upload_id from draft_images where id = $1 and draft_id = $2"
+	SchedulePublish     = "update drafts set publish_at = $1, publish_error = null, publish_attempts = 0, publish_locked_until = null where id = $2 and user_id = $3 and is_published is false and deleted_at is null"
+	CancelSchedule      = "update drafts set publish_at = null, publish_error = null, publish_attempts = 0, publish_locked_until = null where id = $1 and user_id = $2 and is_published is false and publish_at is not null and deleted_at is null"
+	FetchScheduled      = "select id, user_id, data, publish_at, publish_error from drafts where user_id = $1 and is_published is false and publish_at is not null and deleted_at is null order by publish_at"
+	FetchDueScheduled   = "select id, user_id, data, publish_at, publish_error, publish_attempts from drafts where is_published is false and publish_at <= current_timestamp and publish_error is null and deleted_at is null and (publish_locked_until is null or publish_locked_until < current_timestamp) order by publish_at limit $1"
+	ClaimScheduled      = "update drafts set publish_locked_until = current_timestamp + $1 * interval '1 second' where id = $2 and is_published is false and publish_at <= current_timestamp and publish_error is null and (publish_locked_until is null or publish_locked_until < current_timestamp)"
+	UpdatePublishError  = "update drafts set publish_error = $1, publish_locked_until = null where id = $2"
+	DelayPublish        = "update drafts set publish_attempts = publish_attempts + 1, publish_locked_until = current_timestamp + $1 * interval '1 second' where id = $2"
+	RestoreDraft        = "update drafts set deleted_at = null, updated_at = current_timestamp where id = $1 and user_id = $2 and deleted_at > current_timestamp - $3 * interval '1 second'"
+	FetchTrashed        = "select id, user_id, data, preview_image, deleted_at from drafts where user_id = $1 and deleted_at > current_timestamp - $2 * interval '1 second' order by deleted_at desc"
+	FetchExpiredTrashed = "select id, user_id, data, preview_image, deleted_at from drafts where deleted_at <= current_timestamp - $1 * interval '1 second' and is_published is false and purge_attempts < $2 order by deleted_at limit $3"
+	FetchUnsharedImages = "select upload_id from draft_images where draft_id = $1 and not exists (select 1 from draft_images shared where shared.upload_id = draft_images.upload_id and shared.draft_id != draft_images.draft_id) and not exists (select 1 from draft_template_images template where template.upload_id = draft_images.upload_id)"
+	PurgeRevisions      = "delete from draft_revisions where draft_id = $1"
+	PurgeImages         = "delete from draft_images where draft_id = $1"
+	PurgeSubmissions    = "delete from publication_submissions where draft_id = $1"
+	PurgePreviewLinks   = "delete from draft_preview_links where draft_id = $1"
+	UpdatePurgeFailure  = "update drafts set purge_attempts = purge_attempts + 1, purge_error = $1 where id = $2 and deleted_at is not null"
+	PurgeDraft          = "delete from drafts where id = $1 and deleted_at is not null and is_published is false"
 )
 
 type draftRepository struct {
 	db *sqlx.DB
 }
 
-func (repository draftRepository) CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, error) {
+func (repository draftRepository) CreateDraft(ctx context.Context, txn transaction.Transaction, draft models.CreateDraft) (uuid.UUID, error) {
 	var draftUUID uuid.UUID
-	err := repository.db.GetContext(ctx, &draftUUID, CreateDraft, draft.UserID, draft.Data)
+	err := txn.GetContext(ctx, &draftUUID, CreateDraft, draft.UserID, draft.Data, draft.Tagline, pq.Array(draft.Interests))
 
 	if err != nil {
 		return draftUUID, err
@@ -64,33 +96,40 @@ func (repository draftRepository) CreateDraft(ctx context.Context, draft models.
 	return draftUUID, nil
 }
 
-func (repository draftRepository) UpsertPreviewImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) error {
-	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("class", "UpsertPreviewImage")
+func (repository draftRepository) CopyDraft(ctx context.Context, draftUID, userID uuid.UUID) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "CopyDraft")
 
-	logger.Infof("Storing preview image for draft id %v", saveRequest.DraftID)
+	logger.Infof("Copying draft %v into a new draft", draftUID)
 
-	result, err := repository.db.ExecContext(ctx, SavePreviewImage, saveRequest.UploadID, saveRequest.DraftID, saveRequest.UserID)
+	var draftUUID uuid.UUID
+	err := repository.db.GetContext(ctx, &draftUUID, CopyDraft, draftUID, userID)
 
 	if err != nil {
-		logger.Errorf("Error occurred while saving preview image of draft %v", saveRequest.DraftID)
-		return err
+		logger.Errorf("Error occurred while copying draft %v. Error %v", draftUID, err)
+		return uuid.Nil, err
 	}
 
-	affectedNoRows, err := result.RowsAffected()
+	logger.Infof("Successfully copied draft %v into draft %v", draftUID, draftUUID)
 
-	if err != nil {
-		logger.Errorf("Error occurred while fetching affected rows for preview image update of draft %v", err)
-		return err
-	}
+	return draftUUID, nil
+}
+
+func (repository draftRepository) UpsertPreviewImage(ctx context.Context, saveRequest request.PreviewImageSaveRequest) (int64, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("class", "UpsertPreviewImage")
+
+	logger.Infof("Storing preview image for draft id %v", saveRequest.DraftID)
+
+	var version int64
+	err := repository.db.GetContext(ctx, &version, SavePreviewImage, saveRequest.UploadID, saveRequest.DraftID, saveRequest.UserID, saveRequest.Version)
 
-	if affectedNoRows == 0 {
-		logger.Errorf("Error occurred while updating preview image for draft .%v", "More than one entry got updated")
-		return errors.New("draft not found")
+	if err != nil {
+		logger.Errorf("Error occurred while saving preview image of draft %v. Error %v", saveRequest.DraftID, err)
+		return 0, err
 	}
 
 	logger.Infof("Successfully updated the preview image for draft id %v", saveRequest.DraftID)
 
-	return nil
+	return version, nil
 }
 
 func (repository draftRepository) GetDraftByUser(ctx context.Context, draftUID, userID uuid.UUID) (db.Draft, error) {
@@ -131,83 +170,53 @@ func (repository draftRepository) GetDraft(ctx context.Context, draftUID uuid.UU
 	return &draft, nil
 }
 
-func (repository draftRepository) SavePostDraft(draft models.UpsertDraft, ctx context.Context) error {
+func (repository draftRepository) SavePostDraft(ctx context.Context, txn transaction.Transaction, draft models.UpsertDraft) (int64, error) {
 	logger := logging.GetLogger(ctx)
 	log := logger.WithField("class", "DraftRepository").WithField("method", "SavePostDraft")
 
 	log.Infof("Inserting or updating the existing post in draft for user %v", draft.UserID)
 
-	result, err := repository.db.ExecContext(ctx, SavePostDraft, draft.Data, draft.DraftID, draft.UserID)
+	var version int64
+	err := txn.GetContext(ctx, &version, SavePostDraft, draft.Data, draft.DraftID, draft.UserID, draft.Version)
 
 	if err != nil {
 		log.Errorf("Error occurred while updating post in draft for user %v", err)
-		return err
-	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		logger.Infof("unable to get affected rows")
-		return err
+		return 0, err
 	}
 
-	if rowsAffected == 0 {
-		log.Error("no such draft")
-		return errors.New("draft not found")
-	}
-
-	return nil
+	return version, nil
 }
 
-func (repository draftRepository) SaveTaglineToDraft(taglineSaveRequest request.TaglineSaveRequest, ctx context.Context) error {
+func (repository draftRepository) SaveTaglineToDraft(taglineSaveRequest request.TaglineSaveRequest, ctx context.Context) (int64, error) {
 	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "SaveTaglineToDraft")
 	logger.Info("Inserting tagline or upserting to the draft for the given draft id")
 
-	result, err := repository.db.ExecContext(ctx, SaveTagline, taglineSaveRequest.Tagline, taglineSaveRequest.DraftID, taglineSaveRequest.UserID)
+	var version int64
+	err := repository.db.GetContext(ctx, &version, SaveTagline, taglineSaveRequest.Tagline, taglineSaveRequest.DraftID, taglineSaveRequest.UserID, taglineSaveRequest.Version)
 
 	if err != nil {
 		logger.Errorf("Error occurred while updating post tagline in draft for user %v", err)
-		return err
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		logger.Errorf("unable to get affected rows %v", err)
-		return err
-	}
-
-	if rowsAffected == 0 {
-		logger.Errorf("draft not found")
-		return errors.New("draft not found")
+		return 0, err
 	}
 
-	logger.Infof("Successfully saved the tagline for draft id %v", taglineSaveRequest.Tagline)
-	return nil
+	logger.Infof("Successfully saved the tagline for draft id %v", taglineSaveRequest.DraftID)
+	return version, nil
 }
 
-func (repository draftRepository) SaveInterestsToDraft(interestsSaveRequest request.InterestsSaveRequest, ctx context.Context) error {
+func (repository draftRepository) SaveInterestsToDraft(interestsSaveRequest request.InterestsSaveRequest, ctx context.Context) (int64, error) {
 	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "SaveInterestsToDraft")
 	logger.Info("Inserting interests or upserting to the draft for the given draft id")
 
-	// TODO Need to check affected rows
-	result, err := repository.db.ExecContext(ctx, SaveInterests, pq.Array(interestsSaveRequest.Interests), interestsSaveRequest.DraftID, interestsSaveRequest.UserID)
+	var version int64
+	err := repository.db.GetContext(ctx, &version, SaveInterests, pq.Array(interestsSaveRequest.Interests), interestsSaveRequest.DraftID, interestsSaveRequest.UserID, interestsSaveRequest.Version)
 
 	if err != nil {
-		logger.Errorf("Error occurred while updating post tagline in draft for user %v", err)
-		return err
+		logger.Errorf("Error occurred while updating post interests in draft for user %v", err)
+		return 0, err
 	}
 
-	rowAffected, err := result.RowsAffected()
-
-	if err != nil {
-		logger.Errorf("unable to get affected rows %v", err)
-		return err
-	}
-	if rowAffected == 0 {
-		logger.Errorf("no row affected, draft not found")
-		return errors.New("draft not found")
-	}
-
-	logger.Infof("Successfully saved the Interests for draft id %v", interestsSaveRequest.Interests)
-	return nil
+	logger.Infof("Successfully saved the Interests for draft id %v", interestsSaveRequest.DraftID)
+	return version, nil
 }
 
 func (repository draftRepository) GetAllDraft(ctx context.Context, allDraftReq models.GetAllDraftRequest) ([]db.Draft, error) {
@@ -256,22 +265,6 @@ func (repository draftRepository) DeleteDraft(ctx context.Context, draftUID, use
 	return nil
 }
 
-func (repository draftRepository) DeleteDraftImages(ctx context.Context, draftUID uuid.UUID) error {
-	logger := logging.GetLogger(ctx).WithField("class", "").WithField("method", "DeleteDraft")
-
-	logger.Infof("Deleting draft images for the given draft uid %v", draftUID)
-
-	_, err := repository.db.ExecContext(ctx, DeleteDraftImages, draftUID)
-
-	if err != nil {
-		logger.Errorf("error occurred while deleting draft from draft repository for draft id %v. Error %v", draftUID, err)
-		return err
-	}
-
-	logger.Info("Successfully deleted draft images")
-	return nil
-}
-
 func (repository draftRepository) UpdatePublishStatus(ctx context.Context, txn transaction.Transaction, draftUID, userID uuid.UUID, status bool) error {
 	logger := logging.GetLogger(ctx).WithField("class", "").WithField("method", "DeleteDraft")
 
@@ -315,6 +308,21 @@ func (repository draftRepository) UpsertImage(ctx context.Context, saveRequest r
 	return imageID.String(), nil
 }
 
+// AddImage registers an uploaded image for a draft as part of a transaction, for images copied along
+// with the content of another draft.
+func (repository draftRepository) AddImage(ctx context.Context, txn transaction.Transaction, saveRequest request.PreviewImageSaveRequest) (string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "AddImage")
+
+	var imageID uuid.UUID
+	err := txn.GetContext(ctx, &imageID, InsertDraftImage, saveRequest.DraftID, saveRequest.UploadID)
+	if err != nil {
+		logger.Errorf("Unable to insert draft image for draft id %v. Error %v", saveRequest.DraftID, err)
+		return "", err
+	}
+
+	return imageID.String(), nil
+}
+
 func (repository draftRepository) GetDraftImage(ctx context.Context, draftID, imageID uuid.UUID) (string, error) {
 	type draftImage struct {
 		ID       string `db:"id"`
@@ -336,6 +344,242 @@ func (repository draftRepository) GetDraftImage(ctx context.Context, draftID, im
 	return image.UploadID, nil
 }
 
+func (repository draftRepository) SchedulePublish(ctx context.Context, draftID, userID uuid.UUID, publishAt time.Time) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "SchedulePublish")
+	logger.Infof("Scheduling draft %v to be published at %v", draftID, publishAt)
+
+	result, err := repository.db.ExecContext(ctx, SchedulePublish, publishAt, draftID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while scheduling draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no unpublished draft found for draft id %v", draftID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository draftRepository) CancelScheduledPublish(ctx context.Context, draftID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "CancelScheduledPublish")
+	logger.Infof("Cancelling scheduled publish of draft %v", draftID)
+
+	result, err := repository.db.ExecContext(ctx, CancelSchedule, draftID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while cancelling schedule of draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no scheduled draft found for draft id %v", draftID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository draftRepository) GetScheduledDrafts(ctx context.Context, userID uuid.UUID) ([]db.ScheduledDraft, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "GetScheduledDrafts")
+	logger.Infof("Fetching scheduled drafts of user %v", userID)
+
+	var drafts []db.ScheduledDraft
+	err := repository.db.SelectContext(ctx, &drafts, FetchScheduled, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching scheduled drafts of user %v. Error %v", userID, err)
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+func (repository draftRepository) GetDueScheduledDrafts(ctx context.Context, limit int) ([]db.ScheduledDraft, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "GetDueScheduledDrafts")
+
+	var drafts []db.ScheduledDraft
+	err := repository.db.SelectContext(ctx, &drafts, FetchDueScheduled, limit)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching due scheduled drafts. Error %v", err)
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+// ClaimScheduledDraft takes a lease on a due draft so only one instance publishes it. The lease
+// expires on its own, so a draft claimed by an instance that died mid publish is picked up again.
+func (repository draftRepository) ClaimScheduledDraft(ctx context.Context, draftID uuid.UUID, lease time.Duration) (bool, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "ClaimScheduledDraft")
+
+	result, err := repository.db.ExecContext(ctx, ClaimScheduled, int64(lease.Seconds()), draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while claiming scheduled draft %v. Error %v", draftID, err)
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (repository draftRepository) UpdatePublishError(ctx context.Context, draftID uuid.UUID, publishError string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "UpdatePublishError")
+	logger.Infof("Recording publish failure for draft %v", draftID)
+
+	_, err := repository.db.ExecContext(ctx, UpdatePublishError, publishError, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while recording publish failure of draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	return nil
+}
+
+// DelayPublish counts a failed attempt to publish a scheduled draft and keeps the draft locked until the
+// next attempt is due.
+func (repository draftRepository) DelayPublish(ctx context.Context, draftID uuid.UUID, delay time.Duration) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "DelayPublish")
+	logger.Infof("Retrying publish of draft %v in %v", draftID, delay)
+
+	_, err := repository.db.ExecContext(ctx, DelayPublish, int64(delay.Seconds()), draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while delaying publish of draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (repository draftRepository) RestoreDraft(ctx context.Context, draftID, userID uuid.UUID, retention time.Duration) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "RestoreDraft")
+	logger.Infof("Restoring draft %v from trash", draftID)
+
+	result, err := repository.db.ExecContext(ctx, RestoreDraft, draftID, userID, retention.Seconds())
+	if err != nil {
+		logger.Errorf("Error occurred while restoring draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no restorable draft %v found for user %v", draftID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetTrashedDrafts returns the deleted drafts of the user that are still within their retention, leaving
+// out the ones waiting to be purged.
+func (repository draftRepository) GetTrashedDrafts(ctx context.Context, userID uuid.UUID, retention time.Duration) ([]db.TrashedDraft, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "GetTrashedDrafts")
+
+	var drafts []db.TrashedDraft
+	err := repository.db.SelectContext(ctx, &drafts, FetchTrashed, userID, retention.Seconds())
+	if err != nil {
+		logger.Errorf("Error occurred while fetching trashed drafts of user %v. Error %v", userID, err)
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+// GetExpiredTrashedDrafts returns the drafts past their retention, skipping the ones whose purge failed
+// maxAttempts times so they cannot hold up the rest of the trash.
+func (repository draftRepository) GetExpiredTrashedDrafts(ctx context.Context, retention time.Duration, maxAttempts, limit int) ([]db.TrashedDraft, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "GetExpiredTrashedDrafts")
+
+	var drafts []db.TrashedDraft
+	err := repository.db.SelectContext(ctx, &drafts, FetchExpiredTrashed, retention.Seconds(), maxAttempts, limit)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching expired trashed drafts. Error %v", err)
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+func (repository draftRepository) RecordPurgeFailure(ctx context.Context, draftID uuid.UUID, reason string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "RecordPurgeFailure")
+
+	_, err := repository.db.ExecContext(ctx, UpdatePurgeFailure, reason, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while recording purge failure of draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetUnsharedImageKeys returns the upload keys of the draft images that no other draft or template refers
+// to. Forks and templates register the images of their source again, so a key can outlive the draft it was
+// uploaded for.
+func (repository draftRepository) GetUnsharedImageKeys(ctx context.Context, draftID uuid.UUID) ([]string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "GetUnsharedImageKeys")
+
+	var keys []string
+	err := repository.db.SelectContext(ctx, &keys, FetchUnsharedImages, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching image keys of draft %v. Error %v", draftID, err)
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (repository draftRepository) PurgeDraft(ctx context.Context, txn transaction.Transaction, draftID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRepository").WithField("method", "PurgeDraft")
+	logger.Infof("Purging draft %v", draftID)
+
+	for _, query := range []string{PurgeRevisions, PurgeImages, PurgeSubmissions, PurgePreviewLinks} {
+		if _, err := txn.ExecContext(ctx, query, draftID); err != nil {
+			logger.Errorf("Error occurred while purging draft %v. Error %v", draftID, err)
+			return err
+		}
+	}
+
+	result, err := txn.ExecContext(ctx, PurgeDraft, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while purging draft %v. Error %v", draftID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("draft %v is no longer in trash", draftID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 func NewDraftRepository(db *sqlx.DB) DraftRepository {
 	return draftRepository{
 		db: db,
@@ -3,11 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx/types"
 	"post-api/dbhelper"
+	repoHelper "post-api/helper"
 	"post-api/story/models"
 	"post-api/story/models/db"
 	"post-api/story/models/request"
@@ -30,6 +30,7 @@ type DraftRepositoryIntegrationTest struct {
 	draftRepository DraftRepository
 	userRepository  helper.UserRepository
 	dbHelper        helper.DbHelper
+	transaction     repoHelper.TransactionManager
 }
 
 func (suite *DraftRepositoryIntegrationTest) SetupTest() {
@@ -46,6 +47,7 @@ func (suite *DraftRepositoryIntegrationTest) SetupTest() {
 	suite.draftRepository = NewDraftRepository(database)
 	suite.userRepository = helper.NewUserRepository(suite.db)
 	suite.dbHelper = helper.NewDbHelper(database)
+	suite.transaction = repoHelper.NewTransactionManager(database)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TearDownTest() {
@@ -60,6 +62,16 @@ func (suite *DraftRepositoryIntegrationTest) ClearDraftData() {
 	}
 }
 
+func (suite *DraftRepositoryIntegrationTest) createDraft(draft models.CreateDraft) (uuid.UUID, error) {
+	transaction := suite.transaction.NewTransaction()
+	draftUUID, err := suite.draftRepository.CreateDraft(suite.goContext, transaction, draft)
+	if err != nil {
+		_ = transaction.Rollback()
+		return draftUUID, err
+	}
+	return draftUUID, transaction.Commit()
+}
+
 func TestDraftRepositoryIntegrationTest(t *testing.T) {
 	suite.Run(t, new(DraftRepositoryIntegrationTest))
 }
@@ -79,7 +91,7 @@ func (suite *DraftRepositoryIntegrationTest) TestSavePostDraft_WhenUpdate() {
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 	updatedDraft := models.UpsertDraft{
 		DraftID: draftUUID,
@@ -87,11 +99,42 @@ func (suite *DraftRepositoryIntegrationTest) TestSavePostDraft_WhenUpdate() {
 		Data: models.JSONString{
 			JSONText: types.JSONText(`{"title": "hello"}`),
 		},
+		Version: 1,
 	}
-	err = suite.draftRepository.SavePostDraft(updatedDraft, suite.goContext)
+	transaction := suite.transaction.NewTransaction()
+	version, err := suite.draftRepository.SavePostDraft(suite.goContext, transaction, updatedDraft)
+	suite.Nil(err)
+	suite.Equal(int64(2), version)
+	err = transaction.Commit()
 	suite.Nil(err)
 }
 
+func (suite *DraftRepositoryIntegrationTest) TestSavePostDraft_WhenVersionIsStale() {
+	userRequest := helper.CreateUserRequest{
+		Email:    "dummyUserOne@gmail.com",
+		Role:     "User",
+		Password: "some-password",
+		Username: "some-username",
+	}
+	userUUID, err := suite.userRepository.CreateUser(suite.goContext, userRequest)
+	suite.Nil(err)
+	draftUUID, err := suite.createDraft(models.CreateDraft{
+		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
+		UserID: userUUID,
+	})
+	suite.Nil(err)
+
+	transaction := suite.transaction.NewTransaction()
+	_, err = suite.draftRepository.SavePostDraft(suite.goContext, transaction, models.UpsertDraft{
+		DraftID: draftUUID,
+		UserID:  userUUID,
+		Data:    models.JSONString{JSONText: types.JSONText(`{"title": "hello"}`)},
+		Version: 5,
+	})
+	_ = transaction.Rollback()
+	suite.Equal(sql.ErrNoRows, err)
+}
+
 func (suite *DraftRepositoryIntegrationTest) TestSavePostDraft_WhenNoSuchPost() {
 	newDraft := models.UpsertDraft{
 		DraftID: uuid.New(),
@@ -101,10 +144,11 @@ func (suite *DraftRepositoryIntegrationTest) TestSavePostDraft_WhenNoSuchPost()
 		},
 	}
 
-	err := suite.draftRepository.SavePostDraft(newDraft, suite.goContext)
+	transaction := suite.transaction.NewTransaction()
+	_, err := suite.draftRepository.SavePostDraft(suite.goContext, transaction, newDraft)
+	_ = transaction.Rollback()
 	suite.NotNil(err)
-	expectedErr := errors.New("draft not found")
-	suite.Equal(expectedErr, err)
+	suite.Equal(sql.ErrNoRows, err)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TestSaveDraftTagline_WhenDbReturnsSuccess() {
@@ -122,17 +166,19 @@ func (suite *DraftRepositoryIntegrationTest) TestSaveDraftTagline_WhenDbReturnsS
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 	saveRequest := request.TaglineSaveRequest{
 		UserID:  userUUID,
 		DraftID: draftUUID,
 		Tagline: "this is some tagline that will be stored",
+		Version: 1,
 	}
 
-	err = suite.draftRepository.SaveTaglineToDraft(saveRequest, suite.goContext)
+	version, err := suite.draftRepository.SaveTaglineToDraft(saveRequest, suite.goContext)
 
 	suite.Nil(err)
+	suite.Equal(int64(2), version)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TestSaveDraftTagline_WhenNoDraftPresent() {
@@ -142,10 +188,10 @@ func (suite *DraftRepositoryIntegrationTest) TestSaveDraftTagline_WhenNoDraftPre
 		Tagline: "this is some tagline that will be stored",
 	}
 
-	err := suite.draftRepository.SaveTaglineToDraft(saveRequest, suite.goContext)
+	_, err := suite.draftRepository.SaveTaglineToDraft(saveRequest, suite.goContext)
 
 	suite.NotNil(err)
-	suite.Equal(errors.New("draft not found"), err)
+	suite.Equal(sql.ErrNoRows, err)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TestSaveInterestsToDraft_WhenDraftAvailable() {
@@ -163,24 +209,25 @@ func (suite *DraftRepositoryIntegrationTest) TestSaveInterestsToDraft_WhenDraftA
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 
 	interests := []string{"Sports", "Culture"}
 
-	interestRequest := request.InterestsSaveRequest{UserID: userUUID, DraftID: draftUUID, Interests: interests}
+	interestRequest := request.InterestsSaveRequest{UserID: userUUID, DraftID: draftUUID, Interests: interests, Version: 1}
 
-	interestErr := suite.draftRepository.SaveInterestsToDraft(interestRequest, suite.goContext)
+	version, interestErr := suite.draftRepository.SaveInterestsToDraft(interestRequest, suite.goContext)
 	suite.Nil(interestErr)
+	suite.Equal(int64(2), version)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TestSaveInterestsToDraft_WhenDraftNotAvailable() {
 
 	interestsSaveRequest := request.InterestsSaveRequest{UserID: uuid.New(), DraftID: uuid.New(), Interests: []string{"Sports", "Culture"}}
 
-	interestErr := suite.draftRepository.SaveInterestsToDraft(interestsSaveRequest, suite.goContext)
+	_, interestErr := suite.draftRepository.SaveInterestsToDraft(interestsSaveRequest, suite.goContext)
 	suite.NotNil(interestErr)
-	suite.Equal(errors.New("draft not found"), interestErr)
+	suite.Equal(sql.ErrNoRows, interestErr)
 
 }
 
@@ -199,17 +246,19 @@ func (suite *DraftRepositoryIntegrationTest) TestUpsertPreviewImage_WhenUpsertSu
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 
 	saveRequest := request.PreviewImageSaveRequest{
 		UserID:   userUUID,
 		DraftID:  draftUUID,
 		UploadID: "https://some-url",
+		Version:  1,
 	}
 
-	err = suite.draftRepository.UpsertPreviewImage(suite.goContext, saveRequest)
+	version, err := suite.draftRepository.UpsertPreviewImage(suite.goContext, saveRequest)
 	suite.Nil(err)
+	suite.Equal(int64(2), version)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TestUpsertPreviewImage_WhenNoDraft() {
@@ -219,9 +268,9 @@ func (suite *DraftRepositoryIntegrationTest) TestUpsertPreviewImage_WhenNoDraft(
 		UploadID: "https://some-url",
 	}
 
-	err := suite.draftRepository.UpsertPreviewImage(suite.goContext, previewImageSaveRequest)
+	_, err := suite.draftRepository.UpsertPreviewImage(suite.goContext, previewImageSaveRequest)
 	suite.NotNil(err)
-	suite.Equal(errors.New("draft not found"), err)
+	suite.Equal(sql.ErrNoRows, err)
 }
 
 func (suite *DraftRepositoryIntegrationTest) TestGetDraft_WhenDbReturnsDraft() {
@@ -239,30 +288,33 @@ func (suite *DraftRepositoryIntegrationTest) TestGetDraft_WhenDbReturnsDraft() {
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 
 	taglineSaveRequest := request.TaglineSaveRequest{
 		UserID:  userUUID,
 		DraftID: draftUUID,
 		Tagline: "this is some tagline for draft",
+		Version: 1,
 	}
 
-	err = suite.draftRepository.SaveTaglineToDraft(taglineSaveRequest, suite.goContext)
+	_, err = suite.draftRepository.SaveTaglineToDraft(taglineSaveRequest, suite.goContext)
 	suite.Nil(err)
 
-	err = suite.draftRepository.SaveInterestsToDraft(request.InterestsSaveRequest{
+	_, err = suite.draftRepository.SaveInterestsToDraft(request.InterestsSaveRequest{
 		UserID:    userUUID,
 		DraftID:   draftUUID,
 		Interests: []string{"Culture", "Sports"},
+		Version:   2,
 	}, suite.goContext)
 
 	suite.Nil(err)
 
-	err = suite.draftRepository.UpsertPreviewImage(suite.goContext, request.PreviewImageSaveRequest{
+	_, err = suite.draftRepository.UpsertPreviewImage(suite.goContext, request.PreviewImageSaveRequest{
 		UserID:   userUUID,
 		DraftID:  draftUUID,
 		UploadID: "https://www.some-url.com",
+		Version:  3,
 	})
 	suite.Nil(err)
 
@@ -276,6 +328,7 @@ func (suite *DraftRepositoryIntegrationTest) TestGetDraft_WhenDbReturnsDraft() {
 		PreviewImage: &previewImage,
 		Tagline:      &tagLine,
 		Interests:    &interests,
+		Version:      4,
 	}
 
 	savedDraft, err := suite.draftRepository.GetDraftByUser(suite.goContext, draftUUID, userUUID)
@@ -314,7 +367,7 @@ func (suite *DraftRepositoryIntegrationTest) TestGetAllDraft_WhenDBHasValues() {
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 	getAllDraftRequest := models.GetAllDraftRequest{UserID: userUUID, StartValue: 0, Limit: 3}
 
@@ -368,13 +421,13 @@ func (suite *DraftRepositoryIntegrationTest) TestGetAllDraft_WhenReturnsMultiple
 		UserID: userUUID,
 	}
 
-	_, err = suite.draftRepository.CreateDraft(suite.goContext, draftOne)
+	_, err = suite.createDraft(draftOne)
 	suite.Nil(err)
-	draftTwoUUID, err := suite.draftRepository.CreateDraft(suite.goContext, draftTwo)
+	draftTwoUUID, err := suite.createDraft(draftTwo)
 	suite.Nil(err)
-	draftThreeUUID, err := suite.draftRepository.CreateDraft(suite.goContext, draftThree)
+	draftThreeUUID, err := suite.createDraft(draftThree)
 	suite.Nil(err)
-	draftFourUUID, err := suite.draftRepository.CreateDraft(suite.goContext, draftFour)
+	draftFourUUID, err := suite.createDraft(draftFour)
 	suite.Nil(err)
 
 	getAllDraftRequest := models.GetAllDraftRequest{UserID: userUUID, StartValue: 0, Limit: 3}
@@ -446,13 +499,13 @@ func (suite *DraftRepositoryIntegrationTest) TestGetAllDraft_WhenReturnsMultiple
 		UserID: userUUID,
 	}
 
-	draftOneUUID, err := suite.draftRepository.CreateDraft(suite.goContext, draftOne)
+	draftOneUUID, err := suite.createDraft(draftOne)
 	suite.Nil(err)
-	draftTwoUUID, err := suite.draftRepository.CreateDraft(suite.goContext, draftTwo)
+	draftTwoUUID, err := suite.createDraft(draftTwo)
 	suite.Nil(err)
-	draftThreeUUID, err := suite.draftRepository.CreateDraft(suite.goContext, draftThree)
+	draftThreeUUID, err := suite.createDraft(draftThree)
 	suite.Nil(err)
-	_, err = suite.draftRepository.CreateDraft(suite.goContext, draftFour)
+	_, err = suite.createDraft(draftFour)
 	suite.Nil(err)
 
 	now := time.Now()
@@ -512,7 +565,7 @@ func (suite *DraftRepositoryIntegrationTest) TestDeleteDraft_WhenDbDeletesDraft(
 		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
 		UserID: userUUID,
 	}
-	draftUUID, err = suite.draftRepository.CreateDraft(suite.goContext, draft)
+	draftUUID, err = suite.createDraft(draft)
 	suite.Nil(err)
 
 	err = suite.draftRepository.DeleteDraft(suite.goContext, draftUUID, userUUID)
@@ -524,3 +577,77 @@ func (suite *DraftRepositoryIntegrationTest) TestDeleteDraft_WhenNoDraftFound()
 	suite.NotNil(err)
 	suite.Equal(sql.ErrNoRows, err)
 }
+
+func (suite *DraftRepositoryIntegrationTest) TestSchedulePublish_WhenDraftIsDue() {
+	userRequest := helper.CreateUserRequest{
+		Email:    "dummyUserOne@gmail.com",
+		Role:     "User",
+		Password: "some-password",
+		Username: "some-username",
+	}
+	userUUID, err := suite.userRepository.CreateUser(suite.goContext, userRequest)
+	suite.Nil(err)
+	draftUUID, err := suite.createDraft(models.CreateDraft{
+		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
+		UserID: userUUID,
+	})
+	suite.Nil(err)
+
+	err = suite.draftRepository.SchedulePublish(suite.goContext, draftUUID, userUUID, time.Now().Add(-time.Minute))
+	suite.Nil(err)
+
+	dueDrafts, err := suite.draftRepository.GetDueScheduledDrafts(suite.goContext, 10)
+	suite.Nil(err)
+	suite.Len(dueDrafts, 1)
+	suite.Equal(draftUUID, dueDrafts[0].DraftID)
+
+	claimed, err := suite.draftRepository.ClaimScheduledDraft(suite.goContext, draftUUID, time.Minute)
+	suite.Nil(err)
+	suite.True(claimed)
+
+	claimed, err = suite.draftRepository.ClaimScheduledDraft(suite.goContext, draftUUID, time.Minute)
+	suite.Nil(err)
+	suite.False(claimed)
+
+	err = suite.draftRepository.UpdatePublishError(suite.goContext, draftUUID, "read time requirement not meet")
+	suite.Nil(err)
+
+	scheduledDrafts, err := suite.draftRepository.GetScheduledDrafts(suite.goContext, userUUID)
+	suite.Nil(err)
+	suite.Len(scheduledDrafts, 1)
+	suite.Equal("read time requirement not meet", *scheduledDrafts[0].PublishError)
+
+	err = suite.draftRepository.CancelScheduledPublish(suite.goContext, draftUUID, userUUID)
+	suite.Nil(err)
+}
+
+func (suite *DraftRepositoryIntegrationTest) TestPurgeDraft_WhenDraftHasPreviewLinks() {
+	userRequest := helper.CreateUserRequest{
+		Email:    "dummyUserOne@gmail.com",
+		Role:     "User",
+		Password: "some-password",
+		Username: "some-username",
+	}
+	userUUID, err := suite.userRepository.CreateUser(suite.goContext, userRequest)
+	suite.Nil(err)
+	draftUUID, err := suite.createDraft(models.CreateDraft{
+		Data:   models.JSONString{JSONText: types.JSONText(`[{"title": "some text"}]`)},
+		UserID: userUUID,
+	})
+	suite.Nil(err)
+
+	previewLinkRepository := NewDraftPreviewLinkRepository(suite.db)
+	err = previewLinkRepository.Create(suite.goContext, uuid.New(), draftUUID, userUUID, time.Now().Add(time.Hour))
+	suite.Nil(err)
+	err = suite.draftRepository.DeleteDraft(suite.goContext, draftUUID, userUUID)
+	suite.Nil(err)
+
+	txn := suite.transaction.NewTransaction()
+	err = suite.draftRepository.PurgeDraft(suite.goContext, txn, draftUUID)
+	suite.Nil(err)
+	suite.Nil(txn.Commit())
+
+	links, err := previewLinkRepository.GetActiveLinks(suite.goContext, draftUUID, userUUID)
+	suite.Nil(err)
+	suite.Empty(links)
+}
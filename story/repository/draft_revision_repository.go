@@ -0,0 +1,76 @@
+package repository
+
+//go:generate mockgen -source=draft_revision_repository.go -destination=./../mocks/mock_draft_revision_repository.go -package=mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"post-api/helper"
+	"post-api/story/models"
+	"post-api/story/models/db"
+)
+
+type DraftRevisionRepository interface {
+	Save(ctx context.Context, txn helper.Transaction, draftID uuid.UUID, data models.JSONString) (uuid.UUID, error)
+	GetRevisions(ctx context.Context, draftID uuid.UUID) ([]db.DraftRevisionSummary, error)
+	GetRevision(ctx context.Context, draftID, revisionID uuid.UUID) (db.DraftRevision, error)
+}
+
+type draftRevisionRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	SaveDraftRevision   = "insert into draft_revisions (id, draft_id, revision, data) values (uuid_generate_v4(), $1, (select coalesce(max(revision), 0) + 1 from draft_revisions where draft_id = $2), $3) returning id"
+	FetchDraftRevisions = "select id, draft_id, revision, created_at from draft_revisions where draft_id = $1 order by revision desc"
+	FetchDraftRevision  = "select id, draft_id, revision, data, created_at from draft_revisions where id = $1 and draft_id = $2"
+)
+
+func (repository draftRevisionRepository) Save(ctx context.Context, txn helper.Transaction, draftID uuid.UUID, data models.JSONString) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRevisionRepository").WithField("method", "Save")
+	logger.Infof("Inserting new revision for draft id %v", draftID)
+
+	var revisionID uuid.UUID
+	err := txn.GetContext(ctx, &revisionID, SaveDraftRevision, draftID, draftID, data)
+	if err != nil {
+		logger.Errorf("Error occurred while inserting revision for draft id %v. Error %v", draftID, err)
+		return revisionID, err
+	}
+
+	logger.Infof("Successfully inserted revision %v for draft id %v", revisionID, draftID)
+	return revisionID, nil
+}
+
+func (repository draftRevisionRepository) GetRevisions(ctx context.Context, draftID uuid.UUID) ([]db.DraftRevisionSummary, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRevisionRepository").WithField("method", "GetRevisions")
+	logger.Infof("Fetching revisions for draft id %v", draftID)
+
+	var revisions []db.DraftRevisionSummary
+	err := repository.db.SelectContext(ctx, &revisions, FetchDraftRevisions, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching revisions for draft id %v. Error %v", draftID, err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (repository draftRevisionRepository) GetRevision(ctx context.Context, draftID, revisionID uuid.UUID) (db.DraftRevision, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftRevisionRepository").WithField("method", "GetRevision")
+	logger.Infof("Fetching revision %v for draft id %v", revisionID, draftID)
+
+	var revision db.DraftRevision
+	err := repository.db.GetContext(ctx, &revision, FetchDraftRevision, revisionID, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching revision %v for draft id %v. Error %v", revisionID, draftID, err)
+		return db.DraftRevision{}, err
+	}
+
+	return revision, nil
+}
+
+func NewDraftRevisionRepository(db *sqlx.DB) DraftRevisionRepository {
+	return draftRevisionRepository{db: db}
+}
@@ -0,0 +1,173 @@
+package repository
+
+//go:generate mockgen -source=draft_template_repository.go -destination=./../mocks/mock_draft_template_repository.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	transaction "post-api/helper"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+)
+
+type DraftTemplateRepository interface {
+	CreateFromDraft(ctx context.Context, txn transaction.Transaction, saveRequest request.SaveTemplateRequest) (uuid.UUID, error)
+	AddImage(ctx context.Context, txn transaction.Transaction, templateID, draftID, imageID uuid.UUID, uploadID string) error
+	GetImage(ctx context.Context, templateID, draftID, imageID uuid.UUID) (string, error)
+	GetTemplate(ctx context.Context, templateID, userID uuid.UUID) (db.DraftTemplate, error)
+	GetTemplatesByUser(ctx context.Context, userID uuid.UUID) ([]db.DraftTemplateSummary, error)
+	GetPublicTemplates(ctx context.Context, limit, offset int) ([]db.DraftTemplateSummary, error)
+	UpdateVisibility(ctx context.Context, templateID, userID uuid.UUID, isPublic bool) error
+	Delete(ctx context.Context, templateID, userID uuid.UUID) error
+}
+
+type draftTemplateRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	CreateTemplateFromDraft  = "insert into draft_templates (id, user_id, name, description, data, tagline, interests, is_public) select uuid_generate_v4(), drafts.user_id, $1, $2, drafts.data, drafts.tagline, drafts.interests, $3 from drafts where drafts.id = $4 and drafts.user_id = $5 and drafts.deleted_at is null returning id"
+	FetchTemplate            = "select id, user_id, name, description, data, tagline, interests, is_public, created_at from draft_templates where id = $1 and (user_id = $2 or is_public is true)"
+	FetchTemplatesByUser     = "select id, user_id, name, description, is_public, created_at from draft_templates where user_id = $1 order by created_at desc"
+	FetchPublicTemplates     = "select id, user_id, name, description, is_public, created_at from draft_templates where is_public is true order by created_at desc limit $1 offset $2"
+	UpdateTemplateVisibility = "update draft_templates set is_public = $1, updated_at = current_timestamp where id = $2 and user_id = $3"
+	DeleteTemplate           = "delete from draft_templates where id = $1 and user_id = $2"
+	InsertTemplateImage      = "insert into draft_template_images (template_id, draft_id, image_id, upload_id) values ($1, $2, $3, $4) on conflict (template_id, draft_id, image_id) do nothing"
+	FetchTemplateImage       = "select upload_id from draft_template_images where template_id = $1 and draft_id = $2 and image_id = $3"
+)
+
+func (repository draftTemplateRepository) CreateFromDraft(ctx context.Context, txn transaction.Transaction, saveRequest request.SaveTemplateRequest) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "CreateFromDraft")
+	logger.Infof("Saving draft %v as template for user %v", saveRequest.DraftID, saveRequest.UserID)
+
+	var templateID uuid.UUID
+	err := txn.GetContext(ctx, &templateID, CreateTemplateFromDraft, saveRequest.Name, saveRequest.Description, saveRequest.IsPublic, saveRequest.DraftID, saveRequest.UserID)
+	if err != nil {
+		logger.Errorf("Error occurred while saving draft %v as template. Error %v", saveRequest.DraftID, err)
+		return uuid.Nil, err
+	}
+
+	logger.Infof("Successfully saved draft %v as template %v", saveRequest.DraftID, templateID)
+	return templateID, nil
+}
+
+func (repository draftTemplateRepository) GetTemplate(ctx context.Context, templateID, userID uuid.UUID) (db.DraftTemplate, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "GetTemplate")
+
+	var template db.DraftTemplate
+	err := repository.db.GetContext(ctx, &template, FetchTemplate, templateID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching template %v. Error %v", templateID, err)
+		return db.DraftTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func (repository draftTemplateRepository) GetTemplatesByUser(ctx context.Context, userID uuid.UUID) ([]db.DraftTemplateSummary, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "GetTemplatesByUser")
+
+	var templates []db.DraftTemplateSummary
+	err := repository.db.SelectContext(ctx, &templates, FetchTemplatesByUser, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching templates of user %v. Error %v", userID, err)
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (repository draftTemplateRepository) GetPublicTemplates(ctx context.Context, limit, offset int) ([]db.DraftTemplateSummary, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "GetPublicTemplates")
+
+	var templates []db.DraftTemplateSummary
+	err := repository.db.SelectContext(ctx, &templates, FetchPublicTemplates, limit, offset)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching public templates. Error %v", err)
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (repository draftTemplateRepository) UpdateVisibility(ctx context.Context, templateID, userID uuid.UUID, isPublic bool) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "UpdateVisibility")
+	logger.Infof("Setting template %v public to %v", templateID, isPublic)
+
+	result, err := repository.db.ExecContext(ctx, UpdateTemplateVisibility, isPublic, templateID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while updating visibility of template %v. Error %v", templateID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no template %v found for user %v", templateID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository draftTemplateRepository) Delete(ctx context.Context, templateID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "Delete")
+	logger.Infof("Deleting template %v", templateID)
+
+	result, err := repository.db.ExecContext(ctx, DeleteTemplate, templateID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while deleting template %v. Error %v", templateID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no template %v found for user %v", templateID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// AddImage registers the upload behind an image block of the template, keyed by the draft image the block
+// points to. The template keeps the upload alive after the draft it was copied from is purged.
+func (repository draftTemplateRepository) AddImage(ctx context.Context, txn transaction.Transaction, templateID, draftID, imageID uuid.UUID, uploadID string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "AddImage")
+
+	_, err := txn.ExecContext(ctx, InsertTemplateImage, templateID, draftID, imageID, uploadID)
+	if err != nil {
+		logger.Errorf("Error occurred while adding image %v of draft %v to template %v. Error %v", imageID, draftID, templateID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (repository draftTemplateRepository) GetImage(ctx context.Context, templateID, draftID, imageID uuid.UUID) (string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateRepository").WithField("method", "GetImage")
+
+	var uploadID string
+	err := repository.db.GetContext(ctx, &uploadID, FetchTemplateImage, templateID, draftID, imageID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching image %v of draft %v for template %v. Error %v", imageID, draftID, templateID, err)
+		return "", err
+	}
+
+	return uploadID, nil
+}
+
+func NewDraftTemplateRepository(db *sqlx.DB) DraftTemplateRepository {
+	return draftTemplateRepository{db: db}
+}
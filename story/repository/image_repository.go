@@ -0,0 +1,95 @@
+package repository
+
+//go:generate mockgen -source=image_repository.go -destination=./../mocks/mock_image_repository.go -package=mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"post-api/story/models/db"
+)
+
+type ImageRepository interface {
+	GetDraftImages(ctx context.Context) ([]db.DraftImage, error)
+	GetImageBlockURLs(ctx context.Context) ([]string, error)
+	GetStoredImageKeys(ctx context.Context) ([]string, error)
+	DeleteDraftImages(ctx context.Context, imageIDs []uuid.UUID) error
+}
+
+type imageRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	FetchDraftImages     = "select id, draft_id, upload_id from draft_images"
+	FetchImageBlockURLs  = "with contents as (select data from drafts union all select data from draft_revisions union all select data from posts union all select data from post_revisions union all select data from draft_templates) select distinct block -> 'data' -> 'file' ->> 'url' from contents, jsonb_array_elements(case when jsonb_typeof(contents.data -> 'blocks') = 'array' then contents.data -> 'blocks' else '[]'::jsonb end) block where block ->> 'type' = 'image' and block -> 'data' -> 'file' ->> 'url' is not null"
+	FetchStoredImageKeys = "select preview_image from drafts where preview_image is not null union select preview_image from abstract_post where preview_image is not null union select preview_image from post_revisions where preview_image is not null union select avatar from users where avatar is not null union select upload_id from draft_template_images"
+	DeleteImagesByID     = "delete from draft_images where id = any($1)"
+)
+
+func (repository imageRepository) GetDraftImages(ctx context.Context) ([]db.DraftImage, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageRepository").WithField("method", "GetDraftImages")
+
+	var images []db.DraftImage
+	err := repository.db.SelectContext(ctx, &images, FetchDraftImages)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching draft images. Error %v", err)
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// GetImageBlockURLs returns the url of every image block in drafts, posts, their revisions and templates,
+// so an image stays referenced as long as a revision or template it appears in can be used.
+func (repository imageRepository) GetImageBlockURLs(ctx context.Context) ([]string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageRepository").WithField("method", "GetImageBlockURLs")
+
+	var urls []string
+	err := repository.db.SelectContext(ctx, &urls, FetchImageBlockURLs)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching image block urls. Error %v", err)
+		return nil, err
+	}
+
+	return urls, nil
+}
+
+// GetStoredImageKeys returns the S3 keys stored directly as preview images and avatars, and the uploads
+// registered for templates.
+func (repository imageRepository) GetStoredImageKeys(ctx context.Context) ([]string, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageRepository").WithField("method", "GetStoredImageKeys")
+
+	var keys []string
+	err := repository.db.SelectContext(ctx, &keys, FetchStoredImageKeys)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching stored image keys. Error %v", err)
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (repository imageRepository) DeleteDraftImages(ctx context.Context, imageIDs []uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageRepository").WithField("method", "DeleteDraftImages")
+	logger.Infof("Deleting %v draft images", len(imageIDs))
+
+	ids := make([]string, 0, len(imageIDs))
+	for _, imageID := range imageIDs {
+		ids = append(ids, imageID.String())
+	}
+
+	_, err := repository.db.ExecContext(ctx, DeleteImagesByID, pq.Array(ids))
+	if err != nil {
+		logger.Errorf("Error occurred while deleting draft images %v. Error %v", imageIDs, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewImageRepository(db *sqlx.DB) ImageRepository {
+	return imageRepository{db: db}
+}
@@ -0,0 +1,59 @@
+package repository
+
+//go:generate mockgen -source=post_fingerprint_repository.go -destination=./../mocks/mock_post_fingerprint_repository.go -package=mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"post-api/helper"
+	"post-api/story/models/db"
+)
+
+type PostFingerprintRepository interface {
+	FindNearest(ctx context.Context, fingerprint uint64, authorID, excludePostID uuid.UUID, maxDistance int) (db.FingerprintMatch, error)
+	Save(ctx context.Context, txn helper.Transaction, postID uuid.UUID, fingerprint uint64, duplicateOf *uuid.UUID, similarity *float64) error
+}
+
+type postFingerprintRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	// FindNearestFingerprint compares against deleted posts too, so deleting a post does not free its
+	// content for reposting, except for the author of the deleted post. Only fingerprints that share at
+	// least one of their four 16 bit bands with the given one are compared, which the band indexes find
+	// without reading every fingerprint.
+	FindNearestFingerprint = "select fingerprints.post_id, fingerprints.simhash, fingerprints.author_id, fingerprints.state, fingerprints.distance from (select post_fingerprints.post_id, post_fingerprints.simhash, posts.author_id, posts.state, length(replace(((post_fingerprints.simhash # $1)::bit(64))::text, '0', '')) as distance from post_fingerprints inner join posts on post_fingerprints.post_id = posts.id where (((post_fingerprints.simhash >> 48) & 65535) = (($1::bigint >> 48) & 65535) or ((post_fingerprints.simhash >> 32) & 65535) = (($1::bigint >> 32) & 65535) or ((post_fingerprints.simhash >> 16) & 65535) = (($1::bigint >> 16) & 65535) or (post_fingerprints.simhash & 65535) = ($1::bigint & 65535)) and posts.id <> $2 and not (posts.author_id = $3 and posts.state = 'deleted')) fingerprints where fingerprints.distance <= $4 order by fingerprints.distance limit 1"
+	SaveFingerprint        = "insert into post_fingerprints (post_id, simhash, duplicate_of, similarity) values ($1, $2, $3, $4) on conflict (post_id) do update set simhash = excluded.simhash, duplicate_of = excluded.duplicate_of, similarity = excluded.similarity, updated_at = current_timestamp"
+)
+
+func (repository postFingerprintRepository) FindNearest(ctx context.Context, fingerprint uint64, authorID, excludePostID uuid.UUID, maxDistance int) (db.FingerprintMatch, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostFingerprintRepository").WithField("method", "FindNearest")
+
+	var match db.FingerprintMatch
+	err := repository.db.GetContext(ctx, &match, FindNearestFingerprint, int64(fingerprint), excludePostID, authorID, maxDistance)
+	if err != nil {
+		logger.Errorf("Error occurred while finding posts similar to fingerprint %v .%v", fingerprint, err)
+		return db.FingerprintMatch{}, err
+	}
+
+	return match, nil
+}
+
+func (repository postFingerprintRepository) Save(ctx context.Context, txn helper.Transaction, postID uuid.UUID, fingerprint uint64, duplicateOf *uuid.UUID, similarity *float64) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostFingerprintRepository").WithField("method", "Save")
+
+	_, err := txn.ExecContext(ctx, SaveFingerprint, postID, int64(fingerprint), duplicateOf, similarity)
+	if err != nil {
+		logger.Errorf("Error occurred while saving fingerprint of post %v .%v", postID, err)
+		return err
+	}
+
+	return nil
+}
+
+func NewPostFingerprintRepository(db *sqlx.DB) PostFingerprintRepository {
+	return postFingerprintRepository{db: db}
+}
@@ -0,0 +1,60 @@
+package repository
+
+//go:generate mockgen -source=post_revision_repository.go -destination=./../mocks/mock_post_revision_repository.go -package=mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"post-api/helper"
+	"post-api/story/models/db"
+)
+
+type PostRevisionRepository interface {
+	Save(ctx context.Context, txn helper.Transaction, postID uuid.UUID) (uuid.UUID, error)
+	GetRevisions(ctx context.Context, postID uuid.UUID) ([]db.PostRevision, error)
+}
+
+type postRevisionRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	// SavePostRevision snapshots the currently published version of a post before it is overwritten.
+	SavePostRevision   = "insert into post_revisions (id, post_id, revision, data, title, tagline, preview_image, view_time, published_at) select uuid_generate_v4(), posts.id, (select coalesce(max(revision), 0) + 1 from post_revisions where post_id = $1), posts.data, ap.title, ap.tagline, ap.preview_image, ap.view_time, coalesce(posts.updated_at, posts.created_at) from posts inner join abstract_post ap on posts.id = ap.post_id where posts.id = $2 and posts.state <> 'deleted' returning id"
+	FetchPostRevisions = "select id, post_id, revision, data, title, tagline, coalesce(preview_image, '') as preview_image, view_time, published_at, created_at from post_revisions where post_id = $1 order by revision desc"
+)
+
+func (repository postRevisionRepository) Save(ctx context.Context, txn helper.Transaction, postID uuid.UUID) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRevisionRepository").WithField("method", "Save")
+	logger.Infof("Inserting new revision for post id %v", postID)
+
+	var revisionID uuid.UUID
+	err := txn.GetContext(ctx, &revisionID, SavePostRevision, postID, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while inserting revision for post id %v. Error %v", postID, err)
+		return revisionID, err
+	}
+
+	logger.Infof("Successfully inserted revision %v for post id %v", revisionID, postID)
+	return revisionID, nil
+}
+
+func (repository postRevisionRepository) GetRevisions(ctx context.Context, postID uuid.UUID) ([]db.PostRevision, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRevisionRepository").WithField("method", "GetRevisions")
+	logger.Infof("Fetching revisions for post id %v", postID)
+
+	var revisions []db.PostRevision
+	err := repository.db.SelectContext(ctx, &revisions, FetchPostRevisions, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching revisions for post id %v. Error %v", postID, err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func NewPostRevisionRepository(db *sqlx.DB) PostRevisionRepository {
+	return postRevisionRepository{db: db}
+}
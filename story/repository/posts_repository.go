@@ -4,14 +4,17 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"post-api/helper"
+	"post-api/story/models"
 	"post-api/story/models/db"
 	"post-api/story/models/request"
 	"post-api/story/models/response"
 	"strings"
+	"time"
 
 	"github.com/inclusi-blog/gola-utils/logging"
 	"github.com/jmoiron/sqlx"
@@ -24,8 +27,12 @@ type PostsRepository interface {
 	AddInterests(ctx context.Context, transaction helper.Transaction, postID uuid.UUID, interests []uuid.UUID) error
 	FetchPost(ctx context.Context, postId, userId uuid.UUID) (response.Post, error)
 	GetPublishedPostByUser(ctx context.Context, request request.GetPublishedPostRequest) ([]response.PublishedPost, error)
-	Comment(ctx context.Context, comment request.Comment) error
-	FetchComments(ctx context.Context, commentsRequest request.FetchComments) ([]response.Comment, error)
+	Comment(ctx context.Context, tx helper.Transaction, comment request.Comment) (uuid.UUID, error)
+	FetchComments(ctx context.Context, commentsRequest request.FetchComments, maxDisplayDepth int) ([]response.Comment, error)
+	GetComment(ctx context.Context, commentID, postID uuid.UUID) (response.Comment, error)
+	FetchReplies(ctx context.Context, repliesRequest request.FetchReplies, maxDisplayDepth int) ([]response.Comment, error)
+	FetchThreadReplies(ctx context.Context, repliesRequest request.FetchReplies) ([]response.Comment, error)
+	DeleteComment(ctx context.Context, tx helper.Transaction, commentID, postID, userID uuid.UUID) error
 	BookmarkPost(ctx context.Context, postID, userID uuid.UUID) error
 	MarkAsViewed(ctx context.Context, postID, userID uuid.UUID) error
 	FetchReadLater(ctx context.Context, postRequest request.PostRequest) ([]response.PostView, error)
@@ -34,25 +41,72 @@ type PostsRepository interface {
 	RemovePostBookmark(ctx context.Context, postID, userID uuid.UUID) error
 	Delete(ctx context.Context, postID, userID uuid.UUID) error
 	GetHomeFeed(ctx context.Context, userID uuid.UUID, limit, offset int) ([]db.HomeFeedPost, error)
+	GetPostDraftID(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, error)
+	GetPostPublicationID(ctx context.Context, postID uuid.UUID) (*uuid.UUID, error)
+	GetVisibleAuthorID(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, error)
+	GetPublicationPostID(ctx context.Context, draftID, publicationID uuid.UUID) (uuid.UUID, error)
+	UpdatePost(ctx context.Context, txn helper.Transaction, postID uuid.UUID, data models.JSONString) error
+	RemoveInterests(ctx context.Context, txn helper.Transaction, postID uuid.UUID) error
+	SetState(ctx context.Context, postID, userID uuid.UUID, state string) error
+	Restore(ctx context.Context, postID, userID uuid.UUID, retention time.Duration) error
+	GetPostsByState(ctx context.Context, userID uuid.UUID, state string) ([]db.AuthorPost, error)
+	LockPins(ctx context.Context, txn helper.Transaction, userID uuid.UUID) error
+	GetPinnedPostIDs(ctx context.Context, txn helper.Transaction, userID uuid.UUID) ([]uuid.UUID, error)
+	GetLastPinnedPosition(ctx context.Context, txn helper.Transaction, userID uuid.UUID) (int, error)
+	ClearPins(ctx context.Context, txn helper.Transaction, userID uuid.UUID) error
+	Pin(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, position int) error
+	Unpin(ctx context.Context, postID, userID uuid.UUID) error
 }
 
+// The text and author of a deleted comment are blanked, leaving a tombstone in place of the comment
+// while it still has replies. Tombstones whose replies are all gone are not shown.
+const (
+	commentColumns = "c.id, case when c.deleted_at is null then c.data else '' end as data, c.post_id, case when c.deleted_at is null then u.username else '' end as username, c.created_at, c.parent_id, cardinality(c.ancestors) as depth, c.deleted_at is not null as is_deleted"
+	visibleComment = "not c.held_for_review and (c.deleted_at is null or exists (select 1 from comments x where x.parent_id = c.id and not x.held_for_review))"
+	// replyCount counts the direct replies of a comment, or every reply below it once its replies are
+	// shown as a single flat list at the display depth.
+	replyCount = "(select count(*) from comments r where (r.parent_id = c.id or (cardinality(c.ancestors) >= $4 and r.ancestors @> array[c.id])) and not r.held_for_review and (r.deleted_at is null or exists (select 1 from comments x where x.parent_id = r.id and not x.held_for_review)))"
+)
+
 type postRepository struct {
 	db *sqlx.DB
 }
 
 const (
-	PublishPost        = "insert into posts (id, data, author_id, draft_id) values (uuid_generate_v4(), $1, $2, $3) returning id"
+	PublishPost        = "insert into posts (id, data, author_id, draft_id, publication_id, is_under_publication) values (uuid_generate_v4(), $1, $2, $3, $4, $5) returning id"
 	LikePost           = "insert into likes(post_id, liked_by)values($1, $2)"
 	UnLike             = "delete from likes where post_id = $1 and liked_by = $2"
-	CommentPost        = "insert into comments (id, data, post_id, commented_by) values (uuid_generate_v4(), $1, $2, $3)"
+	CommentPost        = "insert into comments (id, data, post_id, commented_by, held_for_review) values (uuid_generate_v4(), $1, $2, $3, $4) returning id"
+	ReplyToComment     = "insert into comments (id, data, post_id, commented_by, held_for_review, parent_id, ancestors) select uuid_generate_v4(), $1, $2, $3, $4, parent.id, parent.ancestors || parent.id from comments parent where parent.id = $5 and parent.post_id = $2 and parent.deleted_at is null and not parent.held_for_review returning id"
 	AddInterests       = "insert into post_x_interests (post_id, interest_id)values %s"
-	GetPost            = "with post_interests as (select jsonb_agg(jsonb_build_object('id', interests.id, 'name', interests.name)) as interests, post_id from posts inner join post_x_interests on posts.id = post_x_interests.post_id inner join interests on post_x_interests.interest_id = interests.id where posts.id = $1 group by post_x_interests.post_id) select posts.id, posts.data, count(distinct l.liked_by) as likes_count, count(distinct c.id) as comments_count, post_interests.interests, u.id as author_id, u.username as author_name, ap.preview_image as preview_image, posts.created_at as published_at, ap.url, case when $2 in (l.post_id) then true else false end as is_viewer_liked, case when $3 = u.id then true else false end as is_viewer_is_author from posts inner join post_interests on posts.id = post_interests.post_id inner join post_x_interests on posts.id = post_x_interests.post_id inner join interests on post_x_interests.interest_id = interests.id inner join users u on u.id = posts.author_id inner join abstract_post ap on posts.id = ap.post_id left join likes l on l.post_id = posts.id left join comments c on c.post_id = posts.id where posts.id = $4 group by posts.id, u.id, ap.preview_image, l.post_id, ap.url, post_interests.interests"
-	GetPublishedPosts  = "select posts.id, ap.title, ap.tagline, posts.created_at, (select json_agg(json_build_object('id', interest_id, 'name', i.name)) from post_x_interests inner join interests i on post_x_interests.interest_id = i.id where post_x_interests.post_id = posts.id) as interests, count(l) as likes_count, username, preview_image, ap.url from posts inner join users on posts.author_id = users.id inner join abstract_post ap on posts.id = ap.post_id left join likes l on posts.id = l.post_id where users.id = $1 group by posts.id, posts.created_at, ap.title, ap.tagline, posts.id, ap.url, preview_image, username order by posts.created_at limit $2 offset $3"
-	GetComments        = "select comments.id, comments.data, comments.post_id, u.username, comments.created_at from comments inner join users u on u.id = comments.commented_by where post_id = $1 order by comments.created_at desc limit $2 offset $3"
+	GetPost            = "with post_interests as (select jsonb_agg(jsonb_build_object('id', interests.id, 'name', interests.name)) as interests, post_id from posts inner join post_x_interests on posts.id = post_x_interests.post_id inner join interests on post_x_interests.interest_id = interests.id where posts.id = $1 group by post_x_interests.post_id) select posts.id, posts.data, count(distinct l.liked_by) as likes_count, count(distinct c.id) as comments_count, post_interests.interests, u.id as author_id, u.username as author_name, ap.preview_image as preview_image, posts.created_at as published_at, ap.url, ap.table_of_contents, posts.updated_at as edited_at, case when $2 in (l.post_id) then true else false end as is_viewer_liked, case when $3 = u.id then true else false end as is_viewer_is_author from posts inner join post_interests on posts.id = post_interests.post_id inner join post_x_interests on posts.id = post_x_interests.post_id inner join interests on post_x_interests.interest_id = interests.id inner join users u on u.id = posts.author_id inner join abstract_post ap on posts.id = ap.post_id left join likes l on l.post_id = posts.id left join comments c on c.post_id = posts.id and not c.held_for_review and c.deleted_at is null where posts.id = $4 and (posts.state in ('published', 'unlisted') or (posts.state = 'archived' and posts.author_id = $5)) group by posts.id, u.id, ap.preview_image, l.post_id, ap.url, ap.table_of_contents, post_interests.interests"
+	GetPublishedPosts  = "select posts.id, ap.title, ap.tagline, posts.created_at, (select json_agg(json_build_object('id', interest_id, 'name', i.name)) from post_x_interests inner join interests i on post_x_interests.interest_id = i.id where post_x_interests.post_id = posts.id) as interests, count(l) as likes_count, username, preview_image, ap.url, posts.pinned_position is not null as is_pinned from posts inner join users on posts.author_id = users.id inner join abstract_post ap on posts.id = ap.post_id left join likes l on posts.id = l.post_id where users.id = $1 and posts.state = 'published' group by posts.id, posts.created_at, ap.title, ap.tagline, posts.id, ap.url, preview_image, username order by posts.pinned_position nulls last, posts.created_at limit $2 offset $3"
+	GetComments        = "select " + commentColumns + ", " + replyCount + " as reply_count from comments c inner join users u on u.id = c.commented_by where c.post_id = $1 and c.parent_id is null and " + visibleComment + " order by c.created_at desc limit $2 offset $3"
+	GetComment         = "select c.id, c.post_id, c.parent_id, cardinality(c.ancestors) as depth, c.deleted_at is not null as is_deleted from comments c where c.id = $1 and c.post_id = $2 and not c.held_for_review"
+	GetReplies         = "select " + commentColumns + ", " + replyCount + " as reply_count from comments c inner join users u on u.id = c.commented_by where c.parent_id = $1 and " + visibleComment + " order by c.created_at limit $2 offset $3"
+	GetThreadReplies   = "select " + commentColumns + ", case when p.deleted_at is null then pu.username end as reply_to from comments c inner join users u on u.id = c.commented_by inner join comments p on p.id = c.parent_id inner join users pu on pu.id = p.commented_by where c.ancestors @> array[$1]::uuid[] and " + visibleComment + " order by c.created_at limit $2 offset $3"
+	DeleteLeafComment  = "delete from comments c where c.id = $1 and c.post_id = $2 and c.commented_by = $3 and not exists (select 1 from comments r where r.parent_id = c.id) returning c.parent_id"
+	CollapseTombstone  = "delete from comments c where c.id = $1 and c.deleted_at is not null and not exists (select 1 from comments r where r.parent_id = c.id) returning c.parent_id"
+	TombstoneComment   = "update comments set data = '', deleted_at = current_timestamp where id = $1 and post_id = $2 and commented_by = $3 and deleted_at is null"
 	BookmarkPost       = "insert into saved_posts (post_id, user_id) values ($1, $2)"
 	RemovePostBookmark = "delete from saved_posts where post_id = $1 and user_id = $2"
 	MarkAsViewed       = "insert into post_views (post_id, user_id) values ($1, $2)"
-	Delete             = "update posts set deleted_at = current_timestamp where id = $1 and author_id = $2"
+	Delete             = "update posts set state = 'deleted', state_before_delete = state, pinned_position = null, deleted_at = current_timestamp where id = $1 and author_id = $2 and state <> 'deleted'"
+	FetchPostDraftID   = "select draft_id from posts where id = $1 and author_id = $2 and state <> 'deleted'"
+	FetchVisibleAuthor = "select author_id from posts where id = $1 and (state in ('published', 'unlisted') or (state = 'archived' and author_id = $2))"
+	FetchPublicationID = "select publication_id from posts where id = $1"
+	FetchPostOfDraft   = "select id from posts where draft_id = $1 and publication_id = $2 and state <> 'deleted'"
+	UpdatePost         = "update posts set data = $1, updated_at = current_timestamp where id = $2 and state <> 'deleted'"
+	RemoveInterests    = "delete from post_x_interests where post_id = $1"
+	SetPostState       = "update posts set state = $1, pinned_position = case when $2 = 'published' then pinned_position end where id = $3 and author_id = $4 and state <> 'deleted'"
+	RestorePost        = "update posts set state = coalesce(state_before_delete, 'published'), state_before_delete = null, deleted_at = null where id = $1 and author_id = $2 and state = 'deleted' and deleted_at > current_timestamp - $3 * interval '1 second'"
+	LockAuthorPosts    = "select id from posts where author_id = $1 for update"
+	FetchPinnedPostIDs = "select id from posts where author_id = $1 and pinned_position is not null and state = 'published' order by pinned_position"
+	FetchLastPinned    = "select coalesce(max(pinned_position), 0) from posts where author_id = $1"
+	ClearPins          = "update posts set pinned_position = null where author_id = $1 and pinned_position is not null"
+	PinPost            = "update posts set pinned_position = $1 where id = $2 and author_id = $3 and state = 'published'"
+	UnpinPost          = "update posts set pinned_position = null where id = $1 and author_id = $2 and pinned_position is not null"
+	FetchPostsByState  = "select posts.id, ap.title, ap.url, posts.state, posts.created_at, posts.deleted_at from posts inner join abstract_post ap on posts.id = ap.post_id where posts.author_id = $1 and posts.state = $2 order by coalesce(posts.deleted_at, posts.created_at) desc"
 	GetHomeFeed        = `WITH post_interests AS (
     SELECT
         px.post_id,
@@ -79,7 +133,7 @@ const (
              COUNT(*) AS comment_count
          FROM
              posts p
-                 LEFT JOIN comments c ON p.id = c.post_id
+                 LEFT JOIN comments c ON p.id = c.post_id AND c.deleted_at IS NULL AND NOT c.held_for_review
          GROUP BY
              p.id
      ),
@@ -131,6 +185,7 @@ FROM
         JOIN post_interests pi ON ps.post_id = pi.post_id
         LEFT JOIN post_author pa ON ps.post_id = pa.post_id
         LEFT JOIN user_likes ul ON ps.post_id = ul.post_id
+        JOIN posts ON ps.post_id = posts.id AND posts.state = 'published'
 WHERE
     ap.deleted_at IS NULL
   OR (pi.interest_ids @> ARRAY(SELECT interest_id FROM user_interests WHERE user_id = $2))
@@ -143,7 +198,7 @@ func (repository postRepository) CreatePost(ctx context.Context, tx helper.Trans
 	logger.Infof("Publishing the post in posts table for post draft id %v", post.DraftID)
 
 	var postID uuid.UUID
-	err := tx.GetContext(ctx, &postID, PublishPost, post.PostData, post.UserID, post.DraftID)
+	err := tx.GetContext(ctx, &postID, PublishPost, post.PostData, post.UserID, post.DraftID, post.PublicationID, post.PublicationID != nil)
 	if err != nil {
 		logger.Errorf("Error occurred while publishing user post in posts table %v", err)
 		return postID, err
@@ -219,7 +274,7 @@ func (repository postRepository) FetchPost(ctx context.Context, postId, userId u
 
 	logger.Infof("fetching post to view for user %v of post id %v", userId, postId)
 	var post response.Post
-	err := repository.db.GetContext(ctx, &post, GetPost, postId, postId, userId, postId)
+	err := repository.db.GetContext(ctx, &post, GetPost, postId, postId, userId, postId, userId)
 
 	if err != nil {
 		logger.Errorf("Error occurred while fetching post to view for user %v of post id %v, Error %v", userId, postId, err)
@@ -243,43 +298,134 @@ func (repository postRepository) GetPublishedPostByUser(ctx context.Context, req
 	return posts, nil
 }
 
-func (repository postRepository) Comment(ctx context.Context, comment request.Comment) error {
+func (repository postRepository) Comment(ctx context.Context, tx helper.Transaction, comment request.Comment) (uuid.UUID, error) {
 	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "Comment")
 	logger.Infof("inserting comment for post %v by user id %v ", comment.PostID, comment.CommentedBy)
 
-	result, err := repository.db.ExecContext(ctx, CommentPost, comment.Data, comment.PostID, comment.CommentedBy)
-
+	var commentID uuid.UUID
+	var err error
+	if comment.ParentID != nil {
+		err = tx.QueryRowContext(ctx, ReplyToComment, comment.Data, comment.PostID, comment.CommentedBy, comment.HeldForReview, *comment.ParentID).Scan(&commentID)
+	} else {
+		err = tx.QueryRowContext(ctx, CommentPost, comment.Data, comment.PostID, comment.CommentedBy, comment.HeldForReview).Scan(&commentID)
+	}
 	if err != nil {
 		logger.Errorf("unable to comment %v", err)
-		return err
+		return uuid.Nil, err
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	return commentID, nil
+}
+
+func (repository postRepository) FetchComments(ctx context.Context, commentsRequest request.FetchComments, maxDisplayDepth int) ([]response.Comment, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "Comment")
+	logger.Infof("fetching comment for post id %v ", commentsRequest.PostID)
+
+	var comments []response.Comment
+	err := repository.db.SelectContext(ctx, &comments, GetComments, commentsRequest.PostID, commentsRequest.Limit, commentsRequest.Start, maxDisplayDepth)
+	if err != nil {
+		logger.Errorf("unable to fetch comments %v", err)
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (repository postRepository) GetComment(ctx context.Context, commentID, postID uuid.UUID) (response.Comment, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "GetComment")
+
+	var comment response.Comment
+	err := repository.db.GetContext(ctx, &comment, GetComment, commentID, postID)
+	if err != nil {
+		logger.Errorf("unable to fetch comment %v of post %v. Error %v", commentID, postID, err)
+		return response.Comment{}, err
+	}
+
+	return comment, nil
+}
+
+func (repository postRepository) FetchReplies(ctx context.Context, repliesRequest request.FetchReplies, maxDisplayDepth int) ([]response.Comment, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "FetchReplies")
+	logger.Infof("fetching replies of comment %v", repliesRequest.CommentID)
+
+	var replies []response.Comment
+	err := repository.db.SelectContext(ctx, &replies, GetReplies, repliesRequest.CommentID, repliesRequest.Limit, repliesRequest.Start, maxDisplayDepth)
 	if err != nil {
-		logger.Errorf("unable to fetch affected rows %v", err)
+		logger.Errorf("unable to fetch replies of comment %v. Error %v", repliesRequest.CommentID, err)
+		return nil, err
+	}
+
+	return replies, nil
+}
+
+// FetchThreadReplies fetches every reply below the comment, however deep, in the order they were made.
+func (repository postRepository) FetchThreadReplies(ctx context.Context, repliesRequest request.FetchReplies) ([]response.Comment, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "FetchThreadReplies")
+	logger.Infof("fetching thread of comment %v", repliesRequest.CommentID)
+
+	var replies []response.Comment
+	err := repository.db.SelectContext(ctx, &replies, GetThreadReplies, repliesRequest.CommentID, repliesRequest.Limit, repliesRequest.Start)
+	if err != nil {
+		logger.Errorf("unable to fetch thread of comment %v. Error %v", repliesRequest.CommentID, err)
+		return nil, err
+	}
+
+	return replies, nil
+}
+
+// DeleteComment removes a comment without replies, and leaves a tombstone in place of a comment that has
+// replies so the thread below it survives. Removing a reply also removes the tombstones above it that are
+// left without any replies.
+func (repository postRepository) DeleteComment(ctx context.Context, tx helper.Transaction, commentID, postID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "DeleteComment")
+
+	var parentID *uuid.UUID
+	err := tx.GetContext(ctx, &parentID, DeleteLeafComment, commentID, postID, userID)
+	if err == sql.ErrNoRows {
+		return repository.tombstoneComment(ctx, tx, commentID, postID, userID)
+	}
+	if err != nil {
+		logger.Errorf("unable to delete comment %v. Error %v", commentID, err)
 		return err
 	}
 
-	if rowsAffected == 0 {
-		logger.Error("user never liked the post")
-		return errors.New("unable to comment")
+	for parentID != nil {
+		tombstoneID := *parentID
+		parentID = nil
+		err = tx.GetContext(ctx, &parentID, CollapseTombstone, tombstoneID)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			logger.Errorf("unable to remove tombstone %v. Error %v", tombstoneID, err)
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (repository postRepository) FetchComments(ctx context.Context, commentsRequest request.FetchComments) ([]response.Comment, error) {
-	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "Comment")
-	logger.Infof("fetching comment for post id %v ", commentsRequest.PostID)
+func (repository postRepository) tombstoneComment(ctx context.Context, tx helper.Transaction, commentID, postID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "tombstoneComment")
 
-	var comments []response.Comment
-	err := repository.db.SelectContext(ctx, &comments, GetComments, commentsRequest.PostID, commentsRequest.Limit, commentsRequest.Start)
+	result, err := tx.ExecContext(ctx, TombstoneComment, commentID, postID, userID)
 	if err != nil {
-		logger.Errorf("unable to fetch comments %v", err)
-		return nil, err
+		logger.Errorf("unable to tombstone comment %v. Error %v", commentID, err)
+		return err
 	}
 
-	return comments, nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no comment %v by user %v on post %v", commentID, userID, postID)
+		return sql.ErrNoRows
+	}
+
+	return nil
 }
 
 func (repository postRepository) BookmarkPost(ctx context.Context, postID, userID uuid.UUID) error {
@@ -357,20 +503,199 @@ func (repository postRepository) FetchPostsByInterests(ctx context.Context, inte
 	return posts, nil
 }
 
+// Delete moves the post to the deleted state, remembering the state it was in for a restore.
 func (repository postRepository) Delete(ctx context.Context, postID, userID uuid.UUID) error {
 	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "Delete")
 	logger.Infof("deleting post for post id %v and author id %v", postID, userID)
 
-	_, err := repository.db.ExecContext(ctx, Delete, postID, userID)
+	result, err := repository.db.ExecContext(ctx, Delete, postID, userID)
 	if err != nil {
 		logger.Errorf("unable to delete post for post id %v. Error %v", postID, err)
 		return err
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no post %v of author %v to delete", postID, userID)
+		return sql.ErrNoRows
+	}
+
 	logger.Infof("successfully deleted post for post id %v", postID)
 	return nil
 }
 
+// SetState moves a post of the author that is not deleted to the state.
+func (repository postRepository) SetState(ctx context.Context, postID, userID uuid.UUID, state string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "SetState")
+	logger.Infof("moving post %v to state %v", postID, state)
+
+	result, err := repository.db.ExecContext(ctx, SetPostState, state, state, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to move post %v to state %v. Error %v", postID, state, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no post %v of author %v to move to state %v", postID, userID, state)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Restore moves a deleted post back to the state it was deleted from, as long as its retention has not
+// run out.
+func (repository postRepository) Restore(ctx context.Context, postID, userID uuid.UUID, retention time.Duration) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "Restore")
+	logger.Infof("restoring deleted post %v", postID)
+
+	result, err := repository.db.ExecContext(ctx, RestorePost, postID, userID, retention.Seconds())
+	if err != nil {
+		logger.Errorf("unable to restore post %v. Error %v", postID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no restorable post %v found for author %v", postID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository postRepository) GetPostsByState(ctx context.Context, userID uuid.UUID, state string) ([]db.AuthorPost, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "GetPostsByState")
+
+	var posts []db.AuthorPost
+	err := repository.db.SelectContext(ctx, &posts, FetchPostsByState, userID, state)
+	if err != nil {
+		logger.Errorf("unable to fetch %v posts of author %v. Error %v", state, userID, err)
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// LockPins locks every post of the author until the transaction ends, pinned or not, so concurrent pins
+// of the author wait for each other even when nothing is pinned yet.
+func (repository postRepository) LockPins(ctx context.Context, txn helper.Transaction, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "LockPins")
+
+	_, err := txn.ExecContext(ctx, LockAuthorPosts, userID)
+	if err != nil {
+		logger.Errorf("unable to lock posts of author %v. Error %v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetPinnedPostIDs returns the pinned posts of the author in the order they are shown on the profile.
+func (repository postRepository) GetPinnedPostIDs(ctx context.Context, txn helper.Transaction, userID uuid.UUID) ([]uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "GetPinnedPostIDs")
+
+	var postIDs []uuid.UUID
+	err := txn.SelectContext(ctx, &postIDs, FetchPinnedPostIDs, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch pinned posts of author %v. Error %v", userID, err)
+		return nil, err
+	}
+
+	return postIDs, nil
+}
+
+// GetLastPinnedPosition returns the highest position taken by a pinned post of the author, counting posts
+// that are pinned but no longer published, or 0 when nothing is pinned.
+func (repository postRepository) GetLastPinnedPosition(ctx context.Context, txn helper.Transaction, userID uuid.UUID) (int, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "GetLastPinnedPosition")
+
+	var position int
+	err := txn.GetContext(ctx, &position, FetchLastPinned, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch last pinned position of author %v. Error %v", userID, err)
+		return 0, err
+	}
+
+	return position, nil
+}
+
+func (repository postRepository) ClearPins(ctx context.Context, txn helper.Transaction, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "ClearPins")
+
+	_, err := txn.ExecContext(ctx, ClearPins, userID)
+	if err != nil {
+		logger.Errorf("unable to clear pinned posts of author %v. Error %v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// Pin pins a published post of the author at the position. Posts that are not published return
+// sql.ErrNoRows.
+func (repository postRepository) Pin(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, position int) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "Pin")
+
+	result, err := txn.ExecContext(ctx, PinPost, position, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to pin post %v. Error %v", postID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no published post %v of author %v to pin", postID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository postRepository) Unpin(ctx context.Context, postID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostRepository").WithField("method", "Unpin")
+
+	result, err := repository.db.ExecContext(ctx, UnpinPost, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to unpin post %v. Error %v", postID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to get affected rows %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no pinned post %v of author %v", postID, userID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 func (repository postRepository) GetHomeFeed(ctx context.Context, userID uuid.UUID, limit, offset int) ([]db.HomeFeedPost, error) {
 	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "GetHomeFeed")
 	var posts []db.HomeFeedPost
@@ -383,6 +708,101 @@ func (repository postRepository) GetHomeFeed(ctx context.Context, userID uuid.UU
 	return posts, nil
 }
 
+func (repository postRepository) GetPostDraftID(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "GetPostDraftID")
+	logger.Infof("fetching source draft for post id %v and author id %v", postID, userID)
+
+	var draftID uuid.UUID
+	err := repository.db.GetContext(ctx, &draftID, FetchPostDraftID, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch source draft for post id %v. Error %v", postID, err)
+		return draftID, err
+	}
+
+	return draftID, nil
+}
+
+// GetPostPublicationID returns the publication the post was published under, or nil for a post of the
+// author alone.
+func (repository postRepository) GetPostPublicationID(ctx context.Context, postID uuid.UUID) (*uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "GetPostPublicationID")
+
+	var publicationID *uuid.UUID
+	err := repository.db.GetContext(ctx, &publicationID, FetchPublicationID, postID)
+	if err != nil {
+		logger.Errorf("unable to fetch publication of post id %v. Error %v", postID, err)
+		return nil, err
+	}
+
+	return publicationID, nil
+}
+
+// GetVisibleAuthorID returns the author of the post when the user can see the post, following the same
+// rule as FetchPost.
+func (repository postRepository) GetVisibleAuthorID(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "GetVisibleAuthorID")
+
+	var authorID uuid.UUID
+	err := repository.db.GetContext(ctx, &authorID, FetchVisibleAuthor, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch author of post id %v. Error %v", postID, err)
+		return authorID, err
+	}
+
+	return authorID, nil
+}
+
+// GetPublicationPostID returns the post published under the publication from the draft.
+func (repository postRepository) GetPublicationPostID(ctx context.Context, draftID, publicationID uuid.UUID) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "GetPublicationPostID")
+
+	var postID uuid.UUID
+	err := repository.db.GetContext(ctx, &postID, FetchPostOfDraft, draftID, publicationID)
+	if err != nil {
+		logger.Errorf("unable to fetch post of draft %v in publication %v. Error %v", draftID, publicationID, err)
+		return postID, err
+	}
+
+	return postID, nil
+}
+
+func (repository postRepository) UpdatePost(ctx context.Context, txn helper.Transaction, postID uuid.UUID, data models.JSONString) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "UpdatePost")
+	logger.Infof("updating published data for post id %v", postID)
+
+	result, err := txn.ExecContext(ctx, UpdatePost, data, postID)
+	if err != nil {
+		logger.Errorf("unable to update post for post id %v. Error %v", postID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no post found for post id %v", postID)
+		return sql.ErrNoRows
+	}
+
+	logger.Infof("successfully updated post for post id %v", postID)
+	return nil
+}
+
+func (repository postRepository) RemoveInterests(ctx context.Context, txn helper.Transaction, postID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostsRepository").WithField("method", "RemoveInterests")
+
+	_, err := txn.ExecContext(ctx, RemoveInterests, postID)
+	if err != nil {
+		logger.Errorf("unable to remove interests for post id %v. Error %v", postID, err)
+		return err
+	}
+
+	return nil
+}
+
 func NewPostsRepository(db *sqlx.DB) PostsRepository {
 	return postRepository{db: db}
 }
@@ -82,7 +82,8 @@ func (suite *PostsRepositoryIntegrationTest) createPost() (uuid.UUID, uuid.UUID,
 		Data:   models.JSONString{JSONText: types.JSONText(test_helper.LargeTextData)},
 		UserID: userID,
 	}
-	draftUUID, err := suite.draftHelper.CreateDraft(suite.goContext, draft)
+	transaction := suite.transaction.NewTransaction()
+	draftUUID, err := suite.draftHelper.CreateDraft(suite.goContext, transaction, draft)
 	suite.Nil(err)
 	post := db.PublishPost{
 		UserID: userID,
@@ -91,7 +92,6 @@ func (suite *PostsRepositoryIntegrationTest) createPost() (uuid.UUID, uuid.UUID,
 		},
 		DraftID: draftUUID,
 	}
-	transaction := suite.transaction.NewTransaction()
 	postUUID, err := suite.postsRepository.CreatePost(suite.goContext, transaction, post)
 	suite.Nil(err)
 	err = transaction.Commit()
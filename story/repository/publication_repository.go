@@ -0,0 +1,269 @@
+package repository
+
+//go:generate mockgen -source=publication_repository.go -destination=./../mocks/mock_publication_repository.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"post-api/story/models/db"
+	"post-api/story/models/response"
+)
+
+type PublicationRepository interface {
+	Create(ctx context.Context, publication db.Publication) (uuid.UUID, error)
+	GetPublication(ctx context.Context, publicationID uuid.UUID) (db.Publication, error)
+	GetMember(ctx context.Context, publicationID, userID uuid.UUID) (db.PublicationMember, error)
+	GetMembers(ctx context.Context, publicationID uuid.UUID) ([]db.PublicationMember, error)
+	InviteMember(ctx context.Context, member db.PublicationMember) error
+	AcceptInvite(ctx context.Context, publicationID, userID uuid.UUID) error
+	HasPendingSubmission(ctx context.Context, draftID uuid.UUID) (bool, error)
+	CreateSubmission(ctx context.Context, submission db.PublicationSubmission) (uuid.UUID, error)
+	GetSubmission(ctx context.Context, publicationID, submissionID uuid.UUID) (db.PublicationSubmission, error)
+	GetSubmissions(ctx context.Context, publicationID uuid.UUID, status string) ([]db.PublicationSubmission, error)
+	GetSubmissionDraft(ctx context.Context, publicationID, submissionID uuid.UUID) (db.Draft, error)
+	GetSubmissionsByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.PublicationSubmission, error)
+	UpdateSubmissionStatus(ctx context.Context, submissionID uuid.UUID, fromStatus, toStatus string, reviewerID uuid.UUID, feedback *string) error
+	GetPublicationPosts(ctx context.Context, publicationID uuid.UUID, limit, offset int) ([]response.PublishedPost, error)
+}
+
+type publicationRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	// CreatePublication inserts the publication and its owner membership in one statement.
+	CreatePublication        = "with publication as (insert into publications (id, name, description, owner_id) values (uuid_generate_v4(), $1, $2, $3) returning id, owner_id) insert into publication_members (publication_id, user_id, role, status) select id, owner_id, 'owner', 'active' from publication returning publication_id"
+	FetchPublication         = "select publications.id, publications.name, publications.description, publications.owner_id, publications.created_at, (select count(*) from posts where posts.publication_id = publications.id and posts.state = 'published') as posts_count, (select count(*) from publication_members pm where pm.publication_id = publications.id and pm.status = 'active') as members_count from publications where publications.id = $1 and publications.deleted_at is null"
+	FetchPublicationMember   = "select pm.publication_id, pm.user_id, u.username, pm.role, pm.status, pm.invited_by from publication_members pm inner join users u on pm.user_id = u.id where pm.publication_id = $1 and pm.user_id = $2"
+	FetchPublicationMembers  = "select pm.publication_id, pm.user_id, u.username, pm.role, pm.status, pm.invited_by from publication_members pm inner join users u on pm.user_id = u.id where pm.publication_id = $1 and pm.status = 'active' order by pm.created_at"
+	InvitePublicationMember  = "insert into publication_members (publication_id, user_id, role, status, invited_by) values ($1, $2, $3, 'invited', $4)"
+	AcceptPublicationInvite  = "update publication_members set status = 'active', updated_at = current_timestamp where publication_id = $1 and user_id = $2 and status = 'invited'"
+	HasPendingSubmission     = "select exists(select 1 from publication_submissions where draft_id = $1 and status = 'pending')"
+	CreateSubmission         = "insert into publication_submissions (id, publication_id, draft_id, draft_version, author_id, status) select uuid_generate_v4(), $1, drafts.id, drafts.version, drafts.user_id, 'pending' from drafts where drafts.id = $2 and drafts.user_id = $3 and (drafts.is_published is false or exists (select 1 from posts where posts.draft_id = drafts.id and posts.publication_id = $1 and posts.state <> 'deleted')) and drafts.deleted_at is null returning id"
+	FetchSubmission          = "select id, publication_id, draft_id, draft_version, author_id, status, feedback, reviewed_by, created_at, updated_at from publication_submissions where publication_id = $1 and id = $2"
+	FetchSubmissions         = "select id, publication_id, draft_id, draft_version, author_id, status, feedback, reviewed_by, created_at, updated_at from publication_submissions where publication_id = $1 and status = $2 order by created_at"
+	FetchSubmissionsByAuthor = "select id, publication_id, draft_id, draft_version, author_id, status, feedback, reviewed_by, created_at, updated_at from publication_submissions where author_id = $1 order by created_at desc"
+	FetchSubmissionDraft     = "select drafts.id, drafts.user_id, drafts.data, drafts.preview_image, drafts.tagline, drafts.interests, drafts.version, drafts.is_published from publication_submissions inner join drafts on publication_submissions.draft_id = drafts.id where publication_submissions.publication_id = $1 and publication_submissions.id = $2 and drafts.deleted_at is null"
+	UpdateSubmissionStatus   = "update publication_submissions set status = $1, reviewed_by = $2, feedback = $3, updated_at = current_timestamp where id = $4 and status = $5"
+	FetchPublicationPosts    = "select posts.id, ap.title, ap.tagline, posts.created_at, (select json_agg(json_build_object('id', interest_id, 'name', i.name)) from post_x_interests inner join interests i on post_x_interests.interest_id = i.id where post_x_interests.post_id = posts.id) as interests, count(l) as likes_count, username, preview_image, ap.url from posts inner join users on posts.author_id = users.id inner join abstract_post ap on posts.id = ap.post_id left join likes l on posts.id = l.post_id where posts.publication_id = $1 and posts.state = 'published' group by posts.id, posts.created_at, ap.title, ap.tagline, ap.url, preview_image, username order by posts.created_at desc limit $2 offset $3"
+)
+
+func (repository publicationRepository) Create(ctx context.Context, publication db.Publication) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "Create")
+	logger.Infof("Creating publication for owner %v", publication.OwnerID)
+
+	var publicationID uuid.UUID
+	err := repository.db.GetContext(ctx, &publicationID, CreatePublication, publication.Name, publication.Description, publication.OwnerID)
+	if err != nil {
+		logger.Errorf("Error occurred while creating publication for owner %v. Error %v", publication.OwnerID, err)
+		return publicationID, err
+	}
+
+	logger.Infof("Successfully created publication %v", publicationID)
+	return publicationID, nil
+}
+
+func (repository publicationRepository) GetPublication(ctx context.Context, publicationID uuid.UUID) (db.Publication, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetPublication")
+
+	var publication db.Publication
+	err := repository.db.GetContext(ctx, &publication, FetchPublication, publicationID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching publication %v. Error %v", publicationID, err)
+		return db.Publication{}, err
+	}
+
+	return publication, nil
+}
+
+func (repository publicationRepository) GetMember(ctx context.Context, publicationID, userID uuid.UUID) (db.PublicationMember, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetMember")
+
+	var member db.PublicationMember
+	err := repository.db.GetContext(ctx, &member, FetchPublicationMember, publicationID, userID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			logger.Errorf("Error occurred while fetching member %v of publication %v. Error %v", userID, publicationID, err)
+		}
+		return db.PublicationMember{}, err
+	}
+
+	return member, nil
+}
+
+func (repository publicationRepository) GetMembers(ctx context.Context, publicationID uuid.UUID) ([]db.PublicationMember, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetMembers")
+
+	var members []db.PublicationMember
+	err := repository.db.SelectContext(ctx, &members, FetchPublicationMembers, publicationID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching members of publication %v. Error %v", publicationID, err)
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (repository publicationRepository) InviteMember(ctx context.Context, member db.PublicationMember) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "InviteMember")
+	logger.Infof("Inviting user %v to publication %v as %v", member.UserID, member.PublicationID, member.Role)
+
+	_, err := repository.db.ExecContext(ctx, InvitePublicationMember, member.PublicationID, member.UserID, member.Role, member.InvitedBy)
+	if err != nil {
+		logger.Errorf("Error occurred while inviting user %v to publication %v. Error %v", member.UserID, member.PublicationID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (repository publicationRepository) AcceptInvite(ctx context.Context, publicationID, userID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "AcceptInvite")
+
+	result, err := repository.db.ExecContext(ctx, AcceptPublicationInvite, publicationID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while accepting invite of user %v to publication %v. Error %v", userID, publicationID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no pending invite for user %v to publication %v", userID, publicationID)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository publicationRepository) HasPendingSubmission(ctx context.Context, draftID uuid.UUID) (bool, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "HasPendingSubmission")
+
+	var pending bool
+	err := repository.db.GetContext(ctx, &pending, HasPendingSubmission, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while checking submissions of draft %v. Error %v", draftID, err)
+		return false, err
+	}
+
+	return pending, nil
+}
+
+// CreateSubmission queues an unpublished draft, or the source draft of a post already in the publication
+// when an edit of the post is submitted.
+func (repository publicationRepository) CreateSubmission(ctx context.Context, submission db.PublicationSubmission) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "CreateSubmission")
+	logger.Infof("Submitting draft %v to publication %v", submission.DraftID, submission.PublicationID)
+
+	var submissionID uuid.UUID
+	err := repository.db.GetContext(ctx, &submissionID, CreateSubmission, submission.PublicationID, submission.DraftID, submission.AuthorID)
+	if err != nil {
+		logger.Errorf("Error occurred while submitting draft %v to publication %v. Error %v", submission.DraftID, submission.PublicationID, err)
+		return submissionID, err
+	}
+
+	return submissionID, nil
+}
+
+func (repository publicationRepository) GetSubmission(ctx context.Context, publicationID, submissionID uuid.UUID) (db.PublicationSubmission, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetSubmission")
+
+	var submission db.PublicationSubmission
+	err := repository.db.GetContext(ctx, &submission, FetchSubmission, publicationID, submissionID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching submission %v. Error %v", submissionID, err)
+		return db.PublicationSubmission{}, err
+	}
+
+	return submission, nil
+}
+
+func (repository publicationRepository) GetSubmissions(ctx context.Context, publicationID uuid.UUID, status string) ([]db.PublicationSubmission, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetSubmissions")
+
+	var submissions []db.PublicationSubmission
+	err := repository.db.SelectContext(ctx, &submissions, FetchSubmissions, publicationID, status)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching submissions of publication %v. Error %v", publicationID, err)
+		return nil, err
+	}
+
+	return submissions, nil
+}
+
+// GetSubmissionDraft returns the draft of the submission as it currently is.
+func (repository publicationRepository) GetSubmissionDraft(ctx context.Context, publicationID, submissionID uuid.UUID) (db.Draft, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetSubmissionDraft")
+
+	var draft db.Draft
+	err := repository.db.GetContext(ctx, &draft, FetchSubmissionDraft, publicationID, submissionID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching draft of submission %v. Error %v", submissionID, err)
+		return db.Draft{}, err
+	}
+
+	return draft, nil
+}
+
+func (repository publicationRepository) GetSubmissionsByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.PublicationSubmission, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetSubmissionsByAuthor")
+
+	var submissions []db.PublicationSubmission
+	err := repository.db.SelectContext(ctx, &submissions, FetchSubmissionsByAuthor, authorID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching submissions of author %v. Error %v", authorID, err)
+		return nil, err
+	}
+
+	return submissions, nil
+}
+
+// UpdateSubmissionStatus moves a submission from fromStatus to toStatus. It returns sql.ErrNoRows when
+// the submission is no longer in fromStatus, so two editors cannot review the same submission.
+func (repository publicationRepository) UpdateSubmissionStatus(ctx context.Context, submissionID uuid.UUID, fromStatus, toStatus string, reviewerID uuid.UUID, feedback *string) error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "UpdateSubmissionStatus")
+	logger.Infof("Moving submission %v from %v to %v", submissionID, fromStatus, toStatus)
+
+	result, err := repository.db.ExecContext(ctx, UpdateSubmissionStatus, toStatus, reviewerID, feedback, submissionID, fromStatus)
+	if err != nil {
+		logger.Errorf("Error occurred while updating submission %v. Error %v", submissionID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("submission %v is not %v", submissionID, fromStatus)
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (repository publicationRepository) GetPublicationPosts(ctx context.Context, publicationID uuid.UUID, limit, offset int) ([]response.PublishedPost, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationRepository").WithField("method", "GetPublicationPosts")
+
+	var posts []response.PublishedPost
+	err := repository.db.SelectContext(ctx, &posts, FetchPublicationPosts, publicationID, limit, offset)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching posts of publication %v. Error %v", publicationID, err)
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func NewPublicationRepository(db *sqlx.DB) PublicationRepository {
+	return publicationRepository{db: db}
+}
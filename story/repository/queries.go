@@ -29,8 +29,8 @@ const FetchSavedPosts = "with post_interests as (select jsonb_agg(jsonb_build_ob
 	"inner join users u on u.id = posts.author_id " +
 	"inner join abstract_post ap on posts.id = ap.post_id " +
 	"left join likes l on l.post_id = posts.id " +
-	"left join comments c on c.post_id = posts.id " +
-	"where posts.deleted_at is null group by posts.id, ap.url, u.id, ap.preview_image, l.liked_by, post_interests.interests, ap.title, ap.tagline limit $5 offset $6"
+	"left join comments c on c.post_id = posts.id and not c.held_for_review and c.deleted_at is null " +
+	"where posts.state in ('published', 'unlisted') group by posts.id, ap.url, u.id, ap.preview_image, l.liked_by, post_interests.interests, ap.title, ap.tagline limit $5 offset $6"
 
 const FetchViewedPosts = "with post_interests as (select jsonb_agg(jsonb_build_object('id', interests.id, 'name', interests.name)) as interests, " +
 	"post_x_interests.post_id " +
@@ -63,8 +63,8 @@ const FetchViewedPosts = "with post_interests as (select jsonb_agg(jsonb_build_o
 	"inner join users u on u.id = posts.author_id " +
 	"inner join abstract_post ap on posts.id = ap.post_id " +
 	"left join likes l on l.post_id = posts.id " +
-	"left join comments c on c.post_id = posts.id " +
-	"where posts.deleted_at is null " +
+	"left join comments c on c.post_id = posts.id and not c.held_for_review and c.deleted_at is null " +
+	"where posts.state in ('published', 'unlisted') " +
 	"group by posts.id, u.id, ap.preview_image,ap.url, l.liked_by, post_interests.interests, ap.title, ap.tagline, sp.user_id " +
 	"limit $7 offset $8"
 
@@ -90,13 +90,13 @@ const FetchPostByInterests = "with ins as ( " +
 	"case when $2 = users.id then true else false end      as is_viewer_is_author, " +
 	"case when $3 in (sp.user_id) then true else false end as is_bookmarked " +
 	"from post_x_interests " +
-	"inner join posts on post_x_interests.post_id = posts.id and deleted_at is null " +
+	"inner join posts on post_x_interests.post_id = posts.id and posts.state = 'published' " +
 	"inner join abstract_post ap on posts.id = ap.post_id " +
 	"inner join users on posts.author_id = users.id " +
 	"inner join ins on ins.post_id = post_x_interests.post_id " +
 	"left join user_blocks ub on ub.blocked_by = users.id " +
 	"left join likes l on posts.id = l.post_id " +
-	"left join comments c on posts.id = c.post_id " +
+	"left join comments c on posts.id = c.post_id and not c.held_for_review and c.deleted_at is null " +
 	"left join saved_posts sp on posts.id = sp.post_id " +
 	"where interest_id = $4 and author_id not in (ub.blocked_id) " +
 	"group by ap.title, ins.post_id, ap.tagline, ap.url, posts.author_id, ins.interests, username, preview_image, liked_by, users.id, " +
@@ -0,0 +1,218 @@
+package repository
+
+//go:generate mockgen -source=series_repository.go -destination=./../mocks/mock_series_repository.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/jmoiron/sqlx"
+	"post-api/helper"
+	"post-api/story/models/db"
+)
+
+type SeriesRepository interface {
+	Create(ctx context.Context, series db.Series) (uuid.UUID, error)
+	GetSeries(ctx context.Context, seriesID uuid.UUID) (db.Series, error)
+	GetSeriesByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.Series, error)
+	GetSeriesForPost(ctx context.Context, postID uuid.UUID) (db.Series, error)
+	GetSeriesPosts(ctx context.Context, seriesID uuid.UUID) ([]db.SeriesPost, error)
+	AddPost(ctx context.Context, txn helper.Transaction, seriesID, postID, authorID uuid.UUID) error
+	RemovePost(ctx context.Context, txn helper.Transaction, seriesID, postID uuid.UUID) error
+	UpdatePosition(ctx context.Context, txn helper.Transaction, seriesID, postID uuid.UUID, position int) error
+	Lock(ctx context.Context, txn helper.Transaction, seriesID uuid.UUID) error
+	GetPositions(ctx context.Context, txn helper.Transaction, seriesID uuid.UUID) ([]db.SeriesPost, error)
+}
+
+type seriesRepository struct {
+	db *sqlx.DB
+}
+
+const (
+	CreateSeries        = "insert into series (id, author_id, title, description) values (uuid_generate_v4(), $1, $2, $3) returning id"
+	FetchSeries         = "select series.id, series.author_id, series.title, series.description, series.created_at, count(posts.id) as posts_count from series left join series_posts sp on series.id = sp.series_id left join posts on sp.post_id = posts.id and posts.state = 'published' where series.id = $1 and series.deleted_at is null group by series.id"
+	FetchSeriesByAuthor = "select series.id, series.author_id, series.title, series.description, series.created_at, count(posts.id) as posts_count from series left join series_posts sp on series.id = sp.series_id left join posts on sp.post_id = posts.id and posts.state = 'published' where series.author_id = $1 and series.deleted_at is null group by series.id order by series.created_at desc"
+	FetchSeriesForPost  = "select series.id, series.author_id, series.title, series.description, series.created_at from series inner join series_posts sp on series.id = sp.series_id where sp.post_id = $1 and series.deleted_at is null"
+	FetchSeriesPosts    = "select sp.post_id, sp.position, ap.title, ap.url from series_posts sp inner join posts on sp.post_id = posts.id inner join abstract_post ap on posts.id = ap.post_id where sp.series_id = $1 and posts.state = 'published' order by sp.position"
+	AddSeriesPost       = "insert into series_posts (series_id, post_id, position) select $1, posts.id, (select coalesce(max(position), 0) + 1 from series_posts where series_id = $2) from posts where posts.id = $3 and posts.author_id = $4 and posts.state = 'published'"
+	RemoveSeriesPost    = "delete from series_posts where series_id = $1 and post_id = $2"
+	UpdateSeriesFlag    = "update posts set is_series = $1 where id = $2"
+	UpdateSeriesPost    = "update series_posts set position = $1 where series_id = $2 and post_id = $3"
+	LockSeries          = "select id from series where id = $1 for update"
+	FetchSeriesSlots    = "select sp.post_id, sp.position, posts.state from series_posts sp inner join posts on sp.post_id = posts.id where sp.series_id = $1 order by sp.position"
+)
+
+func (repository seriesRepository) Create(ctx context.Context, series db.Series) (uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "Create")
+	logger.Infof("Creating series for author %v", series.AuthorID)
+
+	var seriesID uuid.UUID
+	err := repository.db.GetContext(ctx, &seriesID, CreateSeries, series.AuthorID, series.Title, series.Description)
+	if err != nil {
+		logger.Errorf("Error occurred while creating series for author %v. Error %v", series.AuthorID, err)
+		return seriesID, err
+	}
+
+	logger.Infof("Successfully created series %v for author %v", seriesID, series.AuthorID)
+	return seriesID, nil
+}
+
+func (repository seriesRepository) GetSeries(ctx context.Context, seriesID uuid.UUID) (db.Series, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "GetSeries")
+
+	var series db.Series
+	err := repository.db.GetContext(ctx, &series, FetchSeries, seriesID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching series %v. Error %v", seriesID, err)
+		return db.Series{}, err
+	}
+
+	return series, nil
+}
+
+func (repository seriesRepository) GetSeriesByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.Series, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "GetSeriesByAuthor")
+
+	var series []db.Series
+	err := repository.db.SelectContext(ctx, &series, FetchSeriesByAuthor, authorID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching series for author %v. Error %v", authorID, err)
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (repository seriesRepository) GetSeriesForPost(ctx context.Context, postID uuid.UUID) (db.Series, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "GetSeriesForPost")
+
+	var series db.Series
+	err := repository.db.GetContext(ctx, &series, FetchSeriesForPost, postID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			logger.Errorf("Error occurred while fetching series for post %v. Error %v", postID, err)
+		}
+		return db.Series{}, err
+	}
+
+	return series, nil
+}
+
+func (repository seriesRepository) GetSeriesPosts(ctx context.Context, seriesID uuid.UUID) ([]db.SeriesPost, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "GetSeriesPosts")
+
+	var posts []db.SeriesPost
+	err := repository.db.SelectContext(ctx, &posts, FetchSeriesPosts, seriesID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching posts of series %v. Error %v", seriesID, err)
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func (repository seriesRepository) AddPost(ctx context.Context, txn helper.Transaction, seriesID, postID, authorID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "AddPost")
+	logger.Infof("Adding post %v to series %v", postID, seriesID)
+
+	result, err := txn.ExecContext(ctx, AddSeriesPost, seriesID, seriesID, postID, authorID)
+	if err != nil {
+		logger.Errorf("Error occurred while adding post %v to series %v. Error %v", postID, seriesID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("no post %v found for author %v", postID, authorID)
+		return sql.ErrNoRows
+	}
+
+	_, err = txn.ExecContext(ctx, UpdateSeriesFlag, true, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while marking post %v as series. Error %v", postID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (repository seriesRepository) RemovePost(ctx context.Context, txn helper.Transaction, seriesID, postID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "RemovePost")
+	logger.Infof("Removing post %v from series %v", postID, seriesID)
+
+	result, err := txn.ExecContext(ctx, RemoveSeriesPost, seriesID, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while removing post %v from series %v. Error %v", postID, seriesID, err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Errorf("unable to fetch affected row %v", err)
+		return err
+	}
+
+	if rowsAffected == 0 {
+		logger.Errorf("post %v is not part of series %v", postID, seriesID)
+		return sql.ErrNoRows
+	}
+
+	_, err = txn.ExecContext(ctx, UpdateSeriesFlag, false, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while unmarking post %v as series. Error %v", postID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (repository seriesRepository) UpdatePosition(ctx context.Context, txn helper.Transaction, seriesID, postID uuid.UUID, position int) error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "UpdatePosition")
+
+	_, err := txn.ExecContext(ctx, UpdateSeriesPost, position, seriesID, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while moving post %v of series %v to %v. Error %v", postID, seriesID, position, err)
+		return err
+	}
+
+	return nil
+}
+
+// Lock holds the series row until the transaction ends, so posts are added and reordered one at a time.
+func (repository seriesRepository) Lock(ctx context.Context, txn helper.Transaction, seriesID uuid.UUID) error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "Lock")
+
+	var id uuid.UUID
+	err := txn.GetContext(ctx, &id, LockSeries, seriesID)
+	if err != nil {
+		logger.Errorf("Error occurred while locking series %v. Error %v", seriesID, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetPositions returns every post of the series with its position and state, the posts that are no
+// longer published included.
+func (repository seriesRepository) GetPositions(ctx context.Context, txn helper.Transaction, seriesID uuid.UUID) ([]db.SeriesPost, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesRepository").WithField("method", "GetPositions")
+
+	var posts []db.SeriesPost
+	err := txn.SelectContext(ctx, &posts, FetchSeriesSlots, seriesID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching positions of series %v. Error %v", seriesID, err)
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+func NewSeriesRepository(db *sqlx.DB) SeriesRepository {
+	return seriesRepository{db: db}
+}
@@ -0,0 +1,122 @@
+package service
+
+//go:generate mockgen -source=content_review_service.go -destination=./../mocks/mock_content_review_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/helper"
+	"post-api/story/constants"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"post-api/story/repository"
+)
+
+type ContentReviewService interface {
+	GetPendingReviews(ctx context.Context, moderatorID uuid.UUID, limit, offset int) ([]db.ContentReview, *golaerror.Error)
+	ReviewContent(ctx context.Context, reviewID, moderatorID uuid.UUID, decision string) *golaerror.Error
+}
+
+type contentReviewService struct {
+	repository         repository.ContentReviewRepository
+	transactionManager helper.TransactionManager
+}
+
+// GetPendingReviews returns the content held by the content filter, oldest first.
+func (service contentReviewService) GetPendingReviews(ctx context.Context, moderatorID uuid.UUID, limit, offset int) ([]db.ContentReview, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewService").WithField("method", "GetPendingReviews")
+
+	if apiErr := service.checkModerator(ctx, moderatorID); apiErr != nil {
+		return nil, apiErr
+	}
+
+	reviews, err := service.repository.GetPending(ctx, limit, offset)
+	if err != nil {
+		logger.Errorf("unable to fetch pending reviews. Error %v", err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return reviews, nil
+}
+
+// ReviewContent approves or rejects held content. An approved comment becomes visible and an approved
+// about text is saved to the profile. An approved post is published the next time its author publishes
+// the draft, or on the next scheduler run if it was scheduled, as long as the draft is unchanged since
+// it was held. Rejected content stays hidden.
+func (service contentReviewService) ReviewContent(ctx context.Context, reviewID, moderatorID uuid.UUID, decision string) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewService").WithField("method", "ReviewContent")
+
+	if apiErr := service.checkModerator(ctx, moderatorID); apiErr != nil {
+		return apiErr
+	}
+
+	status := map[string]string{
+		request.ApproveContent: db.ReviewApproved,
+		request.RejectContent:  db.ReviewRejected,
+	}[decision]
+
+	txn := service.transactionManager.NewTransaction()
+	review, err := service.repository.Resolve(ctx, txn, reviewID, moderatorID, status)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to resolve review %v. Error %v", reviewID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoContentReviewFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	if status == db.ReviewApproved {
+		switch review.ContentType {
+		case db.ReviewComment:
+			err = service.repository.ReleaseComment(ctx, txn, review.ContentID)
+		case db.ReviewAbout:
+			if review.Content != nil {
+				err = service.repository.ReleaseAbout(ctx, txn, review.UserID, *review.Content)
+			}
+		case db.ReviewPost:
+			err = service.repository.ReleasePost(ctx, txn, review.ContentID)
+		}
+		if err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to release %v %v. Error %v", review.ContentType, review.ContentID, err)
+			if err == sql.ErrNoRows {
+				return &constants.NoCommentFoundError
+			}
+			return constants.StoryInternalServerError(err.Error())
+		}
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit review %v. Error %v", reviewID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Review %v of %v %v moved to %v", reviewID, review.ContentType, review.ContentID, status)
+	return nil
+}
+
+func (service contentReviewService) checkModerator(ctx context.Context, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentReviewService").WithField("method", "checkModerator")
+
+	isModerator, err := service.repository.IsModerator(ctx, userID)
+	if err != nil {
+		logger.Errorf("unable to check whether user %v is a moderator. Error %v", userID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+	if !isModerator {
+		logger.Errorf("user %v is not a moderator", userID)
+		return &constants.ModeratorAccessDeniedError
+	}
+	return nil
+}
+
+func NewContentReviewService(reviewRepository repository.ContentReviewRepository, manager helper.TransactionManager) ContentReviewService {
+	return contentReviewService{
+		repository:         reviewRepository,
+		transactionManager: manager,
+	}
+}
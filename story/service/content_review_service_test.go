@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"testing"
+)
+
+type ContentReviewServiceTest struct {
+	suite.Suite
+	mockController         *gomock.Controller
+	goContext              context.Context
+	mockReviewRepository   *mocks.MockContentReviewRepository
+	mockTransaction        *mocks.MockTransaction
+	mockTransactionManager *mocks.MockTransactionManager
+	contentReviewService   ContentReviewService
+}
+
+func TestContentReviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentReviewServiceTest))
+}
+
+func (suite *ContentReviewServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockReviewRepository = mocks.NewMockContentReviewRepository(suite.mockController)
+	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
+	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
+	suite.contentReviewService = NewContentReviewService(suite.mockReviewRepository, suite.mockTransactionManager)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *ContentReviewServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *ContentReviewServiceTest) TestGetPendingReviews_WhenSuccess() {
+	moderatorID := uuid.New()
+	reviews := []db.ContentReview{{ID: uuid.New(), ContentType: db.ReviewComment, ContentID: uuid.New(), Status: db.ReviewPending}}
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, moderatorID).Return(true, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().GetPending(suite.goContext, 10, 0).Return(reviews, nil).Times(1)
+
+	actualReviews, err := suite.contentReviewService.GetPendingReviews(suite.goContext, moderatorID, 10, 0)
+
+	suite.Nil(err)
+	suite.Equal(reviews, actualReviews)
+}
+
+func (suite *ContentReviewServiceTest) TestGetPendingReviews_WhenUserIsNotAModerator() {
+	userID := uuid.New()
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, userID).Return(false, nil).Times(1)
+
+	actualReviews, err := suite.contentReviewService.GetPendingReviews(suite.goContext, userID, 10, 0)
+
+	suite.Equal(&constants.ModeratorAccessDeniedError, err)
+	suite.Nil(actualReviews)
+}
+
+func (suite *ContentReviewServiceTest) TestReviewContent_WhenCommentIsApproved() {
+	reviewID, moderatorID, commentID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, moderatorID).Return(true, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockReviewRepository.EXPECT().Resolve(suite.goContext, suite.mockTransaction, reviewID, moderatorID, db.ReviewApproved).Return(db.ContentReview{ID: reviewID, ContentType: db.ReviewComment, ContentID: commentID}, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().ReleaseComment(suite.goContext, suite.mockTransaction, commentID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.contentReviewService.ReviewContent(suite.goContext, reviewID, moderatorID, request.ApproveContent)
+
+	suite.Nil(err)
+}
+
+func (suite *ContentReviewServiceTest) TestReviewContent_WhenAboutIsApproved() {
+	reviewID, moderatorID, userID := uuid.New(), uuid.New(), uuid.New()
+	about := "call me on 98765 43210"
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, moderatorID).Return(true, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockReviewRepository.EXPECT().Resolve(suite.goContext, suite.mockTransaction, reviewID, moderatorID, db.ReviewApproved).Return(db.ContentReview{ID: reviewID, ContentType: db.ReviewAbout, ContentID: userID, UserID: userID, Content: &about}, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().ReleaseAbout(suite.goContext, suite.mockTransaction, userID, about).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.contentReviewService.ReviewContent(suite.goContext, reviewID, moderatorID, request.ApproveContent)
+
+	suite.Nil(err)
+}
+
+func (suite *ContentReviewServiceTest) TestReviewContent_WhenPostIsApproved() {
+	reviewID, moderatorID, draftID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, moderatorID).Return(true, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockReviewRepository.EXPECT().Resolve(suite.goContext, suite.mockTransaction, reviewID, moderatorID, db.ReviewApproved).Return(db.ContentReview{ID: reviewID, ContentType: db.ReviewPost, ContentID: draftID}, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().ReleasePost(suite.goContext, suite.mockTransaction, draftID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.contentReviewService.ReviewContent(suite.goContext, reviewID, moderatorID, request.ApproveContent)
+
+	suite.Nil(err)
+}
+
+func (suite *ContentReviewServiceTest) TestReviewContent_WhenCommentIsRejected() {
+	reviewID, moderatorID := uuid.New(), uuid.New()
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, moderatorID).Return(true, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockReviewRepository.EXPECT().Resolve(suite.goContext, suite.mockTransaction, reviewID, moderatorID, db.ReviewRejected).Return(db.ContentReview{ID: reviewID, ContentType: db.ReviewComment, ContentID: uuid.New()}, nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.contentReviewService.ReviewContent(suite.goContext, reviewID, moderatorID, request.RejectContent)
+
+	suite.Nil(err)
+}
+
+func (suite *ContentReviewServiceTest) TestReviewContent_WhenReviewIsNotPending() {
+	reviewID, moderatorID := uuid.New(), uuid.New()
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, moderatorID).Return(true, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockReviewRepository.EXPECT().Resolve(suite.goContext, suite.mockTransaction, reviewID, moderatorID, db.ReviewApproved).Return(db.ContentReview{}, sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.contentReviewService.ReviewContent(suite.goContext, reviewID, moderatorID, request.ApproveContent)
+
+	suite.Equal(&constants.NoContentReviewFoundError, err)
+}
+
+func (suite *ContentReviewServiceTest) TestReviewContent_WhenUserIsNotAModerator() {
+	reviewID, userID := uuid.New(), uuid.New()
+
+	suite.mockReviewRepository.EXPECT().IsModerator(suite.goContext, userID).Return(false, nil).Times(1)
+
+	err := suite.contentReviewService.ReviewContent(suite.goContext, reviewID, userID, request.RejectContent)
+
+	suite.Equal(&constants.ModeratorAccessDeniedError, err)
+}
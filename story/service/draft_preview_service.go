@@ -0,0 +1,242 @@
+package service
+
+//go:generate mockgen -source=draft_preview_service.go -destination=./../mocks/mock_draft_preview_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/configuration"
+	"post-api/service"
+	"post-api/story/constants"
+	"post-api/story/models"
+	"post-api/story/models/db"
+	"post-api/story/models/response"
+	"post-api/story/repository"
+	"post-api/story/utils"
+	"time"
+)
+
+const (
+	defaultPreviewExpiry = 72 * time.Hour
+	maxPreviewExpiry     = 30 * 24 * time.Hour
+	sharedImageExpiry    = time.Hour
+)
+
+type DraftPreviewService interface {
+	CreateLink(ctx context.Context, draftID, userID uuid.UUID, expiresInHours int) (response.DraftPreviewLink, *golaerror.Error)
+	GetLinks(ctx context.Context, draftID, userID uuid.UUID) ([]db.DraftPreviewLink, *golaerror.Error)
+	RevokeLink(ctx context.Context, draftID, linkID, userID uuid.UUID) *golaerror.Error
+	OpenLink(ctx context.Context, token string) (response.SharedDraft, *golaerror.Error)
+}
+
+type draftPreviewService struct {
+	linkRepository  repository.DraftPreviewLinkRepository
+	draftRepository repository.DraftRepository
+	awsServices     service.AwsServices
+	sanitizer       utils.ContentSanitizer
+	secret          []byte
+	defaultExpiry   time.Duration
+	maxExpiry       time.Duration
+}
+
+// CreateLink creates a preview link for one of the user's drafts. Links last for the default expiry
+// unless asked otherwise, and never longer than the maximum expiry.
+func (service draftPreviewService) CreateLink(ctx context.Context, draftID, userID uuid.UUID, expiresInHours int) (response.DraftPreviewLink, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewService").WithField("method", "CreateLink")
+
+	expiry := service.defaultExpiry
+	if expiresInHours > 0 {
+		expiry = time.Duration(expiresInHours) * time.Hour
+	}
+	if expiry > service.maxExpiry {
+		expiry = service.maxExpiry
+	}
+
+	linkID := uuid.New()
+	// the token carries the expiry in seconds, so the stored expiry is kept to the same precision
+	expiresAt := time.Now().Add(expiry).Truncate(time.Second)
+	err := service.linkRepository.Create(ctx, linkID, draftID, userID, expiresAt)
+	if err != nil {
+		logger.Errorf("unable to create preview link for draft %v. Error %v", draftID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return response.DraftPreviewLink{}, &constants.NoDraftFoundError
+		}
+		return response.DraftPreviewLink{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully created preview link %v for draft %v", linkID, draftID)
+	return response.DraftPreviewLink{
+		ID:        linkID,
+		Token:     utils.SignPreviewToken(service.secret, linkID, expiresAt),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (service draftPreviewService) GetLinks(ctx context.Context, draftID, userID uuid.UUID) ([]db.DraftPreviewLink, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewService").WithField("method", "GetLinks")
+
+	links, err := service.linkRepository.GetActiveLinks(ctx, draftID, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch preview links of draft %v. Error %v", draftID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return links, nil
+}
+
+func (service draftPreviewService) RevokeLink(ctx context.Context, draftID, linkID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewService").WithField("method", "RevokeLink")
+
+	err := service.linkRepository.Revoke(ctx, linkID, draftID, userID)
+	if err != nil {
+		logger.Errorf("unable to revoke preview link %v. Error %v", linkID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return &constants.NoPreviewLinkFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully revoked preview link %v", linkID)
+	return nil
+}
+
+// OpenLink returns the draft a preview token points to and counts the opening. Tokens that are forged,
+// expired or revoked, and links to drafts in the trash, are all reported as not found.
+func (service draftPreviewService) OpenLink(ctx context.Context, token string) (response.SharedDraft, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewService").WithField("method", "OpenLink")
+
+	linkID, err := utils.VerifyPreviewToken(service.secret, token, time.Now())
+	if err != nil {
+		logger.Errorf("invalid preview token. Error %v", err)
+		return response.SharedDraft{}, &constants.NoPreviewLinkFoundError
+	}
+
+	draftID, err := service.linkRepository.Open(ctx, linkID)
+	if err != nil {
+		logger.Errorf("unable to open preview link %v. Error %v", linkID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return response.SharedDraft{}, &constants.NoPreviewLinkFoundError
+		}
+		return response.SharedDraft{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	draft, err := service.draftRepository.GetDraft(ctx, draftID)
+	if err != nil {
+		logger.Errorf("unable to fetch draft %v of preview link %v. Error %v", draftID, linkID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return response.SharedDraft{}, &constants.NoPreviewLinkFoundError
+		}
+		return response.SharedDraft{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	// drafts saved before content was sanitized on save still hold the raw content
+	sanitized, _, apiErr := service.sanitizer.Sanitize(ctx, draft.Data)
+	if apiErr != nil {
+		logger.Errorf("unable to sanitize data of draft %v. Error %v", draftID, apiErr)
+		return response.SharedDraft{}, apiErr
+	}
+	draft.Data = sanitized
+
+	var editor models.Editor
+	if err = draft.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse data of draft %v. Error %v", draftID, err)
+		return response.SharedDraft{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	if apiErr := service.presignDraftImages(ctx, draftID, editor); apiErr != nil {
+		return response.SharedDraft{}, apiErr
+	}
+
+	data, err := json.Marshal(editor)
+	if err != nil {
+		logger.Errorf("unable to marshal data of draft %v. Error %v", draftID, err)
+		return response.SharedDraft{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	title, tagline, err := utils.GetTitleAndTaglineFromData(ctx, draft.Data)
+	if err != nil {
+		logger.Errorf("Error occurred while converting title json to string %v .%v", draftID, err)
+		return response.SharedDraft{}, &constants.ConvertTitleToStringError
+	}
+	if draft.Tagline != nil && *draft.Tagline != "" {
+		tagline = *draft.Tagline
+	}
+
+	sharedDraft := response.SharedDraft{
+		DraftID: draftID,
+		Title:   title,
+		Tagline: tagline,
+		Data:    models.JSONString{JSONText: data},
+		HTML:    editor.RenderHTML(),
+	}
+	if draft.PreviewImage != nil && *draft.PreviewImage != "" {
+		sharedDraft.PreviewImage, err = service.awsServices.GetObjectInS3(*draft.PreviewImage, sharedImageExpiry)
+		if err != nil {
+			logger.Errorf("unable to fetch preview image from s3 %v", err)
+			return response.SharedDraft{}, &constants.InternalServerError
+		}
+	}
+
+	return sharedDraft, nil
+}
+
+// presignDraftImages points the image blocks of the draft's own images to presigned urls.
+func (service draftPreviewService) presignDraftImages(ctx context.Context, draftID uuid.UUID, editor models.Editor) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftPreviewService").WithField("method", "presignDraftImages")
+
+	for _, block := range editor.Blocks {
+		if !block.Type.IsEqual(models.Image) {
+			continue
+		}
+		file, _ := block.Data["file"].(map[string]interface{})
+		url, _ := file["url"].(string)
+		match := draftImagePattern.FindStringSubmatch(url)
+		if match == nil || match[1] != draftID.String() {
+			continue
+		}
+		imageID, err := uuid.Parse(match[2])
+		if err != nil {
+			continue
+		}
+
+		uploadKey, err := service.draftRepository.GetDraftImage(ctx, draftID, imageID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			logger.Errorf("Error occurred while fetching image %v of draft %v. Error %v", imageID, draftID, err)
+			return constants.StoryInternalServerError(err.Error())
+		}
+
+		file["url"], err = service.awsServices.GetObjectInS3(uploadKey, sharedImageExpiry)
+		if err != nil {
+			logger.Errorf("unable to presign image %v of draft %v. Error %v", imageID, draftID, err)
+			return &constants.InternalServerError
+		}
+	}
+	return nil
+}
+
+func NewDraftPreviewService(linkRepository repository.DraftPreviewLinkRepository, draftRepository repository.DraftRepository, awsServices service.AwsServices, sanitizer utils.ContentSanitizer, config configuration.DraftPreview, secret string) DraftPreviewService {
+	previewService := draftPreviewService{
+		linkRepository:  linkRepository,
+		draftRepository: draftRepository,
+		awsServices:     awsServices,
+		sanitizer:       sanitizer,
+		secret:          []byte(secret),
+		defaultExpiry:   time.Duration(config.DefaultExpiryInHours) * time.Hour,
+		maxExpiry:       time.Duration(config.MaxExpiryInHours) * time.Hour,
+	}
+	if previewService.defaultExpiry <= 0 {
+		previewService.defaultExpiry = defaultPreviewExpiry
+	}
+	if previewService.maxExpiry <= 0 {
+		previewService.maxExpiry = maxPreviewExpiry
+	}
+	return previewService
+}
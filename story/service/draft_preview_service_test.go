@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models"
+	"post-api/story/models/db"
+	"post-api/story/utils"
+	"testing"
+	"time"
+)
+
+var previewSecret = "preview secret"
+
+type DraftPreviewServiceTest struct {
+	suite.Suite
+	mockController      *gomock.Controller
+	goContext           context.Context
+	mockLinkRepository  *mocks.MockDraftPreviewLinkRepository
+	mockDraftRepository *mocks.MockDraftRepository
+	mockAwsServices     *mocks.MockAwsServices
+	mockSanitizer       *mocks.MockContentSanitizer
+	draftPreviewService DraftPreviewService
+}
+
+func TestDraftPreviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DraftPreviewServiceTest))
+}
+
+func (suite *DraftPreviewServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockLinkRepository = mocks.NewMockDraftPreviewLinkRepository(suite.mockController)
+	suite.mockDraftRepository = mocks.NewMockDraftRepository(suite.mockController)
+	suite.mockAwsServices = mocks.NewMockAwsServices(suite.mockController)
+	suite.mockSanitizer = mocks.NewMockContentSanitizer(suite.mockController)
+	config := configuration.DraftPreview{DefaultExpiryInHours: 24, MaxExpiryInHours: 48}
+	suite.draftPreviewService = NewDraftPreviewService(suite.mockLinkRepository, suite.mockDraftRepository, suite.mockAwsServices, suite.mockSanitizer, config, previewSecret)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *DraftPreviewServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *DraftPreviewServiceTest) TestCreateLink_WhenExpiryIsNotGiven() {
+	draftID, userID := uuid.New(), uuid.New()
+
+	var expiresAt time.Time
+	suite.mockLinkRepository.EXPECT().Create(suite.goContext, gomock.Any(), draftID, userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _ uuid.UUID, expiry time.Time) error {
+			expiresAt = expiry
+			return nil
+		}).Times(1)
+
+	link, err := suite.draftPreviewService.CreateLink(suite.goContext, draftID, userID, 0)
+
+	suite.Nil(err)
+	suite.Equal(expiresAt, link.ExpiresAt)
+	suite.WithinDuration(time.Now().Add(24*time.Hour), link.ExpiresAt, time.Minute)
+	linkID, verifyErr := utils.VerifyPreviewToken([]byte(previewSecret), link.Token, time.Now())
+	suite.Nil(verifyErr)
+	suite.Equal(link.ID, linkID)
+}
+
+func (suite *DraftPreviewServiceTest) TestCreateLink_WhenExpiryIsOverTheMaximum() {
+	draftID, userID := uuid.New(), uuid.New()
+
+	suite.mockLinkRepository.EXPECT().Create(suite.goContext, gomock.Any(), draftID, userID, gomock.Any()).Return(nil).Times(1)
+
+	link, err := suite.draftPreviewService.CreateLink(suite.goContext, draftID, userID, 100)
+
+	suite.Nil(err)
+	suite.WithinDuration(time.Now().Add(48*time.Hour), link.ExpiresAt, time.Minute)
+}
+
+func (suite *DraftPreviewServiceTest) TestCreateLink_WhenDraftIsNotOwnedByUser() {
+	draftID, userID := uuid.New(), uuid.New()
+
+	suite.mockLinkRepository.EXPECT().Create(suite.goContext, gomock.Any(), draftID, userID, gomock.Any()).Return(sql.ErrNoRows).Times(1)
+
+	_, err := suite.draftPreviewService.CreateLink(suite.goContext, draftID, userID, 0)
+
+	suite.Equal(&constants.NoDraftFoundError, err)
+}
+
+func (suite *DraftPreviewServiceTest) TestRevokeLink_WhenLinkIsNotFound() {
+	draftID, linkID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockLinkRepository.EXPECT().Revoke(suite.goContext, linkID, draftID, userID).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.draftPreviewService.RevokeLink(suite.goContext, draftID, linkID, userID)
+
+	suite.Equal(&constants.NoPreviewLinkFoundError, err)
+}
+
+func (suite *DraftPreviewServiceTest) TestOpenLink_WhenSuccess() {
+	draftID, linkID, imageID := uuid.New(), uuid.New(), uuid.New()
+	token := utils.SignPreviewToken([]byte(previewSecret), linkID, time.Now().Add(time.Hour))
+	imageURL := "/api/post/v1/draft/image/" + draftID.String() + "/" + imageID.String()
+	data := `{"blocks":[{"id":"a","type":"header","data":{"text":"Draft title","level":1}},{"id":"b","type":"image","data":{"file":{"url":"` + imageURL + `"},"caption":""}}]}`
+	previewImage := "draft/preview.png"
+
+	suite.mockLinkRepository.EXPECT().Open(suite.goContext, linkID).Return(draftID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftID).Return(&db.Draft{DraftID: draftID, Data: models.JSONString{JSONText: []byte(data)}, PreviewImage: &previewImage}, nil).Times(1)
+	suite.mockSanitizer.EXPECT().Sanitize(suite.goContext, models.JSONString{JSONText: []byte(data)}).Return(models.JSONString{JSONText: []byte(data)}, nil, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftImage(suite.goContext, draftID, imageID).Return("draft/image.png", nil).Times(1)
+	suite.mockAwsServices.EXPECT().GetObjectInS3("draft/image.png", time.Hour).Return("https://signed/image.png", nil).Times(1)
+	suite.mockAwsServices.EXPECT().GetObjectInS3(previewImage, time.Hour).Return("https://signed/preview.png", nil).Times(1)
+
+	sharedDraft, err := suite.draftPreviewService.OpenLink(suite.goContext, token)
+
+	suite.Nil(err)
+	suite.Equal("Draft title", sharedDraft.Title)
+	suite.Equal("https://signed/preview.png", sharedDraft.PreviewImage)
+	suite.Contains(sharedDraft.HTML, "https://signed/image.png")
+	var editor models.Editor
+	suite.Nil(json.Unmarshal(sharedDraft.Data.JSONText, &editor))
+	suite.Equal("https://signed/image.png", editor.Blocks[1].Data["file"].(map[string]interface{})["url"])
+}
+
+func (suite *DraftPreviewServiceTest) TestOpenLink_WhenTokenIsForged() {
+	token := utils.SignPreviewToken([]byte("other secret"), uuid.New(), time.Now().Add(time.Hour))
+
+	_, err := suite.draftPreviewService.OpenLink(suite.goContext, token)
+
+	suite.Equal(&constants.NoPreviewLinkFoundError, err)
+}
+
+func (suite *DraftPreviewServiceTest) TestOpenLink_WhenLinkIsRevoked() {
+	linkID := uuid.New()
+	token := utils.SignPreviewToken([]byte(previewSecret), linkID, time.Now().Add(time.Hour))
+
+	suite.mockLinkRepository.EXPECT().Open(suite.goContext, linkID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+
+	_, err := suite.draftPreviewService.OpenLink(suite.goContext, token)
+
+	suite.Equal(&constants.NoPreviewLinkFoundError, err)
+}
@@ -5,9 +5,12 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/inclusi-blog/gola-utils/model"
+	"post-api/helper"
 	"post-api/service"
 	"post-api/story/constants"
 	"post-api/story/models"
@@ -16,6 +19,8 @@ import (
 	"post-api/story/models/response"
 	"post-api/story/repository"
 	"post-api/story/utils"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/inclusi-blog/gola-utils/golaerror"
@@ -23,71 +28,247 @@ import (
 )
 
 type DraftService interface {
-	CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, error)
-	UpdateDraft(postData models.UpsertDraft, ctx context.Context) *golaerror.Error
-	UpsertInterests(interestRequest request.InterestsSaveRequest, ctx context.Context) *golaerror.Error
-	UpsertTagline(taglineRequest request.TaglineSaveRequest, ctx context.Context) *golaerror.Error
+	CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, []models.SanitizeReport, error)
+	UpdateDraft(postData models.UpsertDraft, ctx context.Context) (int64, []models.SanitizeReport, *golaerror.Error)
+	PatchDraft(ctx context.Context, patchRequest request.DraftPatchRequest) (int64, []models.SanitizeReport, *golaerror.Error)
+	UpsertInterests(interestRequest request.InterestsSaveRequest, ctx context.Context) (int64, *golaerror.Error)
+	UpsertTagline(taglineRequest request.TaglineSaveRequest, ctx context.Context) (int64, *golaerror.Error)
 	GetDraft(ctx context.Context, draftUID, userUUID uuid.UUID) (db.Draft, *golaerror.Error)
-	SavePreviewImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) *golaerror.Error
+	SavePreviewImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) (int64, *golaerror.Error)
 	SaveImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) (string, *golaerror.Error)
 	GetAllDraft(ctx context.Context, allDraftReq models.GetAllDraftRequest) ([]db.DraftPreview, error)
 	DeleteDraft(ctx context.Context, draftID, userUUID uuid.UUID) *golaerror.Error
 	ValidateAndGetDraft(ctx context.Context, draftId uuid.UUID, user model.IdToken) (response.PreviewDraft, *golaerror.Error)
 	GetDraftImage(ctx context.Context, draftID uuid.UUID, imageID uuid.UUID) (string, *golaerror.Error)
+	GetRevisions(ctx context.Context, draftID, userUUID uuid.UUID) ([]db.DraftRevisionSummary, *golaerror.Error)
+	GetRevision(ctx context.Context, draftID, revisionID, userUUID uuid.UUID) (db.DraftRevision, *golaerror.Error)
+	DiffRevisions(ctx context.Context, draftID, fromID, toID, userUUID uuid.UUID) (response.RevisionDiff, *golaerror.Error)
+	RestoreRevision(ctx context.Context, draftID, revisionID, userUUID uuid.UUID, version int64) (int64, []models.SanitizeReport, *golaerror.Error)
+	SchedulePublish(ctx context.Context, scheduleRequest request.SchedulePublishRequest) *golaerror.Error
+	CancelScheduledPublish(ctx context.Context, draftID, userUUID uuid.UUID) *golaerror.Error
+	GetScheduledDrafts(ctx context.Context, userUUID uuid.UUID) ([]db.ScheduledDraft, *golaerror.Error)
+	ImportMarkdown(ctx context.Context, importRequest request.MarkdownImportRequest) (uuid.UUID, []models.SanitizeReport, *golaerror.Error)
+	ExportMarkdown(ctx context.Context, draftID, userUUID uuid.UUID) (string, *golaerror.Error)
+	ForkDraft(ctx context.Context, draftID, userUUID uuid.UUID) (uuid.UUID, *golaerror.Error)
+	ForkPost(ctx context.Context, postID, userUUID uuid.UUID) (uuid.UUID, *golaerror.Error)
 }
 
+var draftImagePattern = regexp.MustCompile(`draft/image/([0-9a-fA-F-]{36})/([0-9a-fA-F-]{36})`)
+
 type draftService struct {
 	draftRepository    repository.DraftRepository
 	interestRepository repository.InterestsRepository
 	validator          utils.PostValidator
+	sanitizer          utils.ContentSanitizer
 	awsServices        service.AwsServices
+	revisionRepository repository.DraftRevisionRepository
+	postsRepository    repository.PostsRepository
+	linkPreviewService LinkPreviewService
+	transactionManager helper.TransactionManager
 }
 
-func (service draftService) CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, error) {
+func (service draftService) CreateDraft(ctx context.Context, draft models.CreateDraft) (uuid.UUID, []models.SanitizeReport, error) {
 	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "CreateDraft")
 	logger.Info("creating draft")
-	return service.draftRepository.CreateDraft(ctx, draft)
+
+	draft, reports, apiErr := service.newDraftContent(ctx, draft)
+	if apiErr != nil {
+		return uuid.Nil, nil, apiErr
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	draftID, apiErr := service.insertDraft(ctx, txn, draft)
+	if apiErr != nil {
+		_ = txn.Rollback()
+		return uuid.Nil, nil, apiErr
+	}
+	if err := txn.Commit(); err != nil {
+		logger.Errorf("unable to commit new draft of user %v. Error %v", draft.UserID, err)
+		return uuid.Nil, nil, constants.StoryInternalServerError(err.Error())
+	}
+	return draftID, reports, nil
+}
+
+// newDraftContent validates the blocks of a new draft and returns it with its content sanitized.
+func (service draftService) newDraftContent(ctx context.Context, draft models.CreateDraft) (models.CreateDraft, []models.SanitizeReport, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "newDraftContent")
+	if blocksErr := utils.ValidateBlocks(ctx, draft.Data); blocksErr != nil {
+		logger.Errorf("invalid content for new draft of user %v", draft.UserID)
+		return draft, nil, blocksErr
+	}
+
+	data, reports, sanitizeErr := service.sanitizer.Sanitize(ctx, draft.Data)
+	if sanitizeErr != nil {
+		logger.Errorf("unable to sanitize content for new draft of user %v", draft.UserID)
+		return draft, nil, sanitizeErr
+	}
+	draft.Data = data
+	return draft, reports, nil
+}
+
+// insertDraft stores a new draft whose content went through newDraftContent, along with its first revision.
+func (service draftService) insertDraft(ctx context.Context, txn helper.Transaction, draft models.CreateDraft) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "insertDraft")
+
+	draftID, err := service.draftRepository.CreateDraft(ctx, txn, draft)
+	if err != nil {
+		logger.Errorf("Error occurred while creating draft for user %v. Error %v", draft.UserID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	if _, err = service.revisionRepository.Save(ctx, txn, draftID, draft.Data); err != nil {
+		logger.Errorf("Error occurred while saving revision for draft %v. Error %v", draftID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+	return draftID, nil
 }
 
-func (service draftService) UpdateDraft(postData models.UpsertDraft, ctx context.Context) *golaerror.Error {
+func (service draftService) UpdateDraft(postData models.UpsertDraft, ctx context.Context) (int64, []models.SanitizeReport, *golaerror.Error) {
 	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "UpdateDraft")
 	logger.Infof("Saving post data to draft repository")
-	err := service.draftRepository.SavePostDraft(postData, ctx)
-	return InternalServerError(err, logger)
+	return service.saveDraftWithRevision(ctx, postData)
 }
 
-func (service draftService) UpsertInterests(interestRequest request.InterestsSaveRequest, ctx context.Context) *golaerror.Error {
+// PatchDraft applies block operations to the stored draft content. The operations are applied in
+// memory and saved against the version the client sent, so a partially applied patch or a patch
+// based on stale content is never written.
+func (service draftService) PatchDraft(ctx context.Context, patchRequest request.DraftPatchRequest) (int64, []models.SanitizeReport, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "PatchDraft")
+	logger.Infof("Applying %v block operations to draft %v", len(patchRequest.Operations), patchRequest.DraftID)
+
+	draft, err := service.draftRepository.GetDraftByUser(ctx, patchRequest.DraftID, patchRequest.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no draft found for draft id %v .Error %v", patchRequest.DraftID, err)
+			return 0, nil, &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while fetching draft %v. Error %v", patchRequest.DraftID, err)
+		return 0, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	if draft.Version != patchRequest.Version {
+		logger.Errorf("stale patch for draft id %v, current version is %v", patchRequest.DraftID, draft.Version)
+		return 0, nil, constants.DraftVersionConflictError(draft.Version)
+	}
+
+	var editor models.Editor
+	if err = draft.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse data of draft %v. Error %v", patchRequest.DraftID, err)
+		return 0, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	if err = editor.ApplyOperations(patchRequest.Operations); err != nil {
+		logger.Errorf("unable to apply block operations to draft %v. Error %v", patchRequest.DraftID, err)
+		return 0, nil, constants.InvalidBlockOperationError(err)
+	}
+
+	data, err := json.Marshal(editor)
+	if err != nil {
+		logger.Errorf("unable to marshal patched data of draft %v. Error %v", patchRequest.DraftID, err)
+		return 0, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return service.saveDraftWithRevision(ctx, models.UpsertDraft{
+		DraftID: patchRequest.DraftID,
+		UserID:  patchRequest.UserID,
+		Data:    models.JSONString{JSONText: data},
+		Version: patchRequest.Version,
+	})
+}
+
+// saveDraftWithRevision updates the draft content and records it as a new immutable revision in a
+// single transaction, so the revision history never diverges from what was actually saved. Link blocks
+// without a preview get one, and the content is sanitized before it is saved and the markup that was
+// stripped is returned.
+func (service draftService) saveDraftWithRevision(ctx context.Context, postData models.UpsertDraft) (int64, []models.SanitizeReport, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "saveDraftWithRevision")
+
+	if blocksErr := utils.ValidateBlocks(ctx, postData.Data); blocksErr != nil {
+		logger.Errorf("invalid content for draft %v", postData.DraftID)
+		return 0, nil, blocksErr
+	}
+
+	postData.Data = service.linkPreviewService.FillLinkPreviews(ctx, postData.Data)
+	data, reports, sanitizeErr := service.sanitizer.Sanitize(ctx, postData.Data)
+	if sanitizeErr != nil {
+		logger.Errorf("unable to sanitize content for draft %v", postData.DraftID)
+		return 0, nil, sanitizeErr
+	}
+	postData.Data = data
+
+	txn := service.transactionManager.NewTransaction()
+	version, err := service.draftRepository.SavePostDraft(ctx, txn, postData)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while saving draft data into draft repository %v", err)
+		return 0, nil, service.draftWriteError(ctx, postData.DraftID, postData.UserID, err, &constants.InternalServerError)
+	}
+
+	_, err = service.revisionRepository.Save(ctx, txn, postData.DraftID, postData.Data)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while saving revision for draft %v. Error %v", postData.DraftID, err)
+		return 0, nil, &constants.InternalServerError
+	}
+
+	_ = txn.Commit()
+	return version, reports, nil
+}
+
+// draftWriteError maps a failed versioned draft write to an api error. A write that matched no rows
+// either targeted a missing draft or carried a stale version, so the draft is re-read to tell them apart.
+func (service draftService) draftWriteError(ctx context.Context, draftID, userUUID uuid.UUID, err error, fallback *golaerror.Error) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "draftWriteError")
+	if !errors.Is(err, sql.ErrNoRows) {
+		return fallback
+	}
+
+	draft, fetchErr := service.draftRepository.GetDraftByUser(ctx, draftID, userUUID)
+	if fetchErr != nil {
+		if errors.Is(fetchErr, sql.ErrNoRows) {
+			logger.Errorf("no draft found for draft id %v .Error %v", draftID, fetchErr)
+			return &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while fetching draft %v. Error %v", draftID, fetchErr)
+		return constants.StoryInternalServerError(fetchErr.Error())
+	}
+
+	logger.Errorf("stale write for draft id %v, current version is %v", draftID, draft.Version)
+	return constants.DraftVersionConflictError(draft.Version)
+}
+
+func (service draftService) UpsertInterests(interestRequest request.InterestsSaveRequest, ctx context.Context) (int64, *golaerror.Error) {
 	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "UpsertInterests")
 
 	logger.Info("Calling service to save interests for draft")
 
-	err := service.draftRepository.SaveInterestsToDraft(interestRequest, ctx)
+	version, err := service.draftRepository.SaveInterestsToDraft(interestRequest, ctx)
 
 	if err != nil {
 		logger.Errorf("Error occurred while inserting interests in draft repository %v", err)
-		return &constants.PostServiceFailureError
+		return 0, service.draftWriteError(ctx, interestRequest.DraftID, interestRequest.UserID, err, &constants.PostServiceFailureError)
 	}
 
 	logger.Info("Successfully stored draft interests")
 
-	return nil
+	return version, nil
 }
 
-func (service draftService) UpsertTagline(taglineRequest request.TaglineSaveRequest, ctx context.Context) *golaerror.Error {
+func (service draftService) UpsertTagline(taglineRequest request.TaglineSaveRequest, ctx context.Context) (int64, *golaerror.Error) {
 	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "UpsertTagline")
 
 	logger.Info("Calling service to save tagline for draft")
 
-	err := service.draftRepository.SaveTaglineToDraft(taglineRequest, ctx)
+	version, err := service.draftRepository.SaveTaglineToDraft(taglineRequest, ctx)
 
 	if err != nil {
 		logger.Errorf("Error occurred while inserting tagline in draft repository %v", err)
-		return &constants.PostServiceFailureError
+		return 0, service.draftWriteError(ctx, taglineRequest.DraftID, taglineRequest.UserID, err, &constants.PostServiceFailureError)
 	}
 
 	logger.Info("Successfully stored draft tagline")
 
-	return nil
+	return version, nil
 }
 
 func (service draftService) GetDraft(ctx context.Context, draftUID, userUUID uuid.UUID) (db.Draft, *golaerror.Error) {
@@ -133,20 +314,20 @@ func (service draftService) GetDraft(ctx context.Context, draftUID, userUUID uui
 	return draft, nil
 }
 
-func (service draftService) SavePreviewImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) *golaerror.Error {
+func (service draftService) SavePreviewImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) (int64, *golaerror.Error) {
 	id := imageSaveRequest.DraftID
 	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "SavePreviewImage")
 	logger.Infof("Saving preview image for draft id %v", id)
 
-	err := service.draftRepository.UpsertPreviewImage(ctx, imageSaveRequest)
+	version, err := service.draftRepository.UpsertPreviewImage(ctx, imageSaveRequest)
 
 	if err != nil {
 		logger.Errorf("Error occurred while saving preview image to draft %v .%v", id, err)
-		return constants.StoryInternalServerError(err.Error())
+		return 0, service.draftWriteError(ctx, id, imageSaveRequest.UserID, err, constants.StoryInternalServerError(err.Error()))
 	}
 
 	logger.Infof("Successfully stored preview image for draft id %v", id)
-	return nil
+	return version, nil
 }
 
 func (service draftService) SaveImage(ctx context.Context, imageSaveRequest request.PreviewImageSaveRequest) (string, *golaerror.Error) {
@@ -264,12 +445,7 @@ func (service draftService) DeleteDraft(ctx context.Context, draftID, userUUID u
 		return &constants.UnauthorisedDraftError
 	}
 
-	err = service.draftRepository.DeleteDraftImages(ctx, draftID)
-	if err != nil {
-		logger.Errorf("Unable to delete draft images for draft ID %v. Error %v", draftID, err)
-		return constants.StoryInternalServerError(err.Error())
-	}
-
+	// the draft only moves to the trash, its images are kept until it is purged
 	err = service.draftRepository.DeleteDraft(ctx, draftID, userUUID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -280,7 +456,7 @@ func (service draftService) DeleteDraft(ctx context.Context, draftID, userUUID u
 		return constants.StoryInternalServerError(err.Error())
 	}
 
-	logger.Info("Successfully deleted draft from draft repository")
+	logger.Info("Successfully moved draft to trash")
 
 	return nil
 }
@@ -332,6 +508,445 @@ func (service draftService) ValidateAndGetDraft(ctx context.Context, draftId uui
 	return previewDraft, nil
 }
 
+func (service draftService) GetRevisions(ctx context.Context, draftID, userUUID uuid.UUID) ([]db.DraftRevisionSummary, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "GetRevisions")
+	logger.Infof("Fetching revisions for draft id %v", draftID)
+
+	if apiErr := service.checkDraftOwner(ctx, draftID, userUUID); apiErr != nil {
+		return nil, apiErr
+	}
+
+	revisions, err := service.revisionRepository.GetRevisions(ctx, draftID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching revisions for draft id %v. Error %v", draftID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully fetched revisions for draft id %v", draftID)
+	return revisions, nil
+}
+
+func (service draftService) GetRevision(ctx context.Context, draftID, revisionID, userUUID uuid.UUID) (db.DraftRevision, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "GetRevision")
+	logger.Infof("Fetching revision %v for draft id %v", revisionID, draftID)
+
+	if apiErr := service.checkDraftOwner(ctx, draftID, userUUID); apiErr != nil {
+		return db.DraftRevision{}, apiErr
+	}
+
+	return service.fetchRevision(ctx, draftID, revisionID)
+}
+
+func (service draftService) DiffRevisions(ctx context.Context, draftID, fromID, toID, userUUID uuid.UUID) (response.RevisionDiff, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "DiffRevisions")
+	logger.Infof("Comparing revision %v with %v for draft id %v", fromID, toID, draftID)
+
+	if apiErr := service.checkDraftOwner(ctx, draftID, userUUID); apiErr != nil {
+		return response.RevisionDiff{}, apiErr
+	}
+
+	from, apiErr := service.fetchRevision(ctx, draftID, fromID)
+	if apiErr != nil {
+		return response.RevisionDiff{}, apiErr
+	}
+
+	to, apiErr := service.fetchRevision(ctx, draftID, toID)
+	if apiErr != nil {
+		return response.RevisionDiff{}, apiErr
+	}
+
+	var fromEditor, toEditor models.Editor
+	if err := from.Data.Unmarshal(&fromEditor); err != nil {
+		logger.Errorf("unable to parse revision %v for draft id %v. Error %v", fromID, draftID, err)
+		return response.RevisionDiff{}, constants.StoryInternalServerError(err.Error())
+	}
+	if err := to.Data.Unmarshal(&toEditor); err != nil {
+		logger.Errorf("unable to parse revision %v for draft id %v. Error %v", toID, draftID, err)
+		return response.RevisionDiff{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	return response.RevisionDiff{
+		DraftID: draftID,
+		From:    fromID,
+		To:      toID,
+		Blocks:  models.DiffBlocks(fromEditor, toEditor),
+	}, nil
+}
+
+func (service draftService) RestoreRevision(ctx context.Context, draftID, revisionID, userUUID uuid.UUID, version int64) (int64, []models.SanitizeReport, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "RestoreRevision")
+	logger.Infof("Restoring revision %v for draft id %v", revisionID, draftID)
+
+	if apiErr := service.checkDraftOwner(ctx, draftID, userUUID); apiErr != nil {
+		return 0, nil, apiErr
+	}
+
+	revision, apiErr := service.fetchRevision(ctx, draftID, revisionID)
+	if apiErr != nil {
+		return 0, nil, apiErr
+	}
+
+	newVersion, reports, apiErr := service.saveDraftWithRevision(ctx, models.UpsertDraft{
+		DraftID: draftID,
+		UserID:  userUUID,
+		Data:    revision.Data,
+		Version: version,
+	})
+	if apiErr != nil {
+		logger.Errorf("unable to restore revision %v for draft id %v", revisionID, draftID)
+		return 0, nil, apiErr
+	}
+
+	logger.Infof("Successfully restored revision %v for draft id %v", revisionID, draftID)
+	return newVersion, reports, nil
+}
+
+func (service draftService) SchedulePublish(ctx context.Context, scheduleRequest request.SchedulePublishRequest) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "SchedulePublish")
+	logger.Infof("Scheduling draft %v to be published at %v", scheduleRequest.DraftID, scheduleRequest.PublishAt)
+
+	if !scheduleRequest.PublishAt.After(time.Now()) {
+		logger.Errorf("publish time %v for draft %v is not in the future", scheduleRequest.PublishAt, scheduleRequest.DraftID)
+		return &constants.InvalidPublishTimeError
+	}
+
+	draft, err := service.draftRepository.GetDraftByUser(ctx, scheduleRequest.DraftID, scheduleRequest.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no draft found for draft id %v .Error %v", scheduleRequest.DraftID, err)
+			return &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while fetching draft %v. Error %v", scheduleRequest.DraftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+	if draft.InReview {
+		logger.Errorf("draft %v is waiting for review by a publication", scheduleRequest.DraftID)
+		return &constants.DraftAlreadySubmittedError
+	}
+
+	err = service.draftRepository.SchedulePublish(ctx, scheduleRequest.DraftID, scheduleRequest.UserID, scheduleRequest.PublishAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no unpublished draft found for draft id %v .Error %v", scheduleRequest.DraftID, err)
+			return &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while scheduling draft %v. Error %v", scheduleRequest.DraftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully scheduled draft %v", scheduleRequest.DraftID)
+	return nil
+}
+
+func (service draftService) CancelScheduledPublish(ctx context.Context, draftID, userUUID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "CancelScheduledPublish")
+	logger.Infof("Cancelling scheduled publish of draft %v", draftID)
+
+	err := service.draftRepository.CancelScheduledPublish(ctx, draftID, userUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no scheduled draft found for draft id %v .Error %v", draftID, err)
+			return &constants.NoScheduledDraftFoundError
+		}
+		logger.Errorf("Error occurred while cancelling schedule of draft %v. Error %v", draftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully cancelled scheduled publish of draft %v", draftID)
+	return nil
+}
+
+func (service draftService) GetScheduledDrafts(ctx context.Context, userUUID uuid.UUID) ([]db.ScheduledDraft, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "GetScheduledDrafts")
+	logger.Infof("Fetching scheduled drafts of user %v", userUUID)
+
+	drafts, err := service.draftRepository.GetScheduledDrafts(ctx, userUUID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching scheduled drafts of user %v. Error %v", userUUID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	for i := range drafts {
+		title, _, err := utils.GetTitleAndTaglineFromData(ctx, drafts[i].Data)
+		if err != nil {
+			logger.Errorf("Error occurred while converting title json to string %v .%v", drafts[i].DraftID, err)
+			return nil, &constants.ConvertTitleToStringError
+		}
+		drafts[i].Title = title
+	}
+
+	return drafts, nil
+}
+
+// ImportMarkdown creates a draft from Markdown. Images that point at an image of another draft of the
+// same user are registered as images of the new draft so that they outlive the draft they came from.
+// Other images are kept as they are. The draft, its images and the rewritten content are saved in one
+// transaction, so a failed import leaves nothing behind.
+func (service draftService) ImportMarkdown(ctx context.Context, importRequest request.MarkdownImportRequest) (uuid.UUID, []models.SanitizeReport, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "ImportMarkdown")
+	logger.Infof("Importing markdown draft for user %v", importRequest.UserID)
+
+	data, err := json.Marshal(models.ParseMarkdown(importRequest.Markdown))
+	if err != nil {
+		logger.Errorf("unable to marshal imported markdown for user %v. Error %v", importRequest.UserID, err)
+		return uuid.Nil, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	// html blocks of the markdown are imported as raw blocks, so the content is checked like any other new draft
+	draft, reports, apiErr := service.newDraftContent(ctx, models.CreateDraft{Data: models.JSONString{JSONText: data}, UserID: importRequest.UserID})
+	if apiErr != nil {
+		return uuid.Nil, nil, apiErr
+	}
+
+	var editor models.Editor
+	if err = draft.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse imported markdown for user %v. Error %v", importRequest.UserID, err)
+		return uuid.Nil, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	draftID, apiErr := service.insertDraft(ctx, txn, draft)
+	if apiErr != nil {
+		_ = txn.Rollback()
+		return uuid.Nil, nil, apiErr
+	}
+
+	linked, apiErr := service.linkDraftImages(ctx, txn, draftID, importRequest.UserID, editor)
+	if apiErr != nil {
+		_ = txn.Rollback()
+		return uuid.Nil, nil, apiErr
+	}
+	if linked {
+		if apiErr = service.saveLinkedImages(ctx, txn, draftID, importRequest.UserID, editor); apiErr != nil {
+			_ = txn.Rollback()
+			return uuid.Nil, nil, apiErr
+		}
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit imported draft %v. Error %v", draftID, err)
+		return uuid.Nil, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully imported markdown into draft %v", draftID)
+	return draftID, reports, nil
+}
+
+// saveLinkedImages saves the content of a new draft after its image blocks were pointed at its own images.
+func (service draftService) saveLinkedImages(ctx context.Context, txn helper.Transaction, draftID, userUUID uuid.UUID, editor models.Editor) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "saveLinkedImages")
+
+	data, err := json.Marshal(editor)
+	if err != nil {
+		logger.Errorf("unable to marshal content of draft %v. Error %v", draftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	// a new draft starts at version 1
+	content := models.JSONString{JSONText: data}
+	_, err = service.draftRepository.SavePostDraft(ctx, txn, models.UpsertDraft{DraftID: draftID, UserID: userUUID, Data: content, Version: 1})
+	if err != nil {
+		logger.Errorf("Error occurred while saving images of draft %v. Error %v", draftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	if _, err = service.revisionRepository.Save(ctx, txn, draftID, content); err != nil {
+		logger.Errorf("Error occurred while saving revision for draft %v. Error %v", draftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+	return nil
+}
+
+// linkDraftImages rewrites image blocks that reference a draft image of the user to a copy registered
+// for the given draft and reports whether any block changed.
+func (service draftService) linkDraftImages(ctx context.Context, txn helper.Transaction, draftID, userUUID uuid.UUID, editor models.Editor) (bool, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "linkDraftImages")
+
+	linked := false
+	for _, image := range draftImageBlocks(editor) {
+		if _, err := service.draftRepository.GetDraftByUser(ctx, image.draftID, userUUID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			logger.Errorf("Error occurred while fetching draft %v. Error %v", image.draftID, err)
+			return false, constants.StoryInternalServerError(err.Error())
+		}
+
+		uploadID, err := service.draftRepository.GetDraftImage(ctx, image.draftID, image.imageID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			logger.Errorf("Error occurred while fetching image %v of draft %v. Error %v", image.imageID, image.draftID, err)
+			return false, constants.StoryInternalServerError(err.Error())
+		}
+
+		newImageID, err := service.draftRepository.AddImage(ctx, txn, request.PreviewImageSaveRequest{UserID: userUUID, DraftID: draftID, UploadID: uploadID})
+		if err != nil {
+			logger.Errorf("Error occurred while saving image for draft %v. Error %v", draftID, err)
+			return false, constants.StoryInternalServerError(err.Error())
+		}
+
+		image.file["url"] = strings.Replace(image.url, image.match, fmt.Sprintf("draft/image/%s/%s", draftID, newImageID), 1)
+		linked = true
+	}
+	return linked, nil
+}
+
+type draftImageBlock struct {
+	file    map[string]interface{}
+	url     string
+	match   string
+	draftID uuid.UUID
+	imageID uuid.UUID
+}
+
+// draftImageBlocks returns the image blocks of the editor that point to a draft image.
+func draftImageBlocks(editor models.Editor) []draftImageBlock {
+	var images []draftImageBlock
+	for _, block := range editor.Blocks {
+		if !block.Type.IsEqual(models.Image) {
+			continue
+		}
+		file, _ := block.Data["file"].(map[string]interface{})
+		url, _ := file["url"].(string)
+		match := draftImagePattern.FindStringSubmatch(url)
+		if match == nil {
+			continue
+		}
+		draftID, draftErr := uuid.Parse(match[1])
+		imageID, imageErr := uuid.Parse(match[2])
+		if draftErr != nil || imageErr != nil {
+			continue
+		}
+		images = append(images, draftImageBlock{file: file, url: url, match: match[0], draftID: draftID, imageID: imageID})
+	}
+	return images
+}
+
+// ExportMarkdown returns the content of the user's draft as Markdown.
+func (service draftService) ExportMarkdown(ctx context.Context, draftID, userUUID uuid.UUID) (string, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "ExportMarkdown")
+	logger.Infof("Exporting draft %v as markdown", draftID)
+
+	draft, err := service.draftRepository.GetDraftByUser(ctx, draftID, userUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no draft found for draft id %v .Error %v", draftID, err)
+			return "", &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while fetching draft %v. Error %v", draftID, err)
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	var editor models.Editor
+	if err = draft.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse data of draft %v. Error %v", draftID, err)
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	return editor.RenderMarkdown(), nil
+}
+
+// ForkDraft starts a new draft for the user with a copy of the content, tagline and interests of one of
+// their drafts. Images of the draft are registered again for the new draft, so the copy keeps resolving
+// them after the original is deleted.
+func (service draftService) ForkDraft(ctx context.Context, draftID, userUUID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "ForkDraft")
+	logger.Infof("Forking draft %v for user %v", draftID, userUUID)
+
+	draft, err := service.draftRepository.GetDraftByUser(ctx, draftID, userUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no draft found for draft id %v .Error %v", draftID, err)
+			return uuid.Nil, &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while fetching draft %v. Error %v", draftID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	var editor models.Editor
+	if err = draft.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse data of draft %v. Error %v", draftID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	forkID, err := service.draftRepository.CopyDraft(ctx, draftID, userUUID)
+	if err != nil {
+		logger.Errorf("Error occurred while copying draft %v. Error %v", draftID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, &constants.NoDraftFoundError
+		}
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	linked, apiErr := service.linkDraftImages(ctx, txn, forkID, userUUID, editor)
+	if apiErr != nil {
+		_ = txn.Rollback()
+		return uuid.Nil, apiErr
+	}
+	if linked {
+		if apiErr = service.saveLinkedImages(ctx, txn, forkID, userUUID, editor); apiErr != nil {
+			_ = txn.Rollback()
+			return uuid.Nil, apiErr
+		}
+	}
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit images of draft %v. Error %v", forkID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully forked draft %v into draft %v", draftID, forkID)
+	return forkID, nil
+}
+
+// ForkPost starts a new draft from the source draft of one of the user's published posts.
+func (service draftService) ForkPost(ctx context.Context, postID, userUUID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "ForkPost")
+
+	draftID, err := service.postsRepository.GetPostDraftID(ctx, postID, userUUID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching source draft of post %v. Error %v", postID, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, &constants.PostNotFoundErr
+		}
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return service.ForkDraft(ctx, draftID, userUUID)
+}
+
+func (service draftService) checkDraftOwner(ctx context.Context, draftID, userUUID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "checkDraftOwner")
+
+	_, err := service.draftRepository.GetDraftByUser(ctx, draftID, userUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no draft found for draft id %v .Error %v", draftID, err)
+			return &constants.NoDraftFoundError
+		}
+		logger.Errorf("Error occurred while fetching draft %v. Error %v", draftID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func (service draftService) fetchRevision(ctx context.Context, draftID, revisionID uuid.UUID) (db.DraftRevision, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftService").WithField("method", "fetchRevision")
+
+	revision, err := service.revisionRepository.GetRevision(ctx, draftID, revisionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Errorf("no revision %v found for draft id %v .Error %v", revisionID, draftID, err)
+			return db.DraftRevision{}, &constants.NoDraftRevisionFoundError
+		}
+		logger.Errorf("Error occurred while fetching revision %v for draft id %v. Error %v", revisionID, draftID, err)
+		return db.DraftRevision{}, constants.StoryInternalServerError(err.Error())
+	}
+	return revision, nil
+}
+
 func InternalServerError(err error, logger logging.GolaLoggerEntry) *golaerror.Error {
 	if err != nil {
 		logger.Errorf("Error occurred while saving draft data into draft repository %v", err)
@@ -340,12 +955,17 @@ func InternalServerError(err error, logger logging.GolaLoggerEntry) *golaerror.E
 	return nil
 }
 
-func NewDraftService(repository repository.DraftRepository, interestsRepository repository.InterestsRepository, validator utils.PostValidator, awsServices service.AwsServices) DraftService {
+func NewDraftService(repository repository.DraftRepository, interestsRepository repository.InterestsRepository, validator utils.PostValidator, sanitizer utils.ContentSanitizer, awsServices service.AwsServices, revisionRepository repository.DraftRevisionRepository, postsRepository repository.PostsRepository, linkPreviewService LinkPreviewService, manager helper.TransactionManager) DraftService {
 	return draftService{
 		draftRepository:    repository,
 		interestRepository: interestsRepository,
 		validator:          validator,
+		sanitizer:          sanitizer,
 		awsServices:        awsServices,
+		revisionRepository: revisionRepository,
+		postsRepository:    postsRepository,
+		linkPreviewService: linkPreviewService,
+		transactionManager: manager,
 	}
 }
 
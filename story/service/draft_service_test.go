@@ -15,16 +15,25 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/inclusi-blog/gola-utils/golaerror"
 	"github.com/jmoiron/sqlx/types"
 	"github.com/stretchr/testify/suite"
 )
 
 type DraftServiceTest struct {
 	suite.Suite
-	mockController      *gomock.Controller
-	goContext           context.Context
-	mockDraftRepository *mocks.MockDraftRepository
-	draftService        DraftService
+	mockController          *gomock.Controller
+	goContext               context.Context
+	mockDraftRepository     *mocks.MockDraftRepository
+	mockInterestsRepository *mocks.MockInterestsRepository
+	mockPostValidator       *mocks.MockPostValidator
+	mockContentSanitizer    *mocks.MockContentSanitizer
+	mockRevisionRepository  *mocks.MockDraftRevisionRepository
+	mockPostsRepository     *mocks.MockPostsRepository
+	mockLinkPreviewService  *mocks.MockLinkPreviewService
+	mockTransaction         *mocks.MockTransaction
+	mockTransactionManager  *mocks.MockTransactionManager
+	draftService            DraftService
 }
 
 func TestDraftServiceTestSuite(t *testing.T) {
@@ -34,7 +43,18 @@ func TestDraftServiceTestSuite(t *testing.T) {
 func (suite *DraftServiceTest) SetupTest() {
 	suite.mockController = gomock.NewController(suite.T())
 	suite.mockDraftRepository = mocks.NewMockDraftRepository(suite.mockController)
-	suite.draftService = NewDraftService(suite.mockDraftRepository)
+	suite.mockInterestsRepository = mocks.NewMockInterestsRepository(suite.mockController)
+	suite.mockPostValidator = mocks.NewMockPostValidator(suite.mockController)
+	suite.mockContentSanitizer = mocks.NewMockContentSanitizer(suite.mockController)
+	suite.mockRevisionRepository = mocks.NewMockDraftRevisionRepository(suite.mockController)
+	suite.mockPostsRepository = mocks.NewMockPostsRepository(suite.mockController)
+	suite.mockLinkPreviewService = mocks.NewMockLinkPreviewService(suite.mockController)
+	suite.mockLinkPreviewService.EXPECT().FillLinkPreviews(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, content models.JSONString) models.JSONString {
+		return content
+	}).AnyTimes()
+	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
+	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
+	suite.draftService = NewDraftService(suite.mockDraftRepository, suite.mockInterestsRepository, suite.mockPostValidator, suite.mockContentSanitizer, nil, suite.mockRevisionRepository, suite.mockPostsRepository, suite.mockLinkPreviewService, suite.mockTransactionManager)
 	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
 }
 
@@ -51,11 +71,14 @@ func (suite *DraftServiceTest) TestCreateDraft_WhenDBReturnsError() {
 		UserID: userUUID,
 	}
 	var newDraftUUID uuid.UUID
-	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, draft).Return(newDraftUUID, errors.New("something went wrong")).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, draft).Return(newDraftUUID, errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
 
-	_, err := suite.draftService.CreateDraft(suite.goContext, draft)
+	_, _, err := suite.draftService.CreateDraft(suite.goContext, draft)
 	suite.NotNil(err)
-	suite.Equal(errors.New("something went wrong"), err)
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
 func (suite *DraftServiceTest) TestCreateDraft_WhenSuccessfullyCreated() {
@@ -67,13 +90,37 @@ func (suite *DraftServiceTest) TestCreateDraft_WhenSuccessfullyCreated() {
 		UserID: userUUID,
 	}
 	newDraftUUID := uuid.New()
-	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, draft).Return(newDraftUUID, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, draft).Return(newDraftUUID, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, newDraftUUID, draft.Data).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
 
-	draftUUID, err := suite.draftService.CreateDraft(suite.goContext, draft)
+	draftUUID, _, err := suite.draftService.CreateDraft(suite.goContext, draft)
 	suite.Nil(err)
 	suite.Equal(newDraftUUID, draftUUID)
 }
 
+func (suite *DraftServiceTest) TestCreateDraft_WhenSavingFirstRevisionFails() {
+	draft := models.CreateDraft{
+		Data: models.JSONString{
+			JSONText: types.JSONText(`{ "title": "hello" }`),
+		},
+		UserID: uuid.New(),
+	}
+	newDraftUUID := uuid.New()
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, draft).Return(newDraftUUID, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, newDraftUUID, draft.Data).Return(uuid.Nil, errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	draftUUID, _, err := suite.draftService.CreateDraft(suite.goContext, draft)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+	suite.Equal(uuid.Nil, draftUUID)
+}
+
 func (suite *DraftServiceTest) TestSaveDraft_WhenDraftRepositoryReturnsNil() {
 	newDraft := models.UpsertDraft{
 		DraftID: uuid.New(),
@@ -83,11 +130,55 @@ func (suite *DraftServiceTest) TestSaveDraft_WhenDraftRepositoryReturnsNil() {
 		},
 	}
 
-	suite.mockDraftRepository.EXPECT().SavePostDraft(newDraft, suite.goContext).Return(nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, newDraft.Data).Return(newDraft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, newDraft).Return(int64(2), nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, newDraft.DraftID, newDraft.Data).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
 
-	expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+	version, _, expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
 
 	suite.Nil(expectedError)
+	suite.Equal(int64(2), version)
+}
+
+func (suite *DraftServiceTest) TestSaveDraft_WhenBlocksAreInvalid() {
+	newDraft := models.UpsertDraft{
+		DraftID: uuid.New(),
+		UserID:  uuid.New(),
+		Data: models.JSONString{
+			JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"paragraph","data":{"text":"fine"}},{"id":"b","type":"list","data":{"style":"bulleted","items":["x"]}}]}`),
+		},
+	}
+
+	_, _, err := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+
+	suite.Equal(constants.InvalidBlocksError([]models.BlockValidationError{{Index: 1, BlockID: "b", Reason: "style must be ordered or unordered"}}), err)
+}
+
+func (suite *DraftServiceTest) TestSaveDraft_WhenMarkupIsStripped() {
+	newDraft := models.UpsertDraft{
+		DraftID: uuid.New(),
+		UserID:  uuid.New(),
+		Data: models.JSONString{
+			JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"raw","data":{"html":"<script>alert(1)</script><p>hi</p>"}}]}`),
+		},
+	}
+	sanitized := newDraft
+	sanitized.Data = models.JSONString{JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"raw","data":{"html":"<p>hi</p>"}}]}`)}
+	reports := []models.SanitizeReport{{Index: 0, BlockID: "a", Stripped: []models.StrippedMarkup{{Tag: "script"}}}}
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, newDraft.Data).Return(sanitized.Data, reports, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, sanitized).Return(int64(2), nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, newDraft.DraftID, sanitized.Data).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	version, stripped, err := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+
+	suite.Nil(err)
+	suite.Equal(int64(2), version)
+	suite.Equal(reports, stripped)
 }
 
 func (suite *DraftServiceTest) TestSaveDraft_WhenDraftRepositoryReturnsError() {
@@ -99,23 +190,89 @@ func (suite *DraftServiceTest) TestSaveDraft_WhenDraftRepositoryReturnsError() {
 		},
 	}
 
-	suite.mockDraftRepository.EXPECT().SavePostDraft(newDraft, suite.goContext).Return(errors.New("something went wrong in db")).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, newDraft.Data).Return(newDraft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, newDraft).Return(int64(0), errors.New("something went wrong in db")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
 
-	expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+	_, _, expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
 
 	suite.NotNil(expectedError)
 }
 
+func (suite *DraftServiceTest) TestSaveDraft_WhenVersionIsStale() {
+	newDraft := models.UpsertDraft{
+		DraftID: uuid.New(),
+		UserID:  uuid.New(),
+		Data: models.JSONString{
+			JSONText: types.JSONText(`{ "title": "hello" }`),
+		},
+		Version: 3,
+	}
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, newDraft.Data).Return(newDraft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, newDraft).Return(int64(0), sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, newDraft.DraftID, newDraft.UserID).Return(db.Draft{DraftID: newDraft.DraftID, Version: 5}, nil).Times(1)
+
+	_, _, expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+
+	suite.Equal(constants.DraftVersionConflictError(5), expectedError)
+}
+
+func (suite *DraftServiceTest) TestSaveDraft_WhenDraftNotFound() {
+	newDraft := models.UpsertDraft{
+		DraftID: uuid.New(),
+		UserID:  uuid.New(),
+		Data: models.JSONString{
+			JSONText: types.JSONText(`{ "title": "hello" }`),
+		},
+		Version: 3,
+	}
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, newDraft.Data).Return(newDraft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, newDraft).Return(int64(0), sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, newDraft.DraftID, newDraft.UserID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+
+	_, _, expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+
+	suite.Equal(&constants.NoDraftFoundError, expectedError)
+}
+
+func (suite *DraftServiceTest) TestSaveDraft_WhenRevisionRepositoryReturnsError() {
+	newDraft := models.UpsertDraft{
+		DraftID: uuid.New(),
+		UserID:  uuid.New(),
+		Data: models.JSONString{
+			JSONText: types.JSONText(`{ "title": "hello" }`),
+		},
+	}
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, newDraft.Data).Return(newDraft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, newDraft).Return(int64(2), nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, newDraft.DraftID, newDraft.Data).Return(uuid.UUID{}, errors.New("something went wrong in db")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	_, _, expectedError := suite.draftService.UpdateDraft(newDraft, suite.goContext)
+
+	suite.Equal(&constants.InternalServerError, expectedError)
+}
+
 func (suite *DraftServiceTest) TestUpsertInterests_WhenDraftRepositoryReturnsNoError() {
 	saveRequest := request.InterestsSaveRequest{
 		Interests: []string{"Sports", "Economy"},
 		DraftID:   uuid.New(),
 		UserID:    uuid.New(),
 	}
-	suite.mockDraftRepository.EXPECT().SaveInterestsToDraft(saveRequest, suite.goContext).Return(nil).Times(1)
-	expectedError := suite.draftService.UpsertInterests(saveRequest, suite.goContext)
+	suite.mockDraftRepository.EXPECT().SaveInterestsToDraft(saveRequest, suite.goContext).Return(int64(2), nil).Times(1)
+	version, expectedError := suite.draftService.UpsertInterests(saveRequest, suite.goContext)
 
 	suite.Nil(expectedError)
+	suite.Equal(int64(2), version)
 }
 
 func (suite *DraftServiceTest) TestUpsertInterests_WhenDraftRepositoryReturnsError() {
@@ -125,9 +282,9 @@ func (suite *DraftServiceTest) TestUpsertInterests_WhenDraftRepositoryReturnsErr
 		UserID:    uuid.New(),
 	}
 
-	suite.mockDraftRepository.EXPECT().SaveInterestsToDraft(saveRequest, suite.goContext).Return(errors.New("something went wrong")).Times(1)
+	suite.mockDraftRepository.EXPECT().SaveInterestsToDraft(saveRequest, suite.goContext).Return(int64(0), errors.New("something went wrong")).Times(1)
 
-	expectedError := suite.draftService.UpsertInterests(saveRequest, suite.goContext)
+	_, expectedError := suite.draftService.UpsertInterests(saveRequest, suite.goContext)
 
 	suite.NotNil(expectedError)
 	suite.Equal(&constants.PostServiceFailureError, expectedError)
@@ -140,11 +297,12 @@ func (suite *DraftServiceTest) TestUpsertTagline_WhenDraftRepositoryReturnsNoErr
 		Tagline: "this is some tagline",
 	}
 
-	suite.mockDraftRepository.EXPECT().SaveTaglineToDraft(saveRequest, suite.goContext).Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().SaveTaglineToDraft(saveRequest, suite.goContext).Return(int64(2), nil).Times(1)
 
-	expectedError := suite.draftService.UpsertTagline(saveRequest, suite.goContext)
+	version, expectedError := suite.draftService.UpsertTagline(saveRequest, suite.goContext)
 
 	suite.Nil(expectedError)
+	suite.Equal(int64(2), version)
 }
 
 func (suite *DraftServiceTest) TestUpsertTagline_WhenDraftRepositoryReturnsError() {
@@ -154,9 +312,9 @@ func (suite *DraftServiceTest) TestUpsertTagline_WhenDraftRepositoryReturnsError
 		Tagline: "this is some tagline",
 	}
 
-	suite.mockDraftRepository.EXPECT().SaveTaglineToDraft(saveRequest, suite.goContext).Return(errors.New("something went wrong")).Times(1)
+	suite.mockDraftRepository.EXPECT().SaveTaglineToDraft(saveRequest, suite.goContext).Return(int64(0), errors.New("something went wrong")).Times(1)
 
-	expectedError := suite.draftService.UpsertTagline(saveRequest, suite.goContext)
+	_, expectedError := suite.draftService.UpsertTagline(saveRequest, suite.goContext)
 
 	suite.NotNil(expectedError)
 	suite.Equal(&constants.PostServiceFailureError, expectedError)
@@ -178,10 +336,17 @@ func (suite *DraftServiceTest) TestGetDraft_WhenDraftRepositoryReturnsNoError()
 		Interests:    &interests,
 	}
 
-	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(expectedDraft, nil).Times(1)
-
-	actualDraft, expectedError := suite.draftService.GetDraft(suite.goContext, draftUUID, userUUID)
-	expectedDraft.ConvertInterests(nil)
+	interestTags := []db.Interests{{ID: uuid.New(), Name: "Culture"}, {ID: uuid.New(), Name: "Sports"}}
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(expectedDraft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"Culture", "Sports"}).Return(interestTags, nil).Times(1)
+	mockAwsServices := mocks.NewMockAwsServices(suite.mockController)
+	mockAwsServices.EXPECT().GetObjectInS3(tmpPreviewPost, 6*time.Hour).Return("https://signed-url.com", nil).Times(1)
+	draftService := NewDraftService(suite.mockDraftRepository, suite.mockInterestsRepository, suite.mockPostValidator, suite.mockContentSanitizer, mockAwsServices, suite.mockRevisionRepository, suite.mockPostsRepository, suite.mockLinkPreviewService, suite.mockTransactionManager)
+
+	actualDraft, expectedError := draftService.GetDraft(suite.goContext, draftUUID, userUUID)
+	signedURL := "https://signed-url.com"
+	expectedDraft.PreviewImage = &signedURL
+	expectedDraft.InterestTags = interestTags
 	suite.Equal(expectedDraft, actualDraft)
 	suite.Nil(expectedError)
 }
@@ -192,7 +357,7 @@ func (suite *DraftServiceTest) TestGetDraft_WhenDraftRepositoryReturnsError() {
 
 	expectedDraft := db.Draft{}
 
-	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftID, userUUID).Return(db.Draft{}, errors.New("something went wrong")).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userUUID).Return(db.Draft{}, errors.New("something went wrong")).Times(1)
 
 	draftData, expectedError := suite.draftService.GetDraft(suite.goContext, draftID, userUUID)
 	suite.Equal(expectedDraft, draftData)
@@ -206,7 +371,7 @@ func (suite *DraftServiceTest) TestGetDraft_WhenDraftRepositoryReturnsNoRowError
 
 	expectedDraft := db.Draft{}
 
-	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftID, userUUID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userUUID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
 
 	draftData, expectedError := suite.draftService.GetDraft(suite.goContext, draftID, userUUID)
 	suite.Equal(expectedDraft, draftData)
@@ -221,10 +386,11 @@ func (suite *DraftServiceTest) TestSavePreviewImage_WhenSuccess() {
 		UploadID: "https://some-url",
 	}
 
-	suite.mockDraftRepository.EXPECT().UpsertPreviewImage(suite.goContext, imageSaveRequest).Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().UpsertPreviewImage(suite.goContext, imageSaveRequest).Return(int64(2), nil).Times(1)
 
-	err := suite.draftService.SavePreviewImage(suite.goContext, imageSaveRequest)
+	version, err := suite.draftService.SavePreviewImage(suite.goContext, imageSaveRequest)
 	suite.Nil(err)
+	suite.Equal(int64(2), version)
 }
 
 func (suite *DraftServiceTest) TestSavePreviewImage_WhenDbReturnsError() {
@@ -234,9 +400,9 @@ func (suite *DraftServiceTest) TestSavePreviewImage_WhenDbReturnsError() {
 		UploadID: "https://some-url",
 	}
 
-	suite.mockDraftRepository.EXPECT().UpsertPreviewImage(suite.goContext, imageSaveRequest).Return(errors.New("something went wrong")).Times(1)
+	suite.mockDraftRepository.EXPECT().UpsertPreviewImage(suite.goContext, imageSaveRequest).Return(int64(0), errors.New("something went wrong")).Times(1)
 
-	err := suite.draftService.SavePreviewImage(suite.goContext, imageSaveRequest)
+	_, err := suite.draftService.SavePreviewImage(suite.goContext, imageSaveRequest)
 	suite.NotNil(err)
 	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
@@ -292,6 +458,7 @@ func (suite *DraftServiceTest) TestGetAllDraft_WhenDraftRepositoryReturnsNoError
 		},
 	}
 
+	interestTags := []db.Interests{{ID: uuid.New(), Name: "Culture"}, {ID: uuid.New(), Name: "Technology"}}
 	expectedDrafts := []db.DraftPreview{
 		{
 			DraftID: draftOneUUID,
@@ -299,9 +466,9 @@ func (suite *DraftServiceTest) TestGetAllDraft_WhenDraftRepositoryReturnsNoError
 			Data: models.JSONString{
 				JSONText: types.JSONText(test_helper.LargeTextData),
 			},
-			Title:     "தமிழ்நாட்டில் கொரோனா தொற்று பரவத் தொடங்கியபோது நோயாளிகளின் எண்ணிக்கை படிப்படியாக அதிகரித்து வந்தது. ",
+			Title:     "இந்தக் கேள்விதான் சென்னைவாசிகள் உட்பட அனைத்து தமிழக மக்களின் மனதிலும் எழுந்துள்ளது. ஒருநாளைக்கு சராச",
 			Tagline:   tagline,
-			Interests: []string{"Culture", "Technology"},
+			Interests: interestTags,
 			CreatedAt: &now,
 		},
 		{
@@ -310,9 +477,9 @@ func (suite *DraftServiceTest) TestGetAllDraft_WhenDraftRepositoryReturnsNoError
 			Data: models.JSONString{
 				JSONText: types.JSONText(test_helper.LargeTextData),
 			},
-			Title:     "தமிழ்நாட்டில் கொரோனா தொற்று பரவத் தொடங்கியபோது நோயாளிகளின் எண்ணிக்கை படிப்படியாக அதிகரித்து வந்தது. ",
+			Title:     "இந்தக் கேள்விதான் சென்னைவாசிகள் உட்பட அனைத்து தமிழக மக்களின் மனதிலும் எழுந்துள்ளது. ஒருநாளைக்கு சராச",
 			Tagline:   tagline,
-			Interests: []string{"Culture", "Technology"},
+			Interests: interestTags,
 			CreatedAt: &now,
 		},
 		{
@@ -321,14 +488,15 @@ func (suite *DraftServiceTest) TestGetAllDraft_WhenDraftRepositoryReturnsNoError
 			Data: models.JSONString{
 				JSONText: types.JSONText(test_helper.LargeTextData),
 			},
-			Title:     "தமிழ்நாட்டில் கொரோனா தொற்று பரவத் தொடங்கியபோது நோயாளிகளின் எண்ணிக்கை படிப்படியாக அதிகரித்து வந்தது. ",
+			Title:     "இந்தக் கேள்விதான் சென்னைவாசிகள் உட்பட அனைத்து தமிழக மக்களின் மனதிலும் எழுந்துள்ளது. ஒருநாளைக்கு சராச",
 			Tagline:   tagline,
-			Interests: []string{"Culture", "Technology"},
+			Interests: interestTags,
 			CreatedAt: &now,
 		},
 	}
 
 	suite.mockDraftRepository.EXPECT().GetAllDraft(suite.goContext, draftRequest).Return(drafts, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"Culture", "Technology"}).Return(interestTags, nil).Times(3)
 
 	actualDrafts, expectedError := suite.draftService.GetAllDraft(suite.goContext, draftRequest)
 	suite.Equal(expectedDrafts, actualDrafts)
@@ -377,6 +545,7 @@ func (suite *DraftServiceTest) TestGetAllDraft_WhenDraftRepositoryReturnsInvalid
 	}
 
 	suite.mockDraftRepository.EXPECT().GetAllDraft(suite.goContext, draftRequest).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, gomock.Any()).Return([]db.Interests{}, nil).Times(1)
 
 	allDraftActual, expectedError := suite.draftService.GetAllDraft(suite.goContext, draftRequest)
 	suite.Nil(allDraftActual)
@@ -403,6 +572,7 @@ func (suite *DraftServiceTest) TestGetAllDraft_WhenDraftRepositoryReturnsNoRowEr
 func (suite *DraftServiceTest) TestDeleteDraft_WhenDraftRepositoryReturnNoError() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
+	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftUUID).Return(&db.Draft{DraftID: draftUUID, UserID: userUUID}, nil).Times(1)
 	suite.mockDraftRepository.EXPECT().DeleteDraft(suite.goContext, draftUUID, userUUID).Return(nil).Times(1)
 
 	err := suite.draftService.DeleteDraft(suite.goContext, draftUUID, userUUID)
@@ -412,6 +582,7 @@ func (suite *DraftServiceTest) TestDeleteDraft_WhenDraftRepositoryReturnNoError(
 func (suite *DraftServiceTest) TestDeleteDraft_WhenDraftRepositoryReturnsNotFoundError() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
+	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftUUID).Return(&db.Draft{DraftID: draftUUID, UserID: userUUID}, nil).Times(1)
 	suite.mockDraftRepository.EXPECT().DeleteDraft(suite.goContext, draftUUID, userUUID).Return(sql.ErrNoRows).Times(1)
 
 	err := suite.draftService.DeleteDraft(suite.goContext, draftUUID, userUUID)
@@ -422,9 +593,393 @@ func (suite *DraftServiceTest) TestDeleteDraft_WhenDraftRepositoryReturnsNotFoun
 func (suite *DraftServiceTest) TestDeleteDraft_WhenDraftRepositoryReturnsGenericError() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
+	suite.mockDraftRepository.EXPECT().GetDraft(suite.goContext, draftUUID).Return(&db.Draft{DraftID: draftUUID, UserID: userUUID}, nil).Times(1)
 	suite.mockDraftRepository.EXPECT().DeleteDraft(suite.goContext, draftUUID, userUUID).Return(errors.New(test_helper.ErrSomethingWentWrong)).Times(1)
 
 	err := suite.draftService.DeleteDraft(suite.goContext, draftUUID, userUUID)
 	suite.NotNil(err)
 	suite.Equal(constants.StoryInternalServerError(test_helper.ErrSomethingWentWrong), err)
 }
+
+func (suite *DraftServiceTest) TestGetRevisions_WhenSuccess() {
+	draftID := uuid.New()
+	userID := uuid.New()
+	revisions := []db.DraftRevisionSummary{{ID: uuid.New(), DraftID: draftID, Revision: 2}, {ID: uuid.New(), DraftID: draftID, Revision: 1}}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, UserID: userID}, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().GetRevisions(suite.goContext, draftID).Return(revisions, nil).Times(1)
+
+	actualRevisions, err := suite.draftService.GetRevisions(suite.goContext, draftID, userID)
+
+	suite.Nil(err)
+	suite.Equal(revisions, actualRevisions)
+}
+
+func (suite *DraftServiceTest) TestGetRevisions_WhenDraftNotOwnedByUser() {
+	draftID := uuid.New()
+	userID := uuid.New()
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+
+	actualRevisions, err := suite.draftService.GetRevisions(suite.goContext, draftID, userID)
+
+	suite.Nil(actualRevisions)
+	suite.Equal(&constants.NoDraftFoundError, err)
+}
+
+func (suite *DraftServiceTest) TestGetRevision_WhenRevisionNotFound() {
+	draftID := uuid.New()
+	revisionID := uuid.New()
+	userID := uuid.New()
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, UserID: userID}, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().GetRevision(suite.goContext, draftID, revisionID).Return(db.DraftRevision{}, sql.ErrNoRows).Times(1)
+
+	_, err := suite.draftService.GetRevision(suite.goContext, draftID, revisionID, userID)
+
+	suite.Equal(&constants.NoDraftRevisionFoundError, err)
+}
+
+func (suite *DraftServiceTest) TestDiffRevisions_WhenSuccess() {
+	draftID := uuid.New()
+	fromID := uuid.New()
+	toID := uuid.New()
+	userID := uuid.New()
+	from := db.DraftRevision{ID: fromID, DraftID: draftID, Revision: 1, Data: models.JSONString{
+		JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"header","data":{"text":"Title","level":1}},{"id":"b","type":"paragraph","data":{"text":"old"}}]}`),
+	}}
+	to := db.DraftRevision{ID: toID, DraftID: draftID, Revision: 2, Data: models.JSONString{
+		JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"header","data":{"text":"Title","level":1}},{"id":"b","type":"paragraph","data":{"text":"new"}},{"id":"c","type":"delimiter","data":{}}]}`),
+	}}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, UserID: userID}, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().GetRevision(suite.goContext, draftID, fromID).Return(from, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().GetRevision(suite.goContext, draftID, toID).Return(to, nil).Times(1)
+
+	diff, err := suite.draftService.DiffRevisions(suite.goContext, draftID, fromID, toID, userID)
+
+	suite.Nil(err)
+	suite.Equal(draftID, diff.DraftID)
+	suite.Len(diff.Blocks, 3)
+	suite.Equal(models.BlockUnchanged, diff.Blocks[0].Change)
+	suite.Equal(models.BlockModified, diff.Blocks[1].Change)
+	suite.Equal(models.BlockAdded, diff.Blocks[2].Change)
+}
+
+func (suite *DraftServiceTest) TestRestoreRevision_WhenSuccess() {
+	draftID := uuid.New()
+	revisionID := uuid.New()
+	userID := uuid.New()
+	data := models.JSONString{JSONText: types.JSONText(`{"blocks":[]}`)}
+	restored := models.UpsertDraft{DraftID: draftID, UserID: userID, Data: data, Version: 4}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, UserID: userID}, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().GetRevision(suite.goContext, draftID, revisionID).Return(db.DraftRevision{ID: revisionID, DraftID: draftID, Data: data}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, data).Return(data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, restored).Return(int64(5), nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, draftID, data).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	version, _, err := suite.draftService.RestoreRevision(suite.goContext, draftID, revisionID, userID, 4)
+
+	suite.Nil(err)
+	suite.Equal(int64(5), version)
+}
+
+func (suite *DraftServiceTest) TestPatchDraft_WhenOperationsApply() {
+	draftID := uuid.New()
+	userID := uuid.New()
+	draft := db.Draft{DraftID: draftID, UserID: userID, Version: 2, Data: models.JSONString{
+		JSONText: types.JSONText(`{"time":1,"blocks":[{"id":"a","type":"paragraph","data":{"text":"old"}}],"version":"2.22"}`),
+	}}
+	patched := models.UpsertDraft{DraftID: draftID, UserID: userID, Version: 2, Data: models.JSONString{
+		JSONText: types.JSONText(`{"time":1,"blocks":[{"id":"a","type":"paragraph","data":{"text":"new"}}],"version":"2.22"}`),
+	}}
+	patchRequest := request.DraftPatchRequest{
+		UserID:     userID,
+		DraftID:    draftID,
+		Version:    2,
+		Operations: []models.BlockOperation{{Op: models.UpdateBlock, BlockID: "a", Data: map[string]interface{}{"text": "new"}}},
+	}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(draft, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, patched.Data).Return(patched.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, patched).Return(int64(3), nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, draftID, patched.Data).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	version, _, err := suite.draftService.PatchDraft(suite.goContext, patchRequest)
+
+	suite.Nil(err)
+	suite.Equal(int64(3), version)
+}
+
+func (suite *DraftServiceTest) TestPatchDraft_WhenBlockIsUnknown() {
+	draftID := uuid.New()
+	userID := uuid.New()
+	draft := db.Draft{DraftID: draftID, UserID: userID, Version: 2, Data: models.JSONString{
+		JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"paragraph","data":{"text":"old"}}]}`),
+	}}
+	patchRequest := request.DraftPatchRequest{
+		UserID:     userID,
+		DraftID:    draftID,
+		Version:    2,
+		Operations: []models.BlockOperation{{Op: models.DeleteBlock, BlockID: "b"}},
+	}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(draft, nil).Times(1)
+
+	_, _, err := suite.draftService.PatchDraft(suite.goContext, patchRequest)
+
+	suite.Equal(constants.InvalidBlockOperationError(models.BlockOperationError{Index: 0, BlockID: "b", Reason: "unknown block"}), err)
+}
+
+func (suite *DraftServiceTest) TestPatchDraft_WhenVersionIsStale() {
+	draftID := uuid.New()
+	userID := uuid.New()
+	patchRequest := request.DraftPatchRequest{
+		UserID:     userID,
+		DraftID:    draftID,
+		Version:    1,
+		Operations: []models.BlockOperation{{Op: models.DeleteBlock, BlockID: "a"}},
+	}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, Version: 4}, nil).Times(1)
+
+	_, _, err := suite.draftService.PatchDraft(suite.goContext, patchRequest)
+
+	suite.Equal(constants.DraftVersionConflictError(4), err)
+}
+
+func (suite *DraftServiceTest) TestSchedulePublish_WhenSuccess() {
+	scheduleRequest := request.SchedulePublishRequest{UserID: uuid.New(), DraftID: uuid.New(), PublishAt: time.Now().Add(time.Hour)}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, scheduleRequest.DraftID, scheduleRequest.UserID).Return(db.Draft{DraftID: scheduleRequest.DraftID}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().SchedulePublish(suite.goContext, scheduleRequest.DraftID, scheduleRequest.UserID, scheduleRequest.PublishAt).Return(nil).Times(1)
+
+	err := suite.draftService.SchedulePublish(suite.goContext, scheduleRequest)
+
+	suite.Nil(err)
+}
+
+func (suite *DraftServiceTest) TestSchedulePublish_WhenDraftIsInReview() {
+	scheduleRequest := request.SchedulePublishRequest{UserID: uuid.New(), DraftID: uuid.New(), PublishAt: time.Now().Add(time.Hour)}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, scheduleRequest.DraftID, scheduleRequest.UserID).Return(db.Draft{DraftID: scheduleRequest.DraftID, InReview: true}, nil).Times(1)
+
+	err := suite.draftService.SchedulePublish(suite.goContext, scheduleRequest)
+
+	suite.Equal(&constants.DraftAlreadySubmittedError, err)
+}
+
+func (suite *DraftServiceTest) TestSchedulePublish_WhenPublishTimeIsInPast() {
+	scheduleRequest := request.SchedulePublishRequest{UserID: uuid.New(), DraftID: uuid.New(), PublishAt: time.Now().Add(-time.Hour)}
+
+	suite.mockDraftRepository.EXPECT().SchedulePublish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := suite.draftService.SchedulePublish(suite.goContext, scheduleRequest)
+
+	suite.Equal(&constants.InvalidPublishTimeError, err)
+}
+
+func (suite *DraftServiceTest) TestCancelScheduledPublish_WhenNothingScheduled() {
+	draftID := uuid.New()
+	userID := uuid.New()
+
+	suite.mockDraftRepository.EXPECT().CancelScheduledPublish(suite.goContext, draftID, userID).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.draftService.CancelScheduledPublish(suite.goContext, draftID, userID)
+
+	suite.Equal(&constants.NoScheduledDraftFoundError, err)
+}
+
+func (suite *DraftServiceTest) TestGetScheduledDrafts_WhenSuccess() {
+	userID := uuid.New()
+	draftID := uuid.New()
+	publishAt := time.Now().Add(time.Hour)
+	drafts := []db.ScheduledDraft{{DraftID: draftID, UserID: userID, PublishAt: publishAt, Data: models.JSONString{
+		JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"header","data":{"text":"Scheduled title","level":1}}]}`),
+	}}}
+
+	suite.mockDraftRepository.EXPECT().GetScheduledDrafts(suite.goContext, userID).Return(drafts, nil).Times(1)
+
+	scheduledDrafts, err := suite.draftService.GetScheduledDrafts(suite.goContext, userID)
+
+	suite.Nil(err)
+	suite.Equal("Scheduled title", scheduledDrafts[0].Title)
+}
+
+func (suite *DraftServiceTest) TestImportMarkdown_WhenImageBelongsToAnotherDraftOfUser() {
+	userID, draftID, sourceDraftID, imageID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	markdown := "# Title\n\n![a cat](/api/post/v1/draft/image/" + sourceDraftID.String() + "/" + imageID.String() + ")\n"
+	newImageID := uuid.NewString()
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, gomock.Any()).DoAndReturn(
+		func(_ context.Context, content models.JSONString) (models.JSONString, []models.SanitizeReport, *golaerror.Error) {
+			return content, nil, nil
+		}).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, gomock.Any()).Return(draftID, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, draftID, gomock.Any()).Return(uuid.New(), nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, sourceDraftID, userID).Return(db.Draft{DraftID: sourceDraftID}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftImage(suite.goContext, sourceDraftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockDraftRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, request.PreviewImageSaveRequest{UserID: userID, DraftID: draftID, UploadID: "draft/some-key.jpg"}).Return(newImageID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ interface{}, saved models.UpsertDraft) (int64, error) {
+			var editor models.Editor
+			suite.Nil(saved.Data.Unmarshal(&editor))
+			suite.Equal("/api/post/v1/draft/image/"+draftID.String()+"/"+newImageID, editor.Blocks[1].Data["file"].(map[string]interface{})["url"])
+			suite.Equal(int64(1), saved.Version)
+			return 2, nil
+		}).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, draftID, gomock.Any()).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	id, _, err := suite.draftService.ImportMarkdown(suite.goContext, request.MarkdownImportRequest{UserID: userID, Markdown: markdown})
+
+	suite.Nil(err)
+	suite.Equal(draftID, id)
+}
+
+func (suite *DraftServiceTest) TestImportMarkdown_WhenImageBelongsToSomeoneElse() {
+	userID, draftID, sourceDraftID := uuid.New(), uuid.New(), uuid.New()
+	markdown := "![a cat](/api/post/v1/draft/image/" + sourceDraftID.String() + "/" + uuid.NewString() + ")"
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, gomock.Any()).DoAndReturn(
+		func(_ context.Context, content models.JSONString) (models.JSONString, []models.SanitizeReport, *golaerror.Error) {
+			return content, nil, nil
+		}).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, gomock.Any()).Return(draftID, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, draftID, gomock.Any()).Return(uuid.New(), nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, sourceDraftID, userID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	id, _, err := suite.draftService.ImportMarkdown(suite.goContext, request.MarkdownImportRequest{UserID: userID, Markdown: markdown})
+
+	suite.Nil(err)
+	suite.Equal(draftID, id)
+}
+
+func (suite *DraftServiceTest) TestImportMarkdown_WhenBlocksAreInvalid() {
+	markdown := "| | |\n|---|---|\n"
+
+	_, _, err := suite.draftService.ImportMarkdown(suite.goContext, request.MarkdownImportRequest{UserID: uuid.New(), Markdown: markdown})
+
+	suite.Equal(constants.InvalidBlocksCode, err.ErrorCode)
+}
+
+func (suite *DraftServiceTest) TestImportMarkdown_WhenLinkingImagesFailsRollsBack() {
+	userID, draftID, sourceDraftID, imageID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	markdown := "![a cat](/api/post/v1/draft/image/" + sourceDraftID.String() + "/" + imageID.String() + ")"
+
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, gomock.Any()).DoAndReturn(
+		func(_ context.Context, content models.JSONString) (models.JSONString, []models.SanitizeReport, *golaerror.Error) {
+			return content, nil, nil
+		}).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, gomock.Any()).Return(draftID, nil).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, draftID, gomock.Any()).Return(uuid.New(), nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, sourceDraftID, userID).Return(db.Draft{DraftID: sourceDraftID}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftImage(suite.goContext, sourceDraftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockDraftRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, gomock.Any()).Return("", errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Times(0)
+
+	id, _, err := suite.draftService.ImportMarkdown(suite.goContext, request.MarkdownImportRequest{UserID: userID, Markdown: markdown})
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+	suite.Equal(uuid.Nil, id)
+}
+
+func (suite *DraftServiceTest) TestExportMarkdown_WhenDraftNotFound() {
+	userID, draftID := uuid.New(), uuid.New()
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+
+	_, err := suite.draftService.ExportMarkdown(suite.goContext, draftID, userID)
+
+	suite.Equal(&constants.NoDraftFoundError, err)
+}
+
+func (suite *DraftServiceTest) TestForkDraft_WhenDraftHasImages() {
+	userID, draftID, forkID, imageID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	url := "/api/post/v1/draft/image/" + draftID.String() + "/" + imageID.String()
+	data := `{"blocks":[{"id":"a","type":"image","data":{"file":{"url":"` + url + `"},"caption":"a cat"}}]}`
+	newImageID := uuid.NewString()
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, Data: models.JSONString{JSONText: []byte(data)}}, nil).Times(2)
+	suite.mockDraftRepository.EXPECT().CopyDraft(suite.goContext, draftID, userID).Return(forkID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftImage(suite.goContext, draftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, request.PreviewImageSaveRequest{UserID: userID, DraftID: forkID, UploadID: "draft/some-key.jpg"}).Return(newImageID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ interface{}, saved models.UpsertDraft) (int64, error) {
+			var editor models.Editor
+			suite.Nil(saved.Data.Unmarshal(&editor))
+			suite.Equal(forkID, saved.DraftID)
+			suite.Equal("/api/post/v1/draft/image/"+forkID.String()+"/"+newImageID, editor.Blocks[0].Data["file"].(map[string]interface{})["url"])
+			return 2, nil
+		}).Times(1)
+	suite.mockRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, forkID, gomock.Any()).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	id, err := suite.draftService.ForkDraft(suite.goContext, draftID, userID)
+
+	suite.Nil(err)
+	suite.Equal(forkID, id)
+}
+
+func (suite *DraftServiceTest) TestForkDraft_WhenDraftHasNoImages() {
+	userID, draftID, forkID := uuid.New(), uuid.New(), uuid.New()
+	data := `{"blocks":[{"id":"a","type":"paragraph","data":{"text":"hello"}}]}`
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, Data: models.JSONString{JSONText: []byte(data)}}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().CopyDraft(suite.goContext, draftID, userID).Return(forkID, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	id, err := suite.draftService.ForkDraft(suite.goContext, draftID, userID)
+
+	suite.Nil(err)
+	suite.Equal(forkID, id)
+}
+
+func (suite *DraftServiceTest) TestForkDraft_WhenDraftNotFound() {
+	userID, draftID := uuid.New(), uuid.New()
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+
+	id, err := suite.draftService.ForkDraft(suite.goContext, draftID, userID)
+
+	suite.Equal(&constants.NoDraftFoundError, err)
+	suite.Equal(uuid.Nil, id)
+}
+
+func (suite *DraftServiceTest) TestForkPost_WhenSuccess() {
+	userID, postID, draftID, forkID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postID, userID).Return(draftID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, draftID, userID).Return(db.Draft{DraftID: draftID, Data: models.JSONString{JSONText: []byte(`{"blocks":[]}`)}}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().CopyDraft(suite.goContext, draftID, userID).Return(forkID, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	id, err := suite.draftService.ForkPost(suite.goContext, postID, userID)
+
+	suite.Nil(err)
+	suite.Equal(forkID, id)
+}
+
+func (suite *DraftServiceTest) TestForkPost_WhenPostIsNotOwnedByUser() {
+	userID, postID := uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postID, userID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+
+	id, err := suite.draftService.ForkPost(suite.goContext, postID, userID)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+	suite.Equal(uuid.Nil, id)
+}
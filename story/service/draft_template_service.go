@@ -0,0 +1,267 @@
+package service
+
+//go:generate mockgen -source=draft_template_service.go -destination=./../mocks/mock_draft_template_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/helper"
+	"post-api/story/constants"
+	"post-api/story/models"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"post-api/story/repository"
+	"strings"
+)
+
+type DraftTemplateService interface {
+	SaveAsTemplate(ctx context.Context, saveRequest request.SaveTemplateRequest) (uuid.UUID, *golaerror.Error)
+	GetTemplate(ctx context.Context, templateID, userID uuid.UUID) (db.DraftTemplate, *golaerror.Error)
+	GetTemplates(ctx context.Context, userID uuid.UUID) ([]db.DraftTemplateSummary, *golaerror.Error)
+	GetPublicTemplates(ctx context.Context, limit, offset int) ([]db.DraftTemplateSummary, *golaerror.Error)
+	ShareTemplate(ctx context.Context, templateID, userID uuid.UUID, isPublic bool) *golaerror.Error
+	DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) *golaerror.Error
+	CreateDraftFromTemplate(ctx context.Context, templateID, userID uuid.UUID) (uuid.UUID, *golaerror.Error)
+}
+
+type draftTemplateService struct {
+	templateRepository repository.DraftTemplateRepository
+	draftRepository    repository.DraftRepository
+	transactionManager helper.TransactionManager
+}
+
+// SaveAsTemplate copies the blocks, tagline and interests of one of the user's drafts into a new template
+// and registers the draft images its image blocks point to, so they outlive the draft.
+func (service draftTemplateService) SaveAsTemplate(ctx context.Context, saveRequest request.SaveTemplateRequest) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "SaveAsTemplate")
+
+	draft, err := service.draftRepository.GetDraftByUser(ctx, saveRequest.DraftID, saveRequest.UserID)
+	if err != nil {
+		logger.Errorf("unable to fetch draft %v. Error %v", saveRequest.DraftID, err)
+		if err == sql.ErrNoRows {
+			return uuid.Nil, &constants.NoDraftFoundError
+		}
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	var editor models.Editor
+	if err = draft.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse data of draft %v. Error %v", saveRequest.DraftID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	templateID, err := service.templateRepository.CreateFromDraft(ctx, txn, saveRequest)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to save draft %v as template. Error %v", saveRequest.DraftID, err)
+		if err == sql.ErrNoRows {
+			return uuid.Nil, &constants.NoDraftFoundError
+		}
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	for _, image := range draftImageBlocks(editor) {
+		if _, err = service.draftRepository.GetDraftByUser(ctx, image.draftID, saveRequest.UserID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			_ = txn.Rollback()
+			logger.Errorf("Error occurred while fetching draft %v. Error %v", image.draftID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+
+		uploadID, err := service.draftRepository.GetDraftImage(ctx, image.draftID, image.imageID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			_ = txn.Rollback()
+			logger.Errorf("Error occurred while fetching image %v of draft %v. Error %v", image.imageID, image.draftID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+
+		if err = service.templateRepository.AddImage(ctx, txn, templateID, image.draftID, image.imageID, uploadID); err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to register image %v for template %v. Error %v", image.imageID, templateID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit template %v. Error %v", templateID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully saved draft %v as template %v", saveRequest.DraftID, templateID)
+	return templateID, nil
+}
+
+// GetTemplate returns a template owned by the user or shared publicly.
+func (service draftTemplateService) GetTemplate(ctx context.Context, templateID, userID uuid.UUID) (db.DraftTemplate, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "GetTemplate")
+
+	template, err := service.templateRepository.GetTemplate(ctx, templateID, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch template %v. Error %v", templateID, err)
+		if err == sql.ErrNoRows {
+			return db.DraftTemplate{}, &constants.NoTemplateFoundError
+		}
+		return db.DraftTemplate{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	return template, nil
+}
+
+func (service draftTemplateService) GetTemplates(ctx context.Context, userID uuid.UUID) ([]db.DraftTemplateSummary, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "GetTemplates")
+
+	templates, err := service.templateRepository.GetTemplatesByUser(ctx, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch templates of user %v. Error %v", userID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return templates, nil
+}
+
+func (service draftTemplateService) GetPublicTemplates(ctx context.Context, limit, offset int) ([]db.DraftTemplateSummary, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "GetPublicTemplates")
+
+	templates, err := service.templateRepository.GetPublicTemplates(ctx, limit, offset)
+	if err != nil {
+		logger.Errorf("unable to fetch public templates. Error %v", err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return templates, nil
+}
+
+func (service draftTemplateService) ShareTemplate(ctx context.Context, templateID, userID uuid.UUID, isPublic bool) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "ShareTemplate")
+
+	err := service.templateRepository.UpdateVisibility(ctx, templateID, userID, isPublic)
+	if err != nil {
+		logger.Errorf("unable to update visibility of template %v. Error %v", templateID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoTemplateFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (service draftTemplateService) DeleteTemplate(ctx context.Context, templateID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "DeleteTemplate")
+
+	err := service.templateRepository.Delete(ctx, templateID, userID)
+	if err != nil {
+		logger.Errorf("unable to delete template %v. Error %v", templateID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoTemplateFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// CreateDraftFromTemplate starts a new draft for the user with the blocks, tagline and interests of the
+// template. Image blocks are pointed at copies of the template images registered for the new draft, the
+// same way a fork links the images of its source. The draft, its images and its content are saved in one
+// transaction, so a failure leaves no draft behind.
+func (service draftTemplateService) CreateDraftFromTemplate(ctx context.Context, templateID, userID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTemplateService").WithField("method", "CreateDraftFromTemplate")
+
+	template, apiErr := service.GetTemplate(ctx, templateID, userID)
+	if apiErr != nil {
+		return uuid.Nil, apiErr
+	}
+
+	var editor models.Editor
+	if err := template.Data.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse data of template %v. Error %v", templateID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	draftID, err := service.draftRepository.CreateDraft(ctx, txn, models.CreateDraft{
+		Data:      template.Data,
+		UserID:    userID,
+		Tagline:   template.Tagline,
+		Interests: template.Interests,
+	})
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to create draft from template %v. Error %v", templateID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	linked := false
+	for _, image := range draftImageBlocks(editor) {
+		uploadID, err := service.templateRepository.GetImage(ctx, templateID, image.draftID, image.imageID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			_ = txn.Rollback()
+			logger.Errorf("Error occurred while fetching image %v of template %v. Error %v", image.imageID, templateID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+
+		newImageID, err := service.draftRepository.AddImage(ctx, txn, request.PreviewImageSaveRequest{UserID: userID, DraftID: draftID, UploadID: uploadID})
+		if err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("Error occurred while saving image for draft %v. Error %v", draftID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+
+		image.file["url"] = strings.Replace(image.url, image.match, fmt.Sprintf("draft/image/%s/%s", draftID, newImageID), 1)
+		linked = true
+	}
+
+	if linked {
+		data, err := json.Marshal(editor)
+		if err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to marshal content of draft %v. Error %v", draftID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+
+		// a new draft starts at version 1
+		_, err = service.draftRepository.SavePostDraft(ctx, txn, models.UpsertDraft{
+			DraftID: draftID,
+			UserID:  userID,
+			Data:    models.JSONString{JSONText: data},
+			Version: 1,
+		})
+		if err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to save images of draft %v. Error %v", draftID, err)
+			return uuid.Nil, constants.StoryInternalServerError(err.Error())
+		}
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit draft %v. Error %v", draftID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully created draft %v from template %v", draftID, templateID)
+	return draftID, nil
+}
+
+func NewDraftTemplateService(templateRepository repository.DraftTemplateRepository, draftRepository repository.DraftRepository, manager helper.TransactionManager) DraftTemplateService {
+	return draftTemplateService{
+		templateRepository: templateRepository,
+		draftRepository:    draftRepository,
+		transactionManager: manager,
+	}
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"testing"
+)
+
+type DraftTemplateServiceTest struct {
+	suite.Suite
+	mockController              *gomock.Controller
+	goContext                   context.Context
+	mockDraftTemplateRepository *mocks.MockDraftTemplateRepository
+	mockDraftRepository         *mocks.MockDraftRepository
+	mockTransaction             *mocks.MockTransaction
+	mockTransactionManager      *mocks.MockTransactionManager
+	draftTemplateService        DraftTemplateService
+}
+
+func TestDraftTemplateServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DraftTemplateServiceTest))
+}
+
+func (suite *DraftTemplateServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockDraftTemplateRepository = mocks.NewMockDraftTemplateRepository(suite.mockController)
+	suite.mockDraftRepository = mocks.NewMockDraftRepository(suite.mockController)
+	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
+	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
+	suite.draftTemplateService = NewDraftTemplateService(suite.mockDraftTemplateRepository, suite.mockDraftRepository, suite.mockTransactionManager)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *DraftTemplateServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *DraftTemplateServiceTest) TestSaveAsTemplate_WhenSuccess() {
+	saveRequest := request.SaveTemplateRequest{UserID: uuid.New(), DraftID: uuid.New(), Name: "weekly digest"}
+	templateID := uuid.New()
+	draft := db.Draft{DraftID: saveRequest.DraftID, Data: models.JSONString{JSONText: []byte(`{"blocks":[]}`)}}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, saveRequest.DraftID, saveRequest.UserID).Return(draft, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().CreateFromDraft(suite.goContext, suite.mockTransaction, saveRequest).Return(templateID, nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	actualID, err := suite.draftTemplateService.SaveAsTemplate(suite.goContext, saveRequest)
+
+	suite.Nil(err)
+	suite.Equal(templateID, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestSaveAsTemplate_WhenDraftHasImages() {
+	saveRequest := request.SaveTemplateRequest{UserID: uuid.New(), DraftID: uuid.New(), Name: "weekly digest"}
+	templateID, imageID := uuid.New(), uuid.New()
+	url := "/api/post/v1/draft/image/" + saveRequest.DraftID.String() + "/" + imageID.String()
+	draft := db.Draft{DraftID: saveRequest.DraftID, Data: models.JSONString{JSONText: []byte(`{"blocks":[{"id":"a","type":"image","data":{"file":{"url":"` + url + `"}}}]}`)}}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, saveRequest.DraftID, saveRequest.UserID).Return(draft, nil).Times(2)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().CreateFromDraft(suite.goContext, suite.mockTransaction, saveRequest).Return(templateID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftImage(suite.goContext, saveRequest.DraftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, templateID, saveRequest.DraftID, imageID, "draft/some-key.jpg").Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	actualID, err := suite.draftTemplateService.SaveAsTemplate(suite.goContext, saveRequest)
+
+	suite.Nil(err)
+	suite.Equal(templateID, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestSaveAsTemplate_WhenImageRegistrationFails() {
+	saveRequest := request.SaveTemplateRequest{UserID: uuid.New(), DraftID: uuid.New(), Name: "weekly digest"}
+	templateID, imageID := uuid.New(), uuid.New()
+	url := "/api/post/v1/draft/image/" + saveRequest.DraftID.String() + "/" + imageID.String()
+	draft := db.Draft{DraftID: saveRequest.DraftID, Data: models.JSONString{JSONText: []byte(`{"blocks":[{"id":"a","type":"image","data":{"file":{"url":"` + url + `"}}}]}`)}}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, saveRequest.DraftID, saveRequest.UserID).Return(draft, nil).Times(2)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().CreateFromDraft(suite.goContext, suite.mockTransaction, saveRequest).Return(templateID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetDraftImage(suite.goContext, saveRequest.DraftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, templateID, saveRequest.DraftID, imageID, "draft/some-key.jpg").Return(errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	actualID, err := suite.draftTemplateService.SaveAsTemplate(suite.goContext, saveRequest)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+	suite.Equal(uuid.Nil, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestSaveAsTemplate_WhenDraftIsNotFound() {
+	saveRequest := request.SaveTemplateRequest{UserID: uuid.New(), DraftID: uuid.New(), Name: "weekly digest"}
+
+	suite.mockDraftRepository.EXPECT().GetDraftByUser(suite.goContext, saveRequest.DraftID, saveRequest.UserID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+
+	actualID, err := suite.draftTemplateService.SaveAsTemplate(suite.goContext, saveRequest)
+
+	suite.Equal(&constants.NoDraftFoundError, err)
+	suite.Equal(uuid.Nil, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestCreateDraftFromTemplate_WhenSuccess() {
+	templateID, userID, draftID := uuid.New(), uuid.New(), uuid.New()
+	tagline := "a tagline"
+	template := db.DraftTemplate{
+		ID:        templateID,
+		UserID:    uuid.New(),
+		Data:      models.JSONString{JSONText: []byte(`{"blocks":[]}`)},
+		Tagline:   &tagline,
+		Interests: pq.StringArray{"Sports", "Art"},
+		IsPublic:  true,
+	}
+
+	suite.mockDraftTemplateRepository.EXPECT().GetTemplate(suite.goContext, templateID, userID).Return(template, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, models.CreateDraft{
+		Data:      template.Data,
+		UserID:    userID,
+		Tagline:   &tagline,
+		Interests: []string{"Sports", "Art"},
+	}).Return(draftID, nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	actualID, err := suite.draftTemplateService.CreateDraftFromTemplate(suite.goContext, templateID, userID)
+
+	suite.Nil(err)
+	suite.Equal(draftID, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestCreateDraftFromTemplate_WhenTemplateHasImages() {
+	templateID, userID, draftID, sourceDraftID, imageID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	url := "/api/post/v1/draft/image/" + sourceDraftID.String() + "/" + imageID.String()
+	template := db.DraftTemplate{
+		ID:   templateID,
+		Data: models.JSONString{JSONText: []byte(`{"blocks":[{"id":"a","type":"image","data":{"file":{"url":"` + url + `"}}}]}`)},
+	}
+	newImageID := uuid.NewString()
+
+	suite.mockDraftTemplateRepository.EXPECT().GetTemplate(suite.goContext, templateID, userID).Return(template, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, models.CreateDraft{Data: template.Data, UserID: userID}).Return(draftID, nil).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().GetImage(suite.goContext, templateID, sourceDraftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockDraftRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, request.PreviewImageSaveRequest{UserID: userID, DraftID: draftID, UploadID: "draft/some-key.jpg"}).Return(newImageID, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().SavePostDraft(suite.goContext, suite.mockTransaction, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ interface{}, saved models.UpsertDraft) (int64, error) {
+			var editor models.Editor
+			suite.Nil(saved.Data.Unmarshal(&editor))
+			suite.Equal(draftID, saved.DraftID)
+			suite.Equal(int64(1), saved.Version)
+			suite.Equal("/api/post/v1/draft/image/"+draftID.String()+"/"+newImageID, editor.Blocks[0].Data["file"].(map[string]interface{})["url"])
+			return 2, nil
+		}).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	actualID, err := suite.draftTemplateService.CreateDraftFromTemplate(suite.goContext, templateID, userID)
+
+	suite.Nil(err)
+	suite.Equal(draftID, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestCreateDraftFromTemplate_WhenSavingImageFailsRollsBack() {
+	templateID, userID, draftID, sourceDraftID, imageID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	url := "/api/post/v1/draft/image/" + sourceDraftID.String() + "/" + imageID.String()
+	template := db.DraftTemplate{
+		ID:   templateID,
+		Data: models.JSONString{JSONText: []byte(`{"blocks":[{"id":"a","type":"image","data":{"file":{"url":"` + url + `"}}}]}`)},
+	}
+
+	suite.mockDraftTemplateRepository.EXPECT().GetTemplate(suite.goContext, templateID, userID).Return(template, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, models.CreateDraft{Data: template.Data, UserID: userID}).Return(draftID, nil).Times(1)
+	suite.mockDraftTemplateRepository.EXPECT().GetImage(suite.goContext, templateID, sourceDraftID, imageID).Return("draft/some-key.jpg", nil).Times(1)
+	suite.mockDraftRepository.EXPECT().AddImage(suite.goContext, suite.mockTransaction, gomock.Any()).Return("", errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Times(0)
+
+	actualID, err := suite.draftTemplateService.CreateDraftFromTemplate(suite.goContext, templateID, userID)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+	suite.Equal(uuid.Nil, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestCreateDraftFromTemplate_WhenTemplateIsNotFound() {
+	templateID, userID := uuid.New(), uuid.New()
+
+	suite.mockDraftTemplateRepository.EXPECT().GetTemplate(suite.goContext, templateID, userID).Return(db.DraftTemplate{}, sql.ErrNoRows).Times(1)
+
+	actualID, err := suite.draftTemplateService.CreateDraftFromTemplate(suite.goContext, templateID, userID)
+
+	suite.Equal(&constants.NoTemplateFoundError, err)
+	suite.Equal(uuid.Nil, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestCreateDraftFromTemplate_WhenDraftCreationFails() {
+	templateID, userID := uuid.New(), uuid.New()
+
+	suite.mockDraftTemplateRepository.EXPECT().GetTemplate(suite.goContext, templateID, userID).Return(db.DraftTemplate{ID: templateID, Data: models.JSONString{JSONText: []byte(`{"blocks":[]}`)}}, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().CreateDraft(suite.goContext, suite.mockTransaction, models.CreateDraft{Data: models.JSONString{JSONText: []byte(`{"blocks":[]}`)}, UserID: userID}).Return(uuid.Nil, errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	actualID, err := suite.draftTemplateService.CreateDraftFromTemplate(suite.goContext, templateID, userID)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+	suite.Equal(uuid.Nil, actualID)
+}
+
+func (suite *DraftTemplateServiceTest) TestShareTemplate_WhenTemplateIsNotOwnedByUser() {
+	templateID, userID := uuid.New(), uuid.New()
+
+	suite.mockDraftTemplateRepository.EXPECT().UpdateVisibility(suite.goContext, templateID, userID, true).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.draftTemplateService.ShareTemplate(suite.goContext, templateID, userID, true)
+
+	suite.Equal(&constants.NoTemplateFoundError, err)
+}
+
+func (suite *DraftTemplateServiceTest) TestDeleteTemplate_WhenSuccess() {
+	templateID, userID := uuid.New(), uuid.New()
+
+	suite.mockDraftTemplateRepository.EXPECT().Delete(suite.goContext, templateID, userID).Return(nil).Times(1)
+
+	err := suite.draftTemplateService.DeleteTemplate(suite.goContext, templateID, userID)
+
+	suite.Nil(err)
+}
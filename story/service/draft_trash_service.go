@@ -0,0 +1,83 @@
+package service
+
+//go:generate mockgen -source=draft_trash_service.go -destination=./../mocks/mock_draft_trash_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/models/db"
+	"post-api/story/repository"
+	"post-api/story/utils"
+	"time"
+)
+
+const defaultTrashRetention = 30 * 24 * time.Hour
+
+type DraftTrashService interface {
+	GetTrashedDrafts(ctx context.Context, userID uuid.UUID) ([]db.TrashedDraft, *golaerror.Error)
+	RestoreDraft(ctx context.Context, draftID, userID uuid.UUID) *golaerror.Error
+}
+
+type draftTrashService struct {
+	draftRepository repository.DraftRepository
+	retention       time.Duration
+}
+
+// GetTrashedDrafts lists the deleted drafts of the user, latest first, with the time each one is purged.
+func (service draftTrashService) GetTrashedDrafts(ctx context.Context, userID uuid.UUID) ([]db.TrashedDraft, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTrashService").WithField("method", "GetTrashedDrafts")
+
+	drafts, err := service.draftRepository.GetTrashedDrafts(ctx, userID, service.retention)
+	if err != nil {
+		logger.Errorf("unable to fetch trashed drafts of user %v. Error %v", userID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	for i := range drafts {
+		title, _, err := utils.GetTitleAndTaglineFromData(ctx, drafts[i].Data)
+		if err != nil {
+			logger.Errorf("Error occurred while converting title json to string %v .%v", drafts[i].DraftID, err)
+			return nil, &constants.ConvertTitleToStringError
+		}
+		drafts[i].Title = title
+		drafts[i].PurgeAt = drafts[i].DeletedAt.Add(service.retention)
+	}
+
+	return drafts, nil
+}
+
+// RestoreDraft moves a draft back out of the trash as long as its retention has not run out.
+func (service draftTrashService) RestoreDraft(ctx context.Context, draftID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "DraftTrashService").WithField("method", "RestoreDraft")
+
+	err := service.draftRepository.RestoreDraft(ctx, draftID, userID, service.retention)
+	if err != nil {
+		logger.Errorf("unable to restore draft %v. Error %v", draftID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoDraftFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully restored draft %v", draftID)
+	return nil
+}
+
+func trashRetention(retentionInDays int) time.Duration {
+	if retentionInDays <= 0 {
+		return defaultTrashRetention
+	}
+	return time.Duration(retentionInDays) * 24 * time.Hour
+}
+
+func NewDraftTrashService(draftRepository repository.DraftRepository, config configuration.DraftTrash) DraftTrashService {
+	return draftTrashService{
+		draftRepository: draftRepository,
+		retention:       trashRetention(config.RetentionInDays),
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models"
+	"post-api/story/models/db"
+	"testing"
+	"time"
+)
+
+type DraftTrashServiceTest struct {
+	suite.Suite
+	mockController      *gomock.Controller
+	goContext           context.Context
+	mockDraftRepository *mocks.MockDraftRepository
+	draftTrashService   DraftTrashService
+}
+
+func TestDraftTrashServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DraftTrashServiceTest))
+}
+
+func (suite *DraftTrashServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockDraftRepository = mocks.NewMockDraftRepository(suite.mockController)
+	suite.draftTrashService = NewDraftTrashService(suite.mockDraftRepository, configuration.DraftTrash{RetentionInDays: 7})
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *DraftTrashServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *DraftTrashServiceTest) TestGetTrashedDrafts_WhenSuccess() {
+	userID := uuid.New()
+	deletedAt := time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+	data := `{"blocks":[{"id":"a","type":"header","data":{"text":"Draft title","level":1}}]}`
+	drafts := []db.TrashedDraft{{DraftID: uuid.New(), UserID: userID, Data: models.JSONString{JSONText: []byte(data)}, DeletedAt: deletedAt}}
+
+	suite.mockDraftRepository.EXPECT().GetTrashedDrafts(suite.goContext, userID, 7*24*time.Hour).Return(drafts, nil).Times(1)
+
+	trashed, err := suite.draftTrashService.GetTrashedDrafts(suite.goContext, userID)
+
+	suite.Nil(err)
+	suite.Equal("Draft title", trashed[0].Title)
+	suite.Equal(deletedAt.Add(7*24*time.Hour), trashed[0].PurgeAt)
+}
+
+func (suite *DraftTrashServiceTest) TestRestoreDraft_WhenSuccess() {
+	draftID, userID := uuid.New(), uuid.New()
+
+	suite.mockDraftRepository.EXPECT().RestoreDraft(suite.goContext, draftID, userID, 7*24*time.Hour).Return(nil).Times(1)
+
+	err := suite.draftTrashService.RestoreDraft(suite.goContext, draftID, userID)
+
+	suite.Nil(err)
+}
+
+func (suite *DraftTrashServiceTest) TestRestoreDraft_WhenRetentionHasRunOut() {
+	draftID, userID := uuid.New(), uuid.New()
+
+	suite.mockDraftRepository.EXPECT().RestoreDraft(suite.goContext, draftID, userID, 7*24*time.Hour).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.draftTrashService.RestoreDraft(suite.goContext, draftID, userID)
+
+	suite.Equal(&constants.NoDraftFoundError, err)
+}
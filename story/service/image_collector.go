@@ -0,0 +1,186 @@
+package service
+
+//go:generate mockgen -source=image_collector.go -destination=./../mocks/mock_image_collector.go -package=mocks
+
+import (
+	"context"
+	"post-api/configuration"
+	"post-api/service"
+	"post-api/story/models/response"
+	"post-api/story/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+)
+
+const (
+	defaultCollectInterval = 24 * time.Hour
+	defaultGracePeriod     = 72 * time.Hour
+)
+
+// imagePrefixes are the S3 prefixes user uploads are stored under.
+var imagePrefixes = []string{"draft/", "profile/"}
+
+type ImageCollector interface {
+	Start(ctx context.Context)
+	Collect(ctx context.Context) (response.ImageCollectionReport, error)
+}
+
+// imageCollector deletes uploaded images nothing refers to anymore. An image is referenced when an image
+// block of a draft, post, template or one of their revisions points to a draft image registered for it, or when it
+// is stored as a preview image or avatar or registered for a template. Only objects older than the grace
+// period are deleted, so uploads that are not saved into a draft yet are left alone.
+type imageCollector struct {
+	imageRepository repository.ImageRepository
+	awsServices     service.AwsServices
+	interval        time.Duration
+	gracePeriod     time.Duration
+	dryRun          bool
+}
+
+func (collector imageCollector) Start(ctx context.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageCollector").WithField("method", "Start")
+	logger.Infof("Starting image collector with interval %v, dry run %v", collector.interval, collector.dryRun)
+
+	ticker := time.NewTicker(collector.interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := collector.Collect(ctx)
+		if err != nil {
+			logger.Errorf("unable to collect orphaned images %v", err)
+		} else {
+			logger.Infof("Scanned %v images, found %v orphaned and deleted %v", report.Scanned, len(report.Orphans), report.Deleted)
+		}
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping image collector")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (collector imageCollector) Collect(ctx context.Context) (response.ImageCollectionReport, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageCollector").WithField("method", "Collect")
+
+	referenced, unlinked, err := collector.referencedKeys(ctx)
+	if err != nil {
+		return response.ImageCollectionReport{}, err
+	}
+
+	report := response.ImageCollectionReport{DryRun: collector.dryRun}
+	cutoff := time.Now().Add(-collector.gracePeriod)
+	for _, prefix := range imagePrefixes {
+		objects, err := collector.awsServices.ListObjectsInS3(prefix)
+		if err != nil {
+			logger.Errorf("unable to list objects under %v. Error %v", prefix, err)
+			return response.ImageCollectionReport{}, err
+		}
+		for _, object := range objects {
+			report.Scanned++
+			if referenced[object.Key] || object.LastModified.After(cutoff) {
+				continue
+			}
+			report.Orphans = append(report.Orphans, response.OrphanedImage{
+				Key:           object.Key,
+				LastModified:  object.LastModified,
+				DraftImageIDs: unlinked[object.Key],
+			})
+		}
+	}
+
+	if collector.dryRun {
+		for _, orphan := range report.Orphans {
+			logger.Infof("Dry run: would delete %v last modified at %v", orphan.Key, orphan.LastModified)
+		}
+		return report, nil
+	}
+
+	for _, orphan := range report.Orphans {
+		// rows go first, an object left behind by a failed delete is picked up again on the next run
+		if len(orphan.DraftImageIDs) > 0 {
+			if err := collector.imageRepository.DeleteDraftImages(ctx, orphan.DraftImageIDs); err != nil {
+				logger.Errorf("unable to delete draft images of %v. Error %v", orphan.Key, err)
+				continue
+			}
+		}
+		if err := collector.awsServices.DeleteObjectInS3(orphan.Key); err != nil {
+			logger.Errorf("unable to delete object %v. Error %v", orphan.Key, err)
+			continue
+		}
+		report.Deleted++
+	}
+
+	return report, nil
+}
+
+// referencedKeys returns the keys that are in use and, for the rest, the draft images registered for them.
+func (collector imageCollector) referencedKeys(ctx context.Context) (map[string]bool, map[string][]uuid.UUID, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ImageCollector").WithField("method", "referencedKeys")
+
+	urls, err := collector.imageRepository.GetImageBlockURLs(ctx)
+	if err != nil {
+		logger.Errorf("unable to fetch image block urls %v", err)
+		return nil, nil, err
+	}
+
+	linked := map[string]bool{}
+	for _, url := range urls {
+		match := draftImagePattern.FindStringSubmatch(url)
+		if match == nil {
+			continue
+		}
+		draftID, draftErr := uuid.Parse(match[1])
+		imageID, imageErr := uuid.Parse(match[2])
+		if draftErr != nil || imageErr != nil {
+			continue
+		}
+		linked[draftID.String()+"/"+imageID.String()] = true
+	}
+
+	images, err := collector.imageRepository.GetDraftImages(ctx)
+	if err != nil {
+		logger.Errorf("unable to fetch draft images %v", err)
+		return nil, nil, err
+	}
+
+	referenced := map[string]bool{}
+	unlinked := map[string][]uuid.UUID{}
+	for _, image := range images {
+		if linked[image.DraftID.String()+"/"+image.ID.String()] {
+			referenced[image.UploadID] = true
+			continue
+		}
+		unlinked[image.UploadID] = append(unlinked[image.UploadID], image.ID)
+	}
+
+	keys, err := collector.imageRepository.GetStoredImageKeys(ctx)
+	if err != nil {
+		logger.Errorf("unable to fetch stored image keys %v", err)
+		return nil, nil, err
+	}
+	for _, key := range keys {
+		referenced[key] = true
+	}
+
+	return referenced, unlinked, nil
+}
+
+func NewImageCollector(imageRepository repository.ImageRepository, awsServices service.AwsServices, config configuration.ImageCollector) ImageCollector {
+	collector := imageCollector{
+		imageRepository: imageRepository,
+		awsServices:     awsServices,
+		interval:        time.Duration(config.IntervalInSeconds) * time.Second,
+		gracePeriod:     time.Duration(config.GracePeriodInHours) * time.Hour,
+		dryRun:          config.DryRun,
+	}
+	if collector.interval <= 0 {
+		collector.interval = defaultCollectInterval
+	}
+	if collector.gracePeriod <= 0 {
+		collector.gracePeriod = defaultGracePeriod
+	}
+	return collector
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/models"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"post-api/story/models/response"
+	"testing"
+	"time"
+)
+
+type ImageCollectorTest struct {
+	suite.Suite
+	mockController      *gomock.Controller
+	goContext           context.Context
+	mockImageRepository *mocks.MockImageRepository
+	mockAwsServices     *mocks.MockAwsServices
+}
+
+func TestImageCollectorTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageCollectorTest))
+}
+
+func (suite *ImageCollectorTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockImageRepository = mocks.NewMockImageRepository(suite.mockController)
+	suite.mockAwsServices = mocks.NewMockAwsServices(suite.mockController)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *ImageCollectorTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *ImageCollectorTest) expectReferences(draftID, linkedImageID, unlinkedImageID uuid.UUID) {
+	suite.mockImageRepository.EXPECT().GetImageBlockURLs(suite.goContext).Return([]string{
+		"/api/post/v1/draft/image/" + draftID.String() + "/" + linkedImageID.String(),
+		"https://example.com/cat.png",
+	}, nil).Times(1)
+	suite.mockImageRepository.EXPECT().GetDraftImages(suite.goContext).Return([]db.DraftImage{
+		{ID: linkedImageID, DraftID: draftID, UploadID: "draft/linked.png"},
+		{ID: unlinkedImageID, DraftID: draftID, UploadID: "draft/removed.png"},
+	}, nil).Times(1)
+	suite.mockImageRepository.EXPECT().GetStoredImageKeys(suite.goContext).Return([]string{"draft/preview.png", "profile/avatar.png"}, nil).Times(1)
+}
+
+func (suite *ImageCollectorTest) TestCollect_WhenDryRunOnlyReportsOrphans() {
+	draftID, linkedImageID, unlinkedImageID := uuid.New(), uuid.New(), uuid.New()
+	old := time.Now().Add(-100 * time.Hour)
+	collector := NewImageCollector(suite.mockImageRepository, suite.mockAwsServices, configuration.ImageCollector{GracePeriodInHours: 72, DryRun: true})
+
+	suite.expectReferences(draftID, linkedImageID, unlinkedImageID)
+	suite.mockAwsServices.EXPECT().ListObjectsInS3("draft/").Return([]models.S3Object{
+		{Key: "draft/linked.png", LastModified: old},
+		{Key: "draft/removed.png", LastModified: old},
+		{Key: "draft/preview.png", LastModified: old},
+		{Key: "draft/never-saved.png", LastModified: old},
+		{Key: "draft/just-uploaded.png", LastModified: time.Now()},
+	}, nil).Times(1)
+	suite.mockAwsServices.EXPECT().ListObjectsInS3("profile/").Return([]models.S3Object{
+		{Key: "profile/avatar.png", LastModified: old},
+		{Key: "profile/old-avatar.png", LastModified: old},
+	}, nil).Times(1)
+
+	report, err := collector.Collect(suite.goContext)
+
+	suite.Nil(err)
+	suite.Equal(response.ImageCollectionReport{
+		DryRun:  true,
+		Scanned: 7,
+		Orphans: []response.OrphanedImage{
+			{Key: "draft/removed.png", LastModified: old, DraftImageIDs: []uuid.UUID{unlinkedImageID}},
+			{Key: "draft/never-saved.png", LastModified: old},
+			{Key: "profile/old-avatar.png", LastModified: old},
+		},
+	}, report)
+}
+
+func (suite *ImageCollectorTest) TestCollect_WhenNotDryRunDeletesOrphans() {
+	draftID, linkedImageID, unlinkedImageID := uuid.New(), uuid.New(), uuid.New()
+	old := time.Now().Add(-100 * time.Hour)
+	collector := NewImageCollector(suite.mockImageRepository, suite.mockAwsServices, configuration.ImageCollector{GracePeriodInHours: 72})
+
+	suite.expectReferences(draftID, linkedImageID, unlinkedImageID)
+	suite.mockAwsServices.EXPECT().ListObjectsInS3("draft/").Return([]models.S3Object{
+		{Key: "draft/linked.png", LastModified: old},
+		{Key: "draft/removed.png", LastModified: old},
+		{Key: "draft/never-saved.png", LastModified: old},
+	}, nil).Times(1)
+	suite.mockAwsServices.EXPECT().ListObjectsInS3("profile/").Return(nil, nil).Times(1)
+	suite.mockImageRepository.EXPECT().DeleteDraftImages(suite.goContext, []uuid.UUID{unlinkedImageID}).Return(nil).Times(1)
+	suite.mockAwsServices.EXPECT().DeleteObjectInS3("draft/removed.png").Return(nil).Times(1)
+	suite.mockAwsServices.EXPECT().DeleteObjectInS3("draft/never-saved.png").Return(errors.New("something went wrong")).Times(1)
+
+	report, err := collector.Collect(suite.goContext)
+
+	suite.Nil(err)
+	suite.Len(report.Orphans, 2)
+	suite.Equal(1, report.Deleted)
+}
+
+func (suite *ImageCollectorTest) TestCollect_WhenListingFailsDeletesNothing() {
+	draftID, linkedImageID, unlinkedImageID := uuid.New(), uuid.New(), uuid.New()
+	collector := NewImageCollector(suite.mockImageRepository, suite.mockAwsServices, configuration.ImageCollector{})
+
+	suite.expectReferences(draftID, linkedImageID, unlinkedImageID)
+	suite.mockAwsServices.EXPECT().ListObjectsInS3("draft/").Return(nil, errors.New("something went wrong")).Times(1)
+
+	_, err := collector.Collect(suite.goContext)
+
+	suite.NotNil(err)
+}
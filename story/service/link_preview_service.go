@@ -0,0 +1,209 @@
+package service
+
+//go:generate mockgen -source=link_preview_service.go -destination=./../mocks/mock_link_preview_service.go -package=mocks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"github.com/inclusi-blog/gola-utils/redis_util"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/models"
+	"post-api/story/utils"
+	"time"
+)
+
+const (
+	linkPreviewCachePrefix               = "link-preview:"
+	linkPreviewFailureCachePrefix        = "link-preview-failed:"
+	defaultLinkPreviewTimeout            = 5
+	defaultLinkPreviewMaxBodyInKB        = 512
+	defaultLinkPreviewCacheExpiry        = 24 * 60
+	defaultLinkPreviewFailureCacheExpiry = 10
+	defaultMaxLinksPerSave               = 5
+)
+
+type LinkPreviewService interface {
+	Unfurl(ctx context.Context, link string) (models.LinkMeta, *golaerror.Error)
+	FillLinkPreviews(ctx context.Context, content models.JSONString) models.JSONString
+}
+
+type linkPreviewService struct {
+	client             *http.Client
+	store              redis_util.RedisStore
+	timeout            time.Duration
+	maxBodyBytes       int64
+	cacheExpiry        int
+	failureCacheExpiry int
+	maxLinksPerSave    int
+}
+
+// Unfurl returns the title, description and image of the page a link points to. Previews are cached, so
+// a page is fetched once for all the drafts that link to it. Links that cannot be previewed are cached for
+// a shorter while, so a dead link is not fetched again on every save.
+func (service linkPreviewService) Unfurl(ctx context.Context, link string) (models.LinkMeta, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "LinkPreviewService").WithField("method", "Unfurl")
+
+	linkURL, err := url.Parse(link)
+	if err != nil || (linkURL.Scheme != "http" && linkURL.Scheme != "https") || linkURL.Host == "" {
+		logger.Errorf("invalid link %v for preview", link)
+		return models.LinkMeta{}, &constants.InvalidLinkError
+	}
+
+	key := linkPreviewCacheKey(link)
+	failureKey := linkPreviewFailureCacheKey(link)
+	var meta models.LinkMeta
+	if service.store != nil {
+		if err = service.store.Get(ctx, key, &meta); err == nil {
+			return meta, nil
+		}
+		var failure string
+		if err = service.store.Get(ctx, failureKey, &failure); err == nil {
+			logger.Infof("preview of link %v failed recently, skipping it", link)
+			return models.LinkMeta{}, &constants.LinkPreviewUnavailableError
+		}
+	}
+
+	meta, err = service.fetch(ctx, linkURL)
+	if err != nil {
+		logger.Errorf("unable to fetch preview of link %v. Error %v", link, err)
+		// a fetch cut short by the caller says nothing about the link, so only the link's own failures are cached
+		if service.store != nil && ctx.Err() == nil {
+			if err = service.store.Set(ctx, failureKey, err.Error(), service.failureCacheExpiry); err != nil {
+				logger.Errorf("unable to cache failed preview of link %v. Error %v", link, err)
+			}
+		}
+		return models.LinkMeta{}, &constants.LinkPreviewUnavailableError
+	}
+
+	if service.store != nil {
+		if err = service.store.Set(ctx, key, meta, service.cacheExpiry); err != nil {
+			logger.Errorf("unable to cache preview of link %v. Error %v", link, err)
+		}
+	}
+	return meta, nil
+}
+
+func (service linkPreviewService) fetch(ctx context.Context, linkURL *url.URL) (models.LinkMeta, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, linkURL.String(), nil)
+	if err != nil {
+		return models.LinkMeta{}, err
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	req.Header.Set("User-Agent", "post-api link preview")
+
+	resp, err := service.client.Do(req)
+	if err != nil {
+		return models.LinkMeta{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return models.LinkMeta{}, fmt.Errorf("unexpected status %v", resp.StatusCode)
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return models.LinkMeta{}, fmt.Errorf("unsupported content type %v", mediaType)
+	}
+
+	return utils.ParseLinkMeta(io.LimitReader(resp.Body, service.maxBodyBytes), resp.Request.URL), nil
+}
+
+// FillLinkPreviews sets the preview of link blocks that have none yet. Links are previewed on a best
+// effort basis, a link that cannot be previewed is left for the client to fill in. All the links of a save
+// share one fetch timeout, so a save never waits longer than a single preview would.
+func (service linkPreviewService) FillLinkPreviews(ctx context.Context, content models.JSONString) models.JSONString {
+	logger := logging.GetLogger(ctx).WithField("class", "LinkPreviewService").WithField("method", "FillLinkPreviews")
+
+	var editor models.Editor
+	if err := content.Unmarshal(&editor); err != nil {
+		return content
+	}
+
+	fillCtx, cancel := context.WithTimeout(ctx, service.timeout)
+	defer cancel()
+
+	filled := 0
+	for _, block := range editor.Blocks {
+		if filled == service.maxLinksPerSave || fillCtx.Err() != nil {
+			break
+		}
+		if !block.Type.IsEqual(models.LinkTool) {
+			continue
+		}
+		link, _ := block.Data["link"].(string)
+		currentMeta, _ := block.Data["meta"].(map[string]interface{})
+		if title, _ := currentMeta["title"].(string); link == "" || title != "" {
+			continue
+		}
+
+		meta, apiErr := service.Unfurl(fillCtx, link)
+		if apiErr != nil {
+			continue
+		}
+		block.Data["meta"] = map[string]interface{}{
+			"title":       meta.Title,
+			"description": meta.Description,
+			"image":       map[string]interface{}{"url": meta.Image.URL},
+		}
+		filled++
+	}
+	if filled == 0 {
+		return content
+	}
+
+	data, err := json.Marshal(editor)
+	if err != nil {
+		logger.Errorf("unable to marshal content with link previews %v", err)
+		return content
+	}
+
+	logger.Infof("filled in previews of %v links", filled)
+	return models.JSONString{JSONText: data}
+}
+
+func linkPreviewCacheKey(link string) string {
+	hash := sha256.Sum256([]byte(link))
+	return linkPreviewCachePrefix + hex.EncodeToString(hash[:])
+}
+
+func linkPreviewFailureCacheKey(link string) string {
+	hash := sha256.Sum256([]byte(link))
+	return linkPreviewFailureCachePrefix + hex.EncodeToString(hash[:])
+}
+
+func NewLinkPreviewService(store redis_util.RedisStore, config configuration.LinkPreview) LinkPreviewService {
+	if config.TimeoutInSeconds <= 0 {
+		config.TimeoutInSeconds = defaultLinkPreviewTimeout
+	}
+	if config.MaxBodyInKB <= 0 {
+		config.MaxBodyInKB = defaultLinkPreviewMaxBodyInKB
+	}
+	if config.CacheExpiryInMinutes <= 0 {
+		config.CacheExpiryInMinutes = defaultLinkPreviewCacheExpiry
+	}
+	if config.FailureCacheExpiryInMinutes <= 0 {
+		config.FailureCacheExpiryInMinutes = defaultLinkPreviewFailureCacheExpiry
+	}
+	if config.MaxLinksPerSave <= 0 {
+		config.MaxLinksPerSave = defaultMaxLinksPerSave
+	}
+	return linkPreviewService{
+		client:             utils.NewLinkPreviewClient(config),
+		store:              store,
+		timeout:            time.Duration(config.TimeoutInSeconds) * time.Second,
+		maxBodyBytes:       int64(config.MaxBodyInKB) << 10,
+		cacheExpiry:        config.CacheExpiryInMinutes,
+		failureCacheExpiry: config.FailureCacheExpiryInMinutes,
+		maxLinksPerSave:    config.MaxLinksPerSave,
+	}
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models"
+	"testing"
+)
+
+const linkPreviewPage = `<html><head><title>Page title</title><meta property="og:description" content="About the page"><meta property="og:image" content="/cover.png"></head><body></body></html>`
+
+type LinkPreviewServiceTest struct {
+	suite.Suite
+	mockController     *gomock.Controller
+	goContext          context.Context
+	mockRedisStore     *mocks.MockRedisStore
+	server             *httptest.Server
+	requests           int
+	linkPreviewService LinkPreviewService
+}
+
+func TestLinkPreviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LinkPreviewServiceTest))
+}
+
+func (suite *LinkPreviewServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockRedisStore = mocks.NewMockRedisStore(suite.mockController)
+	suite.requests = 0
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.requests++
+		if r.URL.Path == "/file" {
+			w.Header().Set("Content-Type", "application/pdf")
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(linkPreviewPage))
+	}))
+	config := configuration.LinkPreview{TimeoutInSeconds: 1, CacheExpiryInMinutes: 10, AllowPrivateNetworks: true}
+	suite.linkPreviewService = NewLinkPreviewService(suite.mockRedisStore, config)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *LinkPreviewServiceTest) TearDownTest() {
+	suite.server.Close()
+	suite.mockController.Finish()
+}
+
+func (suite *LinkPreviewServiceTest) TestUnfurl_WhenLinkIsNotCached() {
+	link := suite.server.URL + "/post"
+	expected := models.LinkMeta{Title: "Page title", Description: "About the page"}
+	expected.Image.URL = suite.server.URL + "/cover.png"
+
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewFailureCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Set(suite.goContext, linkPreviewCacheKey(link), expected, 10).Return(nil).Times(1)
+
+	meta, err := suite.linkPreviewService.Unfurl(suite.goContext, link)
+
+	suite.Nil(err)
+	suite.Equal(expected, meta)
+	suite.Equal(1, suite.requests)
+}
+
+func (suite *LinkPreviewServiceTest) TestUnfurl_WhenLinkIsCached() {
+	link := suite.server.URL + "/post"
+
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewCacheKey(link), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, dest interface{}) error {
+		dest.(*models.LinkMeta).Title = "Cached title"
+		return nil
+	}).Times(1)
+
+	meta, err := suite.linkPreviewService.Unfurl(suite.goContext, link)
+
+	suite.Nil(err)
+	suite.Equal("Cached title", meta.Title)
+	suite.Equal(0, suite.requests)
+}
+
+func (suite *LinkPreviewServiceTest) TestUnfurl_WhenLinkFailedRecently() {
+	link := suite.server.URL + "/post"
+
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewFailureCacheKey(link), gomock.Any()).Return(nil).Times(1)
+
+	_, err := suite.linkPreviewService.Unfurl(suite.goContext, link)
+
+	suite.Equal(&constants.LinkPreviewUnavailableError, err)
+	suite.Equal(0, suite.requests)
+}
+
+func (suite *LinkPreviewServiceTest) TestUnfurl_WhenLinkIsNotHTTP() {
+	_, err := suite.linkPreviewService.Unfurl(suite.goContext, "file:///etc/passwd")
+
+	suite.Equal(&constants.InvalidLinkError, err)
+}
+
+func (suite *LinkPreviewServiceTest) TestUnfurl_WhenLinkIsNotAPage() {
+	link := suite.server.URL + "/file"
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewFailureCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Set(suite.goContext, linkPreviewFailureCacheKey(link), gomock.Any(), 10).Return(nil).Times(1)
+
+	_, err := suite.linkPreviewService.Unfurl(suite.goContext, link)
+
+	suite.Equal(&constants.LinkPreviewUnavailableError, err)
+}
+
+func (suite *LinkPreviewServiceTest) TestUnfurl_WhenLinkPointsToPrivateNetwork() {
+	link := suite.server.URL + "/post"
+	linkPreviewService := NewLinkPreviewService(suite.mockRedisStore, configuration.LinkPreview{TimeoutInSeconds: 1})
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Get(suite.goContext, linkPreviewFailureCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Set(suite.goContext, linkPreviewFailureCacheKey(link), gomock.Any(), 10).Return(nil).Times(1)
+
+	_, err := linkPreviewService.Unfurl(suite.goContext, link)
+
+	suite.Equal(&constants.LinkPreviewUnavailableError, err)
+	suite.Equal(0, suite.requests)
+}
+
+func (suite *LinkPreviewServiceTest) TestFillLinkPreviews_FillsOnlyLinksWithoutPreview() {
+	link := suite.server.URL + "/post"
+	content := models.JSONString{JSONText: []byte(`{"blocks":[` +
+		`{"id":"a","type":"linkTool","data":{"link":"` + link + `","meta":{}}},` +
+		`{"id":"b","type":"linkTool","data":{"link":"https://example.com","meta":{"title":"Set by client"}}}]}`)}
+
+	suite.mockRedisStore.EXPECT().Get(gomock.Any(), linkPreviewCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Get(gomock.Any(), linkPreviewFailureCacheKey(link), gomock.Any()).Return(errors.New("redis: nil")).Times(1)
+	suite.mockRedisStore.EXPECT().Set(gomock.Any(), linkPreviewCacheKey(link), gomock.Any(), 10).Return(nil).Times(1)
+
+	filled := suite.linkPreviewService.FillLinkPreviews(suite.goContext, content)
+
+	var editor models.Editor
+	suite.Nil(filled.Unmarshal(&editor))
+	suite.Equal(map[string]interface{}{"title": "Page title", "description": "About the page", "image": map[string]interface{}{"url": suite.server.URL + "/cover.png"}}, editor.Blocks[0].Data["meta"])
+	suite.Equal(map[string]interface{}{"title": "Set by client"}, editor.Blocks[1].Data["meta"])
+}
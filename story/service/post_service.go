@@ -5,10 +5,13 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"github.com/google/uuid"
+	"post-api/configuration"
 	"post-api/helper"
 	"post-api/service"
 	"post-api/story/constants"
+	"post-api/story/models"
 	"post-api/story/models/db"
 	"post-api/story/models/request"
 	"post-api/story/models/response"
@@ -22,13 +25,22 @@ import (
 )
 
 type PostService interface {
-	GetPost(ctx context.Context, postId, userId uuid.UUID) (response.Post, *golaerror.Error)
+	GetPost(ctx context.Context, postId, userId uuid.UUID, withHTML bool) (response.Post, *golaerror.Error)
+	ExportMarkdown(ctx context.Context, postID, userID uuid.UUID) (string, *golaerror.Error)
 	PublishPost(ctx context.Context, draftUID, userUUID uuid.UUID) (string, *golaerror.Error)
+	PublishToPublication(ctx context.Context, draftUID, userUUID, publicationID uuid.UUID, version int64) (string, *golaerror.Error)
+	EditPost(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, *golaerror.Error)
+	RepublishPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
+	GetPostRevisions(ctx context.Context, postID, userID uuid.UUID) ([]db.PostRevision, *golaerror.Error)
+	SetSlug(ctx context.Context, postID, userID uuid.UUID, slug string) (string, *golaerror.Error)
+	ResolvePost(ctx context.Context, url string, userID uuid.UUID, withHTML bool) (response.ResolvedPost, *golaerror.Error)
 	LikePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
 	UnLikePost(ctx context.Context, postUID, userID uuid.UUID) *golaerror.Error
 	GetPublishedPostByUser(ctx context.Context, request request.GetPublishedPostRequest) ([]response.PublishedPost, *golaerror.Error)
 	Comment(ctx context.Context, comment request.Comment) *golaerror.Error
 	GetComments(ctx context.Context, commentsRequest request.FetchComments) ([]response.Comment, *golaerror.Error)
+	GetReplies(ctx context.Context, repliesRequest request.FetchReplies) ([]response.Comment, *golaerror.Error)
+	DeleteComment(ctx context.Context, commentID, postID, userID uuid.UUID) *golaerror.Error
 	SavePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
 	MarkAsViewed(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
 	FetchSavedPosts(ctx context.Context, postRequest request.PostRequest) ([]response.PostView, *golaerror.Error)
@@ -45,57 +57,85 @@ type postService struct {
 	interestRepository     repository.InterestsRepository
 	draftRepository        repository.DraftRepository
 	abstractPostRepository repository.AbstractPostRepository
+	postRevisionRepository repository.PostRevisionRepository
+	seriesService          SeriesService
 	validator              utils.PostValidator
+	sanitizer              utils.ContentSanitizer
 	awsServices            service.AwsServices
+	fingerprintRepository  repository.PostFingerprintRepository
+	duplicateContent       configuration.DuplicateContent
+	contentFilter          utils.ContentFilter
+	reviewRepository       repository.ContentReviewRepository
+	maxCommentDepth        int
+}
+
+const (
+	flagDuplicateContent   = "flag"
+	defaultMaxCommentDepth = 5
+)
+
+// contentFingerprint is stored with the post, along with the post it nearly duplicates when the
+// duplicate was only flagged.
+type contentFingerprint struct {
+	value       uint64
+	duplicateOf *uuid.UUID
+	similarity  *float64
 }
 
 func (service postService) PublishPost(ctx context.Context, draftUID, userUUID uuid.UUID) (string, *golaerror.Error) {
-	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "PublishPost")
-	draft, err := service.draftRepository.GetDraftByUser(ctx, draftUID, userUUID)
-	if err != nil {
-		logger.Errorf("error occurred while fetching draft from draft repository %v", err)
-		if err == sql.ErrNoRows {
-			logger.Errorf("Error occurred while getting draft data, no draft found for draft id %v .%v", draftUID, err)
-			return "", &constants.NoDraftFoundError
-		}
+	return service.publish(ctx, draftUID, userUUID, nil, nil)
+}
+
+// PublishToPublication publishes an approved submission under the publication. The post stays owned by
+// the draft author. A submission of a draft that is already published under the publication is an edit,
+// it replaces the published post in place and no new url is returned. Only the version of the draft the
+// editors reviewed is published, a draft edited after it was submitted has to be submitted again.
+func (service postService) PublishToPublication(ctx context.Context, draftUID, userUUID, publicationID uuid.UUID, version int64) (string, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "PublishToPublication")
+
+	postID, err := service.repository.GetPublicationPostID(ctx, draftUID, publicationID)
+	if err == nil {
+		logger.Infof("Draft %v is already published as post %v, republishing it", draftUID, postID)
+		return "", service.republish(ctx, postID, draftUID, userUUID, &version)
+	}
+	if err != sql.ErrNoRows {
+		logger.Errorf("Error occurred while fetching post of draft %v. Error %v", draftUID, err)
 		return "", constants.StoryInternalServerError(err.Error())
 	}
-	apiErr := draft.ConvertInterests(func(interests []string) *golaerror.Error {
-		draft.InterestTags, err = service.interestRepository.GetInterestsForName(ctx, interests)
-		if err != nil {
-			logger.Errorf("unable to get interests %v", err)
-			return constants.StoryInternalServerError("something went wrong")
-		}
-		return nil
-	})
 
+	return service.publish(ctx, draftUID, userUUID, &publicationID, &version)
+}
+
+func (service postService) publish(ctx context.Context, draftUID, userUUID uuid.UUID, publicationID *uuid.UUID, version *int64) (string, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "PublishPost")
+	draft, metaData, apiErr := service.prepareDraft(ctx, draftUID, userUUID, version)
 	if apiErr != nil {
-		logger.Error("unable to get interests")
 		return "", apiErr
 	}
 
-	logger.Infof("validating draft and generated read time for post %v", draftUID)
-	metaData, validationErr := service.validator.ValidateAndGetReadTime(draft, ctx)
-
-	if validationErr != nil {
-		logger.Errorf("Error occurred while validating draft of id %v .%v", draftUID, validationErr)
-		return "", validationErr
+	// the source draft of a post stays editable, its edits go live through a republish
+	if draft.IsPublished {
+		logger.Errorf("draft %v is already published", draftUID)
+		return "", &constants.DraftAlreadyPublishedError
 	}
-
-	if *draft.Tagline == "" {
-		draft.Tagline = &metaData.Tagline
+	// a draft submitted to a publication is published by the editors approving it
+	if publicationID == nil && draft.InReview {
+		logger.Errorf("draft %v is waiting for review by a publication", draftUID)
+		return "", &constants.DraftAlreadySubmittedError
 	}
 
-	if draft.PreviewImage == nil || *draft.PreviewImage == "" {
-		draft.PreviewImage = &metaData.PreviewImage
+	fingerprint, apiErr := service.fingerprint(ctx, draft.Data, userUUID, uuid.Nil)
+	if apiErr != nil {
+		return "", apiErr
 	}
 
 	url := utils.GenerateUrl(metaData.Title)
 
 	post := db.PublishPost{
-		DraftID:  draftUID,
-		UserID:   userUUID,
-		PostData: draft.Data,
+		DraftID:       draftUID,
+		UserID:        userUUID,
+		PostData:      draft.Data,
+		PublicationID: publicationID,
 	}
 	txn := service.transactionManager.NewTransaction()
 	logger.Infof("Saving post in post repository for post id %v", draftUID)
@@ -107,11 +147,14 @@ func (service postService) PublishPost(ctx context.Context, draftUID, userUUID u
 		return "", constants.StoryInternalServerError(err.Error())
 	}
 	logger.Infof("Successfully saved story for post id %v", draftUID)
-	var interests []uuid.UUID
-	for _, interest := range draft.InterestTags {
-		interests = append(interests, interest.ID)
+	err = service.fingerprintRepository.Save(ctx, txn, postID, fingerprint.value, fingerprint.duplicateOf, fingerprint.similarity)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to save fingerprint of post %v. Error %v", postID, err)
+		return "", constants.StoryInternalServerError(err.Error())
 	}
-	err = service.repository.AddInterests(ctx, txn, postID, interests)
+
+	err = service.repository.AddInterests(ctx, txn, postID, interestIDs(draft))
 	if err != nil {
 		_ = txn.Rollback()
 		logger.Errorf("unable to add interests to posts %v", err)
@@ -120,12 +163,13 @@ func (service postService) PublishPost(ctx context.Context, draftUID, userUUID u
 
 	finalPostUrl := strings.Join([]string{url, postID.String()}, "-")
 	abstractPost := db.AbstractPost{
-		PostID:       postID,
-		Title:        metaData.Title,
-		Tagline:      *draft.Tagline,
-		PreviewImage: *draft.PreviewImage,
-		ViewTime:     int64(metaData.ReadTime),
-		URL:          finalPostUrl,
+		PostID:          postID,
+		Title:           metaData.Title,
+		Tagline:         *draft.Tagline,
+		PreviewImage:    *draft.PreviewImage,
+		ViewTime:        int64(metaData.ReadTime),
+		URL:             finalPostUrl,
+		TableOfContents: tableOfContents(draft.Data),
 	}
 
 	_, err = service.abstractPostRepository.Save(ctx, txn, abstractPost)
@@ -135,6 +179,13 @@ func (service postService) PublishPost(ctx context.Context, draftUID, userUUID u
 		return "", constants.StoryInternalServerError(err.Error())
 	}
 
+	err = service.abstractPostRepository.AddURL(ctx, txn, postID, finalPostUrl)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while recording url of post %v .%v", postID, err)
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
 	err = service.draftRepository.UpdatePublishStatus(ctx, txn, draftUID, userUUID, true)
 	if err != nil {
 		logger.Errorf("unable to update the publish status %", err)
@@ -147,6 +198,419 @@ func (service postService) PublishPost(ctx context.Context, draftUID, userUUID u
 	return finalPostUrl, nil
 }
 
+// EditPost returns the source draft of a published post so the author can change it before
+// republishing.
+func (service postService) EditPost(ctx context.Context, postID, userID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "EditPost")
+	logger.Infof("Fetching source draft of post %v for user %v", postID, userID)
+
+	draftID, err := service.repository.GetPostDraftID(ctx, postID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching source draft of post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return uuid.Nil, &constants.PostNotFoundErr
+		}
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return draftID, nil
+}
+
+// RepublishPost replaces a published post with the current content of its source draft. The post
+// keeps its id, url, likes and comments, while the version being replaced is kept as a post revision.
+// Posts of a publication are only replaced once the publication editors approve the edit, so their
+// authors have to submit the draft to the publication instead.
+func (service postService) RepublishPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "RepublishPost")
+
+	draftID, apiErr := service.EditPost(ctx, postID, userID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	publicationID, err := service.repository.GetPostPublicationID(ctx, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching publication of post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+	if publicationID != nil {
+		logger.Errorf("post %v belongs to publication %v, its edits need editor review", postID, *publicationID)
+		return &constants.PublicationReviewRequiredError
+	}
+
+	return service.republish(ctx, postID, draftID, userID, nil)
+}
+
+func (service postService) republish(ctx context.Context, postID, draftID, userID uuid.UUID, version *int64) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "RepublishPost")
+
+	draft, metaData, apiErr := service.prepareDraft(ctx, draftID, userID, version)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	fingerprint, apiErr := service.fingerprint(ctx, draft.Data, userID, postID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	logger.Infof("Saving current version of post %v as revision", postID)
+	_, err := service.postRevisionRepository.Save(ctx, txn, postID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while saving revision of post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.repository.UpdatePost(ctx, txn, postID, draft.Data)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while updating post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.fingerprintRepository.Save(ctx, txn, postID, fingerprint.value, fingerprint.duplicateOf, fingerprint.similarity)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to save fingerprint of post %v. Error %v", postID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.repository.RemoveInterests(ctx, txn, postID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to remove interests of post %v. Error %v", postID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.repository.AddInterests(ctx, txn, postID, interestIDs(draft))
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to add interests to post %v. Error %v", postID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	abstractPost := db.AbstractPost{
+		PostID:          postID,
+		Title:           metaData.Title,
+		Tagline:         *draft.Tagline,
+		PreviewImage:    *draft.PreviewImage,
+		ViewTime:        int64(metaData.ReadTime),
+		TableOfContents: tableOfContents(draft.Data),
+	}
+	err = service.abstractPostRepository.Update(ctx, txn, abstractPost)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while updating abstract post for post id %v .%v", postID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	// a renamed post moves to a url for its new title, the old url is kept so existing links redirect
+	url := strings.Join([]string{utils.GenerateUrl(metaData.Title), postID.String()}, "-")
+	urlChanged, err := service.abstractPostRepository.UpdateGeneratedURL(ctx, txn, postID, url)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while updating url of post %v .%v", postID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+	if urlChanged {
+		err = service.abstractPostRepository.AddURL(ctx, txn, postID, url)
+		if err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("Error occurred while recording url of post %v .%v", postID, err)
+			return constants.StoryInternalServerError(err.Error())
+		}
+	}
+
+	_ = txn.Commit()
+	logger.Infof("Successfully republished post %v", postID)
+	return nil
+}
+
+// GetPostRevisions returns the earlier versions of a post the user can see. Only the author gets the
+// content of each version, other readers only learn when the post was edited.
+func (service postService) GetPostRevisions(ctx context.Context, postID, userID uuid.UUID) ([]db.PostRevision, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "GetPostRevisions")
+	logger.Infof("Fetching revisions for post %v", postID)
+
+	authorID, err := service.repository.GetVisibleAuthorID(ctx, postID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching author of post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return nil, &constants.PostNotFoundErr
+		}
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	revisions, err := service.postRevisionRepository.GetRevisions(ctx, postID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching revisions for post %v. Error %v", postID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	for i := range revisions {
+		if authorID != userID {
+			revisions[i].Data = models.JSONString{}
+			continue
+		}
+		sanitized, _, apiErr := service.sanitizer.Sanitize(ctx, revisions[i].Data)
+		if apiErr != nil {
+			logger.Errorf("unable to sanitize revision %v of post %v. Error %v", revisions[i].Revision, postID, apiErr)
+			return nil, apiErr
+		}
+		revisions[i].Data = sanitized
+	}
+
+	return revisions, nil
+}
+
+// SetSlug moves the post to a url built from a slug chosen by the author. Slugs are unique among the
+// posts of an author, and the previous url of the post keeps resolving.
+func (service postService) SetSlug(ctx context.Context, postID, userID uuid.UUID, slug string) (string, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "SetSlug")
+
+	txn := service.transactionManager.NewTransaction()
+	url, err := service.abstractPostRepository.SetCustomSlug(ctx, txn, postID, userID, slug)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while setting slug of post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return "", &constants.PostNotFoundErr
+		}
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.abstractPostRepository.AddURL(ctx, txn, postID, url)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("Error occurred while recording url %v of post %v. Error %v", url, postID, err)
+		if err == sql.ErrNoRows {
+			return "", &constants.SlugAlreadyTakenError
+		}
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("Error occurred while committing slug of post %v. Error %v", postID, err)
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully moved post %v to url %v", postID, url)
+	return url, nil
+}
+
+// ResolvePost returns the post a url points to. Urls the post had before it was renamed resolve too,
+// and are reported as redirects to the current url.
+func (service postService) ResolvePost(ctx context.Context, url string, userID uuid.UUID, withHTML bool) (response.ResolvedPost, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "ResolvePost")
+
+	candidates := utils.PostURLCandidates(url)
+	if len(candidates) == 0 {
+		return response.ResolvedPost{}, &constants.PostNotFoundErr
+	}
+
+	postID, currentURL, err := service.abstractPostRepository.ResolveURL(ctx, candidates, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while resolving url %v. Error %v", url, err)
+		if err == sql.ErrNoRows {
+			return response.ResolvedPost{}, &constants.PostNotFoundErr
+		}
+		return response.ResolvedPost{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	post, apiErr := service.GetPost(ctx, postID, userID, withHTML)
+	if apiErr != nil {
+		return response.ResolvedPost{}, apiErr
+	}
+
+	redirect := true
+	for _, candidate := range candidates {
+		if candidate == currentURL {
+			redirect = false
+		}
+	}
+
+	return response.ResolvedPost{Redirect: redirect, Post: post}, nil
+}
+
+// prepareDraft loads a draft with its interests, validates it and fills in the tagline and preview
+// image from the draft content when the author has not set them.
+// prepareDraft fetches, validates and sanitizes the draft to publish. When a version is given, the draft
+// must still be at that version.
+func (service postService) prepareDraft(ctx context.Context, draftUID, userUUID uuid.UUID, version *int64) (db.Draft, models.MetaData, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "prepareDraft")
+	draft, err := service.draftRepository.GetDraftByUser(ctx, draftUID, userUUID)
+	if err != nil {
+		logger.Errorf("error occurred while fetching draft from draft repository %v", err)
+		if err == sql.ErrNoRows {
+			logger.Errorf("Error occurred while getting draft data, no draft found for draft id %v .%v", draftUID, err)
+			return db.Draft{}, models.MetaData{}, &constants.NoDraftFoundError
+		}
+		return db.Draft{}, models.MetaData{}, constants.StoryInternalServerError(err.Error())
+	}
+	if version != nil && draft.Version != *version {
+		logger.Errorf("draft %v is at version %v instead of %v", draftUID, draft.Version, *version)
+		return db.Draft{}, models.MetaData{}, &constants.DraftChangedAfterSubmitError
+	}
+	apiErr := draft.ConvertInterests(func(interests []string) *golaerror.Error {
+		draft.InterestTags, err = service.interestRepository.GetInterestsForName(ctx, interests)
+		if err != nil {
+			logger.Errorf("unable to get interests %v", err)
+			return constants.StoryInternalServerError("something went wrong")
+		}
+		return nil
+	})
+
+	if apiErr != nil {
+		logger.Error("unable to get interests")
+		return db.Draft{}, models.MetaData{}, apiErr
+	}
+
+	logger.Infof("validating draft and generated read time for post %v", draftUID)
+	metaData, validationErr := service.validator.ValidateAndGetReadTime(draft, ctx)
+
+	if validationErr != nil {
+		logger.Errorf("Error occurred while validating draft of id %v .%v", draftUID, validationErr)
+		return db.Draft{}, models.MetaData{}, validationErr
+	}
+
+	// drafts are sanitized when saved, this catches drafts saved before a policy got stricter
+	data, reports, sanitizeErr := service.sanitizer.Sanitize(ctx, draft.Data)
+	if sanitizeErr != nil {
+		logger.Errorf("Error occurred while sanitizing draft of id %v .%v", draftUID, sanitizeErr)
+		return db.Draft{}, models.MetaData{}, sanitizeErr
+	}
+	if len(reports) > 0 {
+		logger.Infof("stripped disallowed markup from %v blocks of draft %v", len(reports), draftUID)
+	}
+	draft.Data = data
+
+	if *draft.Tagline == "" {
+		draft.Tagline = &metaData.Tagline
+	}
+
+	if draft.PreviewImage == nil || *draft.PreviewImage == "" {
+		draft.PreviewImage = &metaData.PreviewImage
+	}
+
+	if apiErr := service.filterDraft(ctx, &draft, &metaData); apiErr != nil {
+		return db.Draft{}, models.MetaData{}, apiErr
+	}
+
+	return draft, metaData, nil
+}
+
+// filterDraft applies the content filter to the text of the post, its title and its tagline, keeping any
+// masked text. A post held for review is recorded against the version of its draft and is not published
+// until a moderator approves that version.
+func (service postService) filterDraft(ctx context.Context, draft *db.Draft, metaData *models.MetaData) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "filterDraft")
+
+	data, result, apiErr := service.contentFilter.FilterEditor(ctx, draft.Data)
+	if apiErr != nil {
+		logger.Errorf("Error occurred while filtering draft of id %v .%v", draft.DraftID, apiErr)
+		return apiErr
+	}
+	title, titleResult := service.contentFilter.FilterText(ctx, metaData.Title)
+	tagline, taglineResult := service.contentFilter.FilterText(ctx, *draft.Tagline)
+	result = utils.MergeFilterResults(result, titleResult, taglineResult)
+
+	switch result.Action {
+	case utils.FilterReject:
+		logger.Errorf("draft %v rejected by content filter rules %v", draft.DraftID, result.Rules)
+		return constants.ContentRejectedError(result.Rules)
+	case utils.FilterHold:
+		status, err := service.reviewRepository.GetPostReviewStatus(ctx, draft.DraftID, draft.Version)
+		if err != nil && err != sql.ErrNoRows {
+			logger.Errorf("unable to fetch review of draft %v. Error %v", draft.DraftID, err)
+			return constants.StoryInternalServerError(err.Error())
+		}
+		if status == db.ReviewRejected {
+			logger.Errorf("draft %v at version %v was rejected by a moderator", draft.DraftID, draft.Version)
+			return constants.ContentRejectedError(result.Rules)
+		}
+		if status != db.ReviewApproved {
+			review := db.ContentReview{ContentType: db.ReviewPost, ContentID: draft.DraftID, ContentVersion: &draft.Version, UserID: draft.UserID, Rules: result.Rules}
+			if err := service.reviewRepository.Save(ctx, review); err != nil {
+				logger.Errorf("unable to hold draft %v for review. Error %v", draft.DraftID, err)
+				return constants.StoryInternalServerError(err.Error())
+			}
+			return &constants.ContentHeldForReviewError
+		}
+		logger.Infof("draft %v at version %v was approved by a moderator", draft.DraftID, draft.Version)
+	}
+
+	draft.Data = data
+	metaData.Title = title
+	draft.Tagline = &tagline
+	return nil
+}
+
+// fingerprint compares the content with the stored fingerprints of other posts. A near duplicate blocks
+// publishing, unless the configured action only flags it. Authors may publish the content of their own
+// deleted posts again. The duplicated post is named only when it is published or the author wrote it. Like tableOfContents it reads validated post data.
+func (service postService) fingerprint(ctx context.Context, data models.JSONString, authorID, postID uuid.UUID) (contentFingerprint, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "fingerprint")
+
+	var editor models.Editor
+	_ = json.Unmarshal(data.JSONText, &editor)
+
+	value, words := utils.ContentFingerprint(editor)
+	fingerprint := contentFingerprint{value: value}
+	if words < service.duplicateContent.MinWords {
+		return fingerprint, nil
+	}
+
+	maxDistance := utils.FingerprintDistance(service.duplicateContent.Similarity)
+	match, err := service.fingerprintRepository.FindNearest(ctx, value, authorID, postID, maxDistance)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fingerprint, nil
+		}
+		logger.Errorf("unable to compare post with existing posts. Error %v", err)
+		return contentFingerprint{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	similarity := utils.FingerprintSimilarity(value, uint64(match.Simhash))
+	if service.duplicateContent.Action != flagDuplicateContent {
+		logger.Errorf("post of author %v duplicates post %v with similarity %v", authorID, match.PostID, similarity)
+		if match.State == db.PostPublished || match.AuthorID == authorID {
+			return contentFingerprint{}, constants.DuplicateContentError(match.PostID)
+		}
+		return contentFingerprint{}, constants.DuplicateContentError(nil)
+	}
+
+	logger.Infof("flagging post of author %v as duplicate of post %v with similarity %v", authorID, match.PostID, similarity)
+	fingerprint.duplicateOf = &match.PostID
+	fingerprint.similarity = &similarity
+	return fingerprint, nil
+}
+
+// tableOfContents lists the header blocks of validated post data, so the data is known to parse.
+func tableOfContents(data models.JSONString) models.TableOfContents {
+	var editor models.Editor
+	_ = json.Unmarshal(data.JSONText, &editor)
+	return editor.TableOfContents()
+}
+
+func interestIDs(draft db.Draft) []uuid.UUID {
+	var interests []uuid.UUID
+	for _, interest := range draft.InterestTags {
+		interests = append(interests, interest.ID)
+	}
+	return interests
+}
+
 func (service postService) LikePost(ctx context.Context, postUID, userID uuid.UUID) *golaerror.Error {
 	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "LikePost")
 	logger.Infof("Saving post data to draft repository")
@@ -171,7 +635,7 @@ func (service postService) UnLikePost(ctx context.Context, postUID, userID uuid.
 	return nil
 }
 
-func (service postService) GetPost(ctx context.Context, postId, userId uuid.UUID) (response.Post, *golaerror.Error) {
+func (service postService) GetPost(ctx context.Context, postId, userId uuid.UUID, withHTML bool) (response.Post, *golaerror.Error) {
 	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "GetPost")
 	logger.Infof("Fetching post for post id %v", postId)
 
@@ -186,6 +650,37 @@ func (service postService) GetPost(ctx context.Context, postId, userId uuid.UUID
 		return response.Post{}, constants.StoryInternalServerError(err.Error())
 	}
 
+	// posts published before content was sanitized on save still hold the raw content
+	sanitized, _, apiErr := service.sanitizer.Sanitize(ctx, post.PostData)
+	if apiErr != nil {
+		logger.Errorf("unable to sanitize post data for post %v. Error %v", postId, apiErr)
+		return response.Post{}, apiErr
+	}
+	post.PostData = sanitized
+
+	post.IsEdited = post.EditedAt != nil
+	navigation, apiErr := service.seriesService.GetNavigation(ctx, postId)
+	if apiErr != nil {
+		logger.Errorf("unable to fetch series navigation for post %v. Error %v", postId, apiErr)
+		return response.Post{}, apiErr
+	}
+	post.Series = navigation
+
+	if withHTML || post.TableOfContents == nil {
+		var editor models.Editor
+		if err = json.Unmarshal(post.PostData.JSONText, &editor); err != nil {
+			logger.Errorf("unable to parse post data for post %v. Error %v", postId, err)
+			return response.Post{}, constants.StoryInternalServerError(err.Error())
+		}
+		// posts published before tables of contents were stored have none
+		if post.TableOfContents == nil {
+			post.TableOfContents = editor.TableOfContents()
+		}
+		if withHTML {
+			post.HTML = editor.RenderHTML()
+		}
+	}
+
 	post.PreviewImage, err = service.awsServices.GetObjectInS3(post.PreviewImage, time.Hour*time.Duration(6))
 	if err != nil {
 		logger.Errorf("unable to fetch preview image from s3 %v", err)
@@ -196,6 +691,29 @@ func (service postService) GetPost(ctx context.Context, postId, userId uuid.UUID
 	return post, nil
 }
 
+// ExportMarkdown returns the published content of a post as Markdown.
+func (service postService) ExportMarkdown(ctx context.Context, postID, userID uuid.UUID) (string, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "ExportMarkdown")
+	logger.Infof("Exporting post %v as markdown", postID)
+
+	post, err := service.repository.FetchPost(ctx, postID, userID)
+	if err != nil {
+		logger.Errorf("Error occurred while fetching post for given post id %v, Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return "", &constants.PostNotFoundErr
+		}
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	var editor models.Editor
+	if err = json.Unmarshal(post.PostData.JSONText, &editor); err != nil {
+		logger.Errorf("unable to parse post data for post %v. Error %v", postID, err)
+		return "", constants.StoryInternalServerError(err.Error())
+	}
+
+	return editor.RenderMarkdown(), nil
+}
+
 func (service postService) GetPublishedPostByUser(ctx context.Context, request request.GetPublishedPostRequest) ([]response.PublishedPost, *golaerror.Error) {
 	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "GetPost")
 	logger.Infof("Fetching posts for user id %v", request.UserID)
@@ -223,11 +741,43 @@ func (service postService) GetPublishedPostByUser(ctx context.Context, request r
 func (service postService) Comment(ctx context.Context, comment request.Comment) *golaerror.Error {
 	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "Comment")
 
-	err := service.repository.Comment(ctx, comment)
+	data, result := service.contentFilter.FilterText(ctx, comment.Data)
+	if result.Action == utils.FilterReject {
+		logger.Errorf("comment rejected by content filter rules %v", result.Rules)
+		return constants.ContentRejectedError(result.Rules)
+	}
+	comment.Data = data
+	comment.HeldForReview = result.Action == utils.FilterHold
+
+	txn := service.transactionManager.NewTransaction()
+	commentID, err := service.repository.Comment(ctx, txn, comment)
 	if err != nil {
+		_ = txn.Rollback()
 		logger.Infof("unable to comment %v", err)
+		if err == sql.ErrNoRows {
+			return &constants.NoCommentFoundError
+		}
 		return constants.StoryInternalServerError(err.Error())
 	}
+
+	if comment.HeldForReview {
+		review := db.ContentReview{ContentType: db.ReviewComment, ContentID: commentID, UserID: comment.CommentedBy, Rules: result.Rules}
+		if err := service.reviewRepository.Hold(ctx, txn, review); err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to hold comment %v for review. Error %v", commentID, err)
+			return constants.StoryInternalServerError(err.Error())
+		}
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit comment %v. Error %v", commentID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	if comment.HeldForReview {
+		logger.Infof("comment %v held for review", commentID)
+		return &constants.ContentHeldForReviewError
+	}
 	logger.Info("comment successfully posted")
 
 	return nil
@@ -237,7 +787,11 @@ func (service postService) GetComments(ctx context.Context, commentsRequest requ
 	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "FetchComments")
 	logger.Infof("fetching post for post id %v", commentsRequest.PostID)
 
-	comments, err := service.repository.FetchComments(ctx, commentsRequest)
+	if err := service.checkPostReadable(ctx, commentsRequest.PostID, commentsRequest.UserID); err != nil {
+		return nil, err
+	}
+
+	comments, err := service.repository.FetchComments(ctx, commentsRequest, service.maxCommentDepth)
 	if err != nil {
 		logger.Errorf("unable to fetch comments from repository %v", err)
 		return nil, constants.StoryInternalServerError(err.Error())
@@ -248,6 +802,80 @@ func (service postService) GetComments(ctx context.Context, commentsRequest requ
 	return comments, nil
 }
 
+// GetReplies fetches the direct replies of a comment. Once the comment is at the deepest level shown,
+// every reply below it is fetched as one flat list instead, so the conversation can still be followed.
+func (service postService) GetReplies(ctx context.Context, repliesRequest request.FetchReplies) ([]response.Comment, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "GetReplies")
+	logger.Infof("fetching replies of comment %v", repliesRequest.CommentID)
+
+	if err := service.checkPostReadable(ctx, repliesRequest.PostID, repliesRequest.UserID); err != nil {
+		return nil, err
+	}
+
+	comment, err := service.repository.GetComment(ctx, repliesRequest.CommentID, repliesRequest.PostID)
+	if err != nil {
+		logger.Errorf("unable to fetch comment %v. Error %v", repliesRequest.CommentID, err)
+		if err == sql.ErrNoRows {
+			return nil, &constants.NoCommentFoundError
+		}
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	var replies []response.Comment
+	if comment.Depth >= service.maxCommentDepth {
+		replies, err = service.repository.FetchThreadReplies(ctx, repliesRequest)
+	} else {
+		replies, err = service.repository.FetchReplies(ctx, repliesRequest, service.maxCommentDepth)
+	}
+	if err != nil {
+		logger.Errorf("unable to fetch replies of comment %v. Error %v", repliesRequest.CommentID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Info("successfully fetched replies")
+
+	return replies, nil
+}
+
+// checkPostReadable makes sure the user may read the post, so threads of deleted or hidden posts stay hidden.
+func (service postService) checkPostReadable(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "checkPostReadable")
+
+	_, err := service.repository.GetVisibleAuthorID(ctx, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to fetch post %v for user %v. Error %v", postID, userID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (service postService) DeleteComment(ctx context.Context, commentID, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "DeleteComment")
+
+	txn := service.transactionManager.NewTransaction()
+	err := service.repository.DeleteComment(ctx, txn, commentID, postID, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to delete comment %v. Error %v", commentID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoCommentFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit deletion of comment %v. Error %v", commentID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully deleted comment %v", commentID)
+	return nil
+}
+
 func (service postService) SavePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
 	logger := logging.GetLogger(ctx).WithField("class", "PostService").WithField("method", "FetchComments")
 	logger.Info("marking post as read later")
@@ -339,6 +967,9 @@ func (service postService) Delete(ctx context.Context, postID, userID uuid.UUID)
 
 	if err != nil {
 		logger.Errorf("unable to update post delete status %v", err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
 		return &constants.InternalServerError
 	}
 	logger.Infof("successfully deleted post for post id %v", postID)
@@ -365,14 +996,26 @@ func (service postService) GetHomeFeed(ctx context.Context, userID uuid.UUID, li
 	return posts, nil
 }
 
-func NewPostService(postsRepository repository.PostsRepository, draftRepository repository.DraftRepository, validator utils.PostValidator, previewPostsRepository repository.AbstractPostRepository, interestsRepository repository.InterestsRepository, manager helper.TransactionManager, services service.AwsServices) PostService {
+func NewPostService(postsRepository repository.PostsRepository, draftRepository repository.DraftRepository, validator utils.PostValidator, sanitizer utils.ContentSanitizer, previewPostsRepository repository.AbstractPostRepository, postRevisionRepository repository.PostRevisionRepository, seriesService SeriesService, interestsRepository repository.InterestsRepository, manager helper.TransactionManager, services service.AwsServices, fingerprintRepository repository.PostFingerprintRepository, duplicateContent configuration.DuplicateContent, contentFilter utils.ContentFilter, reviewRepository repository.ContentReviewRepository, commentThreads configuration.CommentThreads) PostService {
+	maxCommentDepth := commentThreads.MaxDisplayDepth
+	if maxCommentDepth <= 0 {
+		maxCommentDepth = defaultMaxCommentDepth
+	}
 	return postService{
 		transactionManager:     manager,
 		repository:             postsRepository,
 		interestRepository:     interestsRepository,
 		draftRepository:        draftRepository,
 		abstractPostRepository: previewPostsRepository,
+		postRevisionRepository: postRevisionRepository,
+		seriesService:          seriesService,
 		validator:              validator,
+		sanitizer:              sanitizer,
 		awsServices:            services,
+		fingerprintRepository:  fingerprintRepository,
+		duplicateContent:       duplicateContent,
+		contentFilter:          contentFilter,
+		reviewRepository:       reviewRepository,
+		maxCommentDepth:        maxCommentDepth,
 	}
 }
@@ -3,13 +3,19 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"github.com/google/uuid"
+	"post-api/configuration"
 	"post-api/story/constants"
 	"post-api/story/mocks"
 	"post-api/story/models"
 	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"post-api/story/models/response"
 	"post-api/story/service/test_helper"
+	"post-api/story/utils"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -24,10 +30,18 @@ type PostServiceTest struct {
 	mockPostsRepository        *mocks.MockPostsRepository
 	mockDraftsRepository       *mocks.MockDraftRepository
 	mockAbstractPostRepository *mocks.MockAbstractPostRepository
+	mockPostRevisionRepository *mocks.MockPostRevisionRepository
+	mockSeriesService          *mocks.MockSeriesService
 	mockInterestsRepository    *mocks.MockInterestsRepository
 	mockTransaction            *mocks.MockTransaction
 	mockTransactionManager     *mocks.MockTransactionManager
 	mockPostValidator          *mocks.MockPostValidator
+	mockContentSanitizer       *mocks.MockContentSanitizer
+	mockFingerprintRepository  *mocks.MockPostFingerprintRepository
+	duplicateContent           configuration.DuplicateContent
+	contentFilter              utils.ContentFilter
+	mockReviewRepository       *mocks.MockContentReviewRepository
+	commentThreads             configuration.CommentThreads
 	postService                PostService
 }
 
@@ -41,288 +55,224 @@ func (suite *PostServiceTest) SetupTest() {
 	suite.mockPostsRepository = mocks.NewMockPostsRepository(suite.mockController)
 	suite.mockDraftsRepository = mocks.NewMockDraftRepository(suite.mockController)
 	suite.mockPostValidator = mocks.NewMockPostValidator(suite.mockController)
+	suite.mockContentSanitizer = mocks.NewMockContentSanitizer(suite.mockController)
 	suite.mockAbstractPostRepository = mocks.NewMockAbstractPostRepository(suite.mockController)
+	suite.mockPostRevisionRepository = mocks.NewMockPostRevisionRepository(suite.mockController)
+	suite.mockSeriesService = mocks.NewMockSeriesService(suite.mockController)
 	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
 	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
 	suite.mockInterestsRepository = mocks.NewMockInterestsRepository(suite.mockController)
-	suite.postService = NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockAbstractPostRepository, suite.mockInterestsRepository, suite.mockTransactionManager)
+	suite.mockFingerprintRepository = mocks.NewMockPostFingerprintRepository(suite.mockController)
+	suite.duplicateContent = configuration.DuplicateContent{Similarity: 0.85, Action: "block", MinWords: 50}
+	suite.contentFilter, _ = utils.NewContentFilter(nil)
+	suite.mockReviewRepository = mocks.NewMockContentReviewRepository(suite.mockController)
+	suite.commentThreads = configuration.CommentThreads{MaxDisplayDepth: 2}
+	suite.postService = NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockContentSanitizer, suite.mockAbstractPostRepository, suite.mockPostRevisionRepository, suite.mockSeriesService, suite.mockInterestsRepository, suite.mockTransactionManager, nil, suite.mockFingerprintRepository, suite.duplicateContent, suite.contentFilter, suite.mockReviewRepository, suite.commentThreads)
 }
 
 func (suite *PostServiceTest) TearDownTest() {
 	suite.mockController.Finish()
 }
 
-func (suite *PostServiceTest) TestPublishPost_WhenSuccess() {
-	draftUUID := uuid.New()
-	userUUID := uuid.New()
-	postUUID := uuid.New()
-	abstractPostUUID := uuid.New()
-	tmpPreviewImage := "https://www.some-url.com"
+func (suite *PostServiceTest) publishDraft(draftUUID, userUUID uuid.UUID, previewImage *string) db.Draft {
 	tmpTagLine := ""
 	interests := "{sports,economy}"
-	draft := db.Draft{
+	return db.Draft{
 		DraftID: draftUUID,
 		UserID:  userUUID,
 		Data: models.JSONString{
 			JSONText: types.JSONText(test_helper.ContentTestData),
 		},
-		PreviewImage: &tmpPreviewImage,
+		PreviewImage: previewImage,
 		Tagline:      &tmpTagLine,
 		Interests:    &interests,
 	}
-	post := db.PublishPost{
-		UserID: userUUID,
-		PostData: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		DraftID: draftUUID,
-	}
-	abstractPost := db.AbstractPost{
-		Model:        db.Model{},
-		PostID:       postUUID,
-		Title:        "Install apps via helm in kubernetes",
-		Tagline:      "",
-		PreviewImage: "https://www.some-url.com",
-		ViewTime:     22,
-	}
-	interestUUIDs := []uuid.UUID{uuid.New(), uuid.New()}
-
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{
-		Title:    "Install apps via helm in kubernetes",
-		Tagline:  "",
-		ReadTime: 22,
-	}, nil).Times(1)
+}
+
+// expectPreparedDraft sets up loading, validating and sanitizing the draft, and returns the interests of
+// the draft.
+func (suite *PostServiceTest) expectPreparedDraft(draft db.Draft, metaData models.MetaData) []db.Interests {
+	interests := []db.Interests{{ID: uuid.New(), Name: "sports"}, {ID: uuid.New(), Name: "economy"}}
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draft.DraftID, draft.UserID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports", "economy"}).Return(interests, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(metaData, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	return interests
+}
+
+func (suite *PostServiceTest) TestPublishPost_WhenSuccess() {
+	draftUUID := uuid.New()
+	userUUID := uuid.New()
+	postUUID := uuid.New()
+	previewImage := "https://www.some-url.com"
+	draft := suite.publishDraft(draftUUID, userUUID, &previewImage)
+	metaData := models.MetaData{Title: "Install apps via helm in kubernetes", ReadTime: 22}
+	url := "install-apps-via-helm-in-kubernetes-" + postUUID.String()
+
+	interests := suite.expectPreparedDraft(draft, metaData)
 	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
-	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, post).Return(postUUID, nil).Times(1)
-	suite.mockInterestsRepository.EXPECT().GetInterestIDs(suite.goContext, draft.InterestTags).Return(interestUUIDs, nil).Times(1)
-	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, interestUUIDs).Return(nil).Times(1)
-	suite.mockAbstractPostRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, abstractPost).Return(abstractPostUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, db.PublishPost{UserID: userUUID, PostData: draft.Data, DraftID: draftUUID}).Return(postUUID, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, uint64(0), nil, nil).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, []uuid.UUID{interests[0].ID, interests[1].ID}).Return(nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, db.AbstractPost{
+		PostID:          postUUID,
+		Title:           "Install apps via helm in kubernetes",
+		PreviewImage:    "https://www.some-url.com",
+		ViewTime:        22,
+		URL:             url,
+		TableOfContents: tableOfContents(draft.Data),
+	}).Return(uuid.New(), nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().AddURL(suite.goContext, suite.mockTransaction, postUUID, url).Return(nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().UpdatePublishStatus(suite.goContext, suite.mockTransaction, draftUUID, userUUID, true).Return(nil).Times(1)
 	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+	postURL, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
 	suite.Nil(err)
+	suite.Equal(url, postURL)
 }
 
 func (suite *PostServiceTest) TestPublishPost_WhenSuccessThereIsNoPreviewImage() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
 	postUUID := uuid.New()
-	abstractPostUUID := uuid.New()
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: nil,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
-	post := db.PublishPost{
-		UserID: userUUID,
-		PostData: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		DraftID: draftUUID,
-	}
-	abstractPost := db.AbstractPost{
-		Model:        db.Model{},
-		PostID:       postUUID,
-		Title:        "Install apps via helm in kubernetes",
-		Tagline:      "",
-		PreviewImage: "https://www.some-url.com",
-		ViewTime:     22,
-	}
-	interestUUIDs := []uuid.UUID{uuid.New(), uuid.New()}
-
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{
-		Title:        "Install apps via helm in kubernetes",
-		Tagline:      "",
-		ReadTime:     22,
-		PreviewImage: "https://www.some-url.com",
-	}, nil).Times(1)
+	draft := suite.publishDraft(draftUUID, userUUID, nil)
+	metaData := models.MetaData{Title: "Install apps via helm in kubernetes", ReadTime: 22, PreviewImage: "https://www.some-url.com"}
+	url := "install-apps-via-helm-in-kubernetes-" + postUUID.String()
+
+	suite.expectPreparedDraft(draft, metaData)
 	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
-	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, post).Return(postUUID, nil).Times(1)
-	suite.mockInterestsRepository.EXPECT().GetInterestIDs(suite.goContext, draft.InterestTags).Return(interestUUIDs, nil).Times(1)
-	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, interestUUIDs).Return(nil).Times(1)
-	suite.mockAbstractPostRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, abstractPost).Return(abstractPostUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, db.PublishPost{UserID: userUUID, PostData: draft.Data, DraftID: draftUUID}).Return(postUUID, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, uint64(0), nil, nil).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, gomock.Any()).Return(nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, db.AbstractPost{
+		PostID:          postUUID,
+		Title:           "Install apps via helm in kubernetes",
+		PreviewImage:    "https://www.some-url.com",
+		ViewTime:        22,
+		URL:             url,
+		TableOfContents: tableOfContents(draft.Data),
+	}).Return(uuid.New(), nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().AddURL(suite.goContext, suite.mockTransaction, postUUID, url).Return(nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().UpdatePublishStatus(suite.goContext, suite.mockTransaction, draftUUID, userUUID, true).Return(nil).Times(1)
 	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
 	suite.Nil(err)
 }
 
 func (suite *PostServiceTest) TestPublishPost_WhenGetDraftFails() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
-	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: &tmpPreviewImage,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
 
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, errors.New("something went wrong")).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(db.Draft{}, errors.New("something went wrong")).Times(1)
+
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(err)
 	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
 func (suite *PostServiceTest) TestPublishPost_WhenNoDraftFound() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
-	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: &tmpPreviewImage,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
 
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, sql.ErrNoRows).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(db.Draft{}, sql.ErrNoRows).Times(1)
+
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(err)
 	suite.Equal(&constants.NoDraftFoundError, err)
 }
 
 func (suite *PostServiceTest) TestPublishPost_WhenValidationFails() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
-	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: &tmpPreviewImage,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
+	previewImage := "https://www.some-url.com"
+	draft := suite.publishDraft(draftUUID, userUUID, &previewImage)
 
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{}, &constants.DraftValidationFailedError).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports", "economy"}).Return([]db.Interests{}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{}, &constants.DraftValidationFailedError).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(&constants.DraftValidationFailedError, err)
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
+	suite.Equal(&constants.DraftValidationFailedError, err)
+}
+
+func (suite *PostServiceTest) TestPublishPost_WhenGetInterestsFails() {
+	draftUUID := uuid.New()
+	userUUID := uuid.New()
+	previewImage := "https://www.some-url.com"
+	draft := suite.publishDraft(draftUUID, userUUID, &previewImage)
+
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports", "economy"}).Return(nil, errors.New("something went wrong")).Times(1)
+
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
 func (suite *PostServiceTest) TestPublishPost_WhenCreatePostFails() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
-	var postUUID uuid.UUID
-	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: &tmpPreviewImage,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
-	post := db.PublishPost{
-		UserID: userUUID,
-		PostData: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		DraftID: draftUUID,
-	}
+	previewImage := "https://www.some-url.com"
+	draft := suite.publishDraft(draftUUID, userUUID, &previewImage)
 
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{
-		Title:    "Install apps via helm in kubernetes",
-		Tagline:  "",
-		ReadTime: 22,
-	}, nil).Times(1)
+	suite.expectPreparedDraft(draft, models.MetaData{Title: "Install apps via helm in kubernetes", ReadTime: 22})
 	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
-	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, post).Return(postUUID, errors.New("something went wrong")).Times(1)
+	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, db.PublishPost{UserID: userUUID, PostData: draft.Data, DraftID: draftUUID}).Return(uuid.Nil, errors.New("something went wrong")).Times(1)
 	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(err)
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
 	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
-func (suite *PostServiceTest) TestPublishPost_WhenGetInterestsFails() {
+func (suite *PostServiceTest) TestPublishPost_WhenAddInterestsFails() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
 	postUUID := uuid.New()
-	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: &tmpPreviewImage,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
-	post := db.PublishPost{
-		UserID: userUUID,
-		PostData: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		DraftID: draftUUID,
-	}
-	var interestUUIDs []uuid.UUID
-
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{
-		Title:    "Install apps via helm in kubernetes",
-		Tagline:  "",
-		ReadTime: 22,
-	}, nil).Times(1)
+	previewImage := "https://www.some-url.com"
+	draft := suite.publishDraft(draftUUID, userUUID, &previewImage)
+
+	suite.expectPreparedDraft(draft, models.MetaData{Title: "Install apps via helm in kubernetes", ReadTime: 22})
 	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
-	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, post).Return(postUUID, nil).Times(1)
-	suite.mockInterestsRepository.EXPECT().GetInterestIDs(suite.goContext, draft.InterestTags).Return(interestUUIDs, errors.New("something went wrong")).Times(1)
+	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, db.PublishPost{UserID: userUUID, PostData: draft.Data, DraftID: draftUUID}).Return(postUUID, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, uint64(0), nil, nil).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, gomock.Any()).Return(errors.New("something went wrong")).Times(1)
 	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(err)
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
 	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
-func (suite *PostServiceTest) TestPublishPost_WhenAddInterestsFails() {
+func (suite *PostServiceTest) TestPublishPost_WhenAbstractPostSaveFails() {
 	draftUUID := uuid.New()
 	userUUID := uuid.New()
 	postUUID := uuid.New()
+	previewImage := "https://www.some-url.com"
+	draft := suite.publishDraft(draftUUID, userUUID, &previewImage)
+
+	suite.expectPreparedDraft(draft, models.MetaData{Title: "Install apps via helm in kubernetes", ReadTime: 22})
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, db.PublishPost{UserID: userUUID, PostData: draft.Data, DraftID: draftUUID}).Return(postUUID, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, uint64(0), nil, nil).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, gomock.Any()).Return(nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, gomock.Any()).Return(uuid.Nil, errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+}
+
+func (suite *PostServiceTest) republishDraft(draftUUID, userUUID uuid.UUID) db.Draft {
 	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
+	tmpTagLine := "edited tagline"
+	interests := "{sports}"
+	return db.Draft{
 		DraftID: draftUUID,
 		UserID:  userUUID,
 		Data: models.JSONString{
@@ -331,88 +281,632 @@ func (suite *PostServiceTest) TestPublishPost_WhenAddInterestsFails() {
 		PreviewImage: &tmpPreviewImage,
 		Tagline:      &tmpTagLine,
 		Interests:    &interests,
+		IsPublished:  true,
 	}
-	post := db.PublishPost{
-		UserID: userUUID,
-		PostData: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		DraftID: draftUUID,
-	}
-	interestUUIDs := []uuid.UUID{uuid.New(), uuid.New()}
-
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{
-		Title:    "Install apps via helm in kubernetes",
-		Tagline:  "",
-		ReadTime: 22,
-	}, nil).Times(1)
+}
+
+func (suite *PostServiceTest) TestPublishPost_WhenDraftIsAlreadyPublished() {
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title", ReadTime: 120}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+
+	url, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
+	suite.Equal(&constants.DraftAlreadyPublishedError, err)
+	suite.Equal("", url)
+}
+
+func (suite *PostServiceTest) TestPublishPost_WhenDraftIsInReview() {
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	draft.IsPublished = false
+	draft.InReview = true
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title", ReadTime: 120}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+
+	_, err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
+
+	suite.Equal(&constants.DraftAlreadySubmittedError, err)
+}
+
+func (suite *PostServiceTest) TestEditPost_WhenSuccess() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+
+	draftID, err := suite.postService.EditPost(suite.goContext, postUUID, userUUID)
+
+	suite.Nil(err)
+	suite.Equal(draftUUID, draftID)
+}
+
+func (suite *PostServiceTest) TestEditPost_WhenPostNotOwnedByUser() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+
+	_, err := suite.postService.EditPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenSuccess() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	interestID := uuid.New()
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: interestID, Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title", ReadTime: 120}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
 	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
-	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, post).Return(postUUID, nil).Times(1)
-	suite.mockInterestsRepository.EXPECT().GetInterestIDs(suite.goContext, draft.InterestTags).Return(interestUUIDs, nil).Times(1)
-	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, interestUUIDs).Return(errors.New("something went wrong")).Times(1)
+	suite.mockPostRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().UpdatePost(suite.goContext, suite.mockTransaction, postUUID, draft.Data).Return(nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, uint64(0), nil, nil).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().RemoveInterests(suite.goContext, suite.mockTransaction, postUUID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, []uuid.UUID{interestID}).Return(nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().Update(suite.goContext, suite.mockTransaction, db.AbstractPost{
+		PostID:          postUUID,
+		Title:           "Edited title",
+		Tagline:         "edited tagline",
+		PreviewImage:    "https://www.some-url.com",
+		ViewTime:        120,
+		TableOfContents: models.TableOfContents{},
+	}).Return(nil).Times(1)
+	url := "edited-title-" + postUUID.String()
+	suite.mockAbstractPostRepository.EXPECT().UpdateGeneratedURL(suite.goContext, suite.mockTransaction, postUUID, url).Return(true, nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().AddURL(suite.goContext, suite.mockTransaction, postUUID, url).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Nil(err)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenPostBelongsToPublication() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	publicationID := uuid.New()
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(&publicationID, nil).Times(1)
+
+	err := suite.postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(&constants.PublicationReviewRequiredError, err)
+}
+
+func (suite *PostServiceTest) TestPublishToPublication_WhenDraftIsAlreadyPublishedInPublication() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	publicationID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	interestID := uuid.New()
+	suite.mockPostsRepository.EXPECT().GetPublicationPostID(suite.goContext, draftUUID, publicationID).Return(postUUID, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: interestID, Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title", ReadTime: 120}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().UpdatePost(suite.goContext, suite.mockTransaction, postUUID, draft.Data).Return(nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, uint64(0), nil, nil).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().RemoveInterests(suite.goContext, suite.mockTransaction, postUUID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, []uuid.UUID{interestID}).Return(nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().Update(suite.goContext, suite.mockTransaction, gomock.Any()).Return(nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().UpdateGeneratedURL(suite.goContext, suite.mockTransaction, postUUID, "edited-title-"+postUUID.String()).Return(false, nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	url, err := suite.postService.PublishToPublication(suite.goContext, draftUUID, userUUID, publicationID, 0)
+
+	suite.Nil(err)
+	suite.Equal("", url)
+}
+
+func (suite *PostServiceTest) TestPublishToPublication_WhenDraftChangedAfterSubmit() {
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	publicationID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	draft.Version = 4
+	suite.mockPostsRepository.EXPECT().GetPublicationPostID(suite.goContext, draftUUID, publicationID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+
+	_, err := suite.postService.PublishToPublication(suite.goContext, draftUUID, userUUID, publicationID, 3)
+
+	suite.Equal(&constants.DraftChangedAfterSubmitError, err)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenValidationFails() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{}, &constants.DraftValidationFailedError).Times(1)
+
+	err := suite.postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(&constants.DraftValidationFailedError, err)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenUpdatePostFails() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft := suite.republishDraft(draftUUID, userUUID)
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title"}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().UpdatePost(suite.goContext, suite.mockTransaction, postUUID, draft.Data).Return(errors.New("something went wrong")).Times(1)
 	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(err)
+	err := suite.postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
 	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
-func (suite *PostServiceTest) TestPublishPost_WhenAbstractPostSaveFails() {
+func (suite *PostServiceTest) longRepublishDraft(draftUUID, userUUID uuid.UUID) (db.Draft, uint64) {
+	editor := models.Editor{Blocks: []models.Block{{
+		Type: models.Paragraph,
+		Data: map[string]interface{}{"text": strings.Repeat("Helm packages the manifests of an application into a chart so it can be installed and upgraded. ", 4)},
+	}}}
+	data, _ := json.Marshal(editor)
+	draft := suite.republishDraft(draftUUID, userUUID)
+	draft.Data = models.JSONString{JSONText: data}
+	fingerprint, _ := utils.ContentFingerprint(editor)
+	return draft, fingerprint
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenContentDuplicatesAnotherPost() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
 	draftUUID := uuid.New()
+	duplicateOf := uuid.New()
+	draft, fingerprint := suite.longRepublishDraft(draftUUID, userUUID)
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title"}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().FindNearest(suite.goContext, fingerprint, userUUID, postUUID, 9).Return(db.FingerprintMatch{PostID: duplicateOf, Simhash: int64(fingerprint ^ 0b111), AuthorID: uuid.New(), State: db.PostPublished, Distance: 3}, nil).Times(1)
+
+	err := suite.postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(constants.DuplicateContentError(duplicateOf), err)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenContentDuplicatesAPostTheAuthorCannotSee() {
+	postUUID := uuid.New()
 	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft, fingerprint := suite.longRepublishDraft(draftUUID, userUUID)
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title"}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().FindNearest(suite.goContext, fingerprint, userUUID, postUUID, 9).Return(db.FingerprintMatch{PostID: uuid.New(), Simhash: int64(fingerprint ^ 0b111), AuthorID: uuid.New(), State: db.PostArchived, Distance: 3}, nil).Times(1)
+
+	err := suite.postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(constants.DuplicateContentError(nil), err)
+	suite.Nil(err.AdditionalData)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenDuplicateContentIsOnlyFlagged() {
 	postUUID := uuid.New()
-	var abstractPostUUID uuid.UUID
-	tmpPreviewImage := "https://www.some-url.com"
-	tmpTagLine := ""
-	interests := "{sports,economy}"
-	draft := db.Draft{
-		DraftID: draftUUID,
-		UserID:  userUUID,
-		Data: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		PreviewImage: &tmpPreviewImage,
-		Tagline:      &tmpTagLine,
-		Interests:    &interests,
-	}
-	post := db.PublishPost{
-		UserID: userUUID,
-		PostData: models.JSONString{
-			JSONText: types.JSONText(test_helper.ContentTestData),
-		},
-		DraftID: draftUUID,
-	}
-	abstractPost := db.AbstractPost{
-		Model:        db.Model{},
-		PostID:       postUUID,
-		Title:        "Install apps via helm in kubernetes",
-		Tagline:      "",
-		PreviewImage: "https://www.some-url.com",
-		ViewTime:     22,
-	}
-	interestUUIDs := []uuid.UUID{uuid.New(), uuid.New()}
-
-	suite.mockDraftsRepository.EXPECT().GetDraft(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
-	draft.ConvertInterests(nil)
-	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(draft, suite.goContext).Return(models.MetaData{
-		Title:    "Install apps via helm in kubernetes",
-		Tagline:  "",
-		ReadTime: 22,
-	}, nil).Times(1)
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	duplicateOf := uuid.New()
+	similarity := 1 - float64(3)/64
+	draft, fingerprint := suite.longRepublishDraft(draftUUID, userUUID)
+	suite.duplicateContent.Action = "flag"
+	postService := NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockContentSanitizer, suite.mockAbstractPostRepository, suite.mockPostRevisionRepository, suite.mockSeriesService, suite.mockInterestsRepository, suite.mockTransactionManager, nil, suite.mockFingerprintRepository, suite.duplicateContent, suite.contentFilter, suite.mockReviewRepository, suite.commentThreads)
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title"}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().FindNearest(suite.goContext, fingerprint, userUUID, postUUID, 9).Return(db.FingerprintMatch{PostID: duplicateOf, Simhash: int64(fingerprint ^ 0b111), Distance: 3}, nil).Times(1)
 	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
-	suite.mockPostsRepository.EXPECT().CreatePost(suite.goContext, suite.mockTransaction, post).Return(postUUID, nil).Times(1)
-	suite.mockInterestsRepository.EXPECT().GetInterestIDs(suite.goContext, draft.InterestTags).Return(interestUUIDs, nil).Times(1)
-	suite.mockPostsRepository.EXPECT().AddInterests(suite.goContext, suite.mockTransaction, postUUID, interestUUIDs).Return(nil).Times(1)
-	suite.mockAbstractPostRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, abstractPost).Return(abstractPostUUID, errors.New("something went wrong")).Times(1)
+	suite.mockPostRevisionRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().UpdatePost(suite.goContext, suite.mockTransaction, postUUID, draft.Data).Return(nil).Times(1)
+	suite.mockFingerprintRepository.EXPECT().Save(suite.goContext, suite.mockTransaction, postUUID, fingerprint, &duplicateOf, &similarity).Return(errors.New("something went wrong")).Times(1)
 	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
 
-	err := suite.postService.PublishPost(suite.goContext, draftUUID, userUUID)
-	suite.NotNil(err)
+	err := postService.RepublishPost(suite.goContext, postUUID, userUUID)
+
 	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
 }
 
+func (suite *PostServiceTest) filteringPostService() PostService {
+	contentFilter, _ := utils.NewContentFilter([]configuration.ContentFilterRule{
+		{Name: "profanity", Action: utils.FilterMask, Words: []string{"darn"}},
+		{Name: "phone_numbers", Action: utils.FilterHold, Patterns: []string{`\b\d{10}\b`}},
+		{Name: "spam_links", Action: utils.FilterReject, Domains: []string{"spam.example"}},
+	})
+	return NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockContentSanitizer, suite.mockAbstractPostRepository, suite.mockPostRevisionRepository, suite.mockSeriesService, suite.mockInterestsRepository, suite.mockTransactionManager, nil, suite.mockFingerprintRepository, suite.duplicateContent, contentFilter, suite.mockReviewRepository, suite.commentThreads)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenTaglineIsHeldForReview() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft, _ := suite.longRepublishDraft(draftUUID, userUUID)
+	tagline := "call 9876543210 for a darn good chart"
+	draft.Tagline = &tagline
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title"}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().GetPostReviewStatus(suite.goContext, draftUUID, draft.Version).Return("", sql.ErrNoRows).Times(1)
+	suite.mockReviewRepository.EXPECT().Save(suite.goContext, db.ContentReview{
+		ContentType:    db.ReviewPost,
+		ContentID:      draftUUID,
+		ContentVersion: &draft.Version,
+		UserID:         userUUID,
+		Rules:          []string{"profanity", "phone_numbers"},
+	}).Return(nil).Times(1)
+
+	err := suite.filteringPostService().RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(&constants.ContentHeldForReviewError, err)
+}
+
+func (suite *PostServiceTest) TestRepublishPost_WhenHeldVersionWasRejected() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	draftUUID := uuid.New()
+	draft, _ := suite.longRepublishDraft(draftUUID, userUUID)
+	tagline := "call 9876543210 for a good chart"
+	draft.Tagline = &tagline
+	suite.mockPostsRepository.EXPECT().GetPostDraftID(suite.goContext, postUUID, userUUID).Return(draftUUID, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPostPublicationID(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	suite.mockDraftsRepository.EXPECT().GetDraftByUser(suite.goContext, draftUUID, userUUID).Return(draft, nil).Times(1)
+	suite.mockInterestsRepository.EXPECT().GetInterestsForName(suite.goContext, []string{"sports"}).Return([]db.Interests{{ID: uuid.New(), Name: "sports"}}, nil).Times(1)
+	suite.mockPostValidator.EXPECT().ValidateAndGetReadTime(gomock.Any(), suite.goContext).Return(models.MetaData{Title: "Edited title"}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, draft.Data).Return(draft.Data, nil, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().GetPostReviewStatus(suite.goContext, draftUUID, draft.Version).Return(db.ReviewRejected, nil).Times(1)
+
+	err := suite.filteringPostService().RepublishPost(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(constants.ContentRejectedError([]string{"phone_numbers"}), err)
+}
+
+func (suite *PostServiceTest) TestComment_WhenCommentIsMasked() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().Comment(suite.goContext, suite.mockTransaction, request.Comment{Data: "what a **** post", PostID: postUUID, CommentedBy: userUUID}).Return(uuid.New(), nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.filteringPostService().Comment(suite.goContext, request.Comment{Data: "what a darn post", PostID: postUUID, CommentedBy: userUUID})
+
+	suite.Nil(err)
+}
+
+func (suite *PostServiceTest) TestComment_WhenCommentIsHeldForReview() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	commentUUID := uuid.New()
+	comment := request.Comment{Data: "call 9876543210", PostID: postUUID, CommentedBy: userUUID}
+	heldComment := comment
+	heldComment.HeldForReview = true
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().Comment(suite.goContext, suite.mockTransaction, heldComment).Return(commentUUID, nil).Times(1)
+	suite.mockReviewRepository.EXPECT().Hold(suite.goContext, suite.mockTransaction, db.ContentReview{
+		ContentType: db.ReviewComment,
+		ContentID:   commentUUID,
+		UserID:      userUUID,
+		Rules:       []string{"phone_numbers"},
+	}).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.filteringPostService().Comment(suite.goContext, comment)
+
+	suite.Equal(&constants.ContentHeldForReviewError, err)
+}
+
+func (suite *PostServiceTest) TestComment_WhenHoldingCommentFails() {
+	comment := request.Comment{Data: "call 9876543210", PostID: uuid.New(), CommentedBy: uuid.New()}
+	heldComment := comment
+	heldComment.HeldForReview = true
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().Comment(suite.goContext, suite.mockTransaction, heldComment).Return(uuid.New(), nil).Times(1)
+	suite.mockReviewRepository.EXPECT().Hold(suite.goContext, suite.mockTransaction, gomock.Any()).Return(errors.New("something went wrong")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Times(0)
+
+	err := suite.filteringPostService().Comment(suite.goContext, comment)
+
+	suite.Equal(constants.StoryInternalServerError("something went wrong"), err)
+}
+
+func (suite *PostServiceTest) TestComment_WhenCommentIsRejected() {
+	err := suite.filteringPostService().Comment(suite.goContext, request.Comment{Data: "win at https://spam.example/prize", PostID: uuid.New(), CommentedBy: uuid.New()})
+
+	suite.Equal(constants.ContentRejectedError([]string{"spam_links"}), err)
+}
+
+func (suite *PostServiceTest) TestComment_WhenReplyingToMissingComment() {
+	parentID := uuid.New()
+	comment := request.Comment{Data: "agreed", PostID: uuid.New(), CommentedBy: uuid.New(), ParentID: &parentID}
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().Comment(suite.goContext, suite.mockTransaction, comment).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.postService.Comment(suite.goContext, comment)
+
+	suite.Equal(&constants.NoCommentFoundError, err)
+}
+
+func (suite *PostServiceTest) TestGetComments_WhenSuccess() {
+	commentsRequest := request.FetchComments{PostID: uuid.New(), UserID: uuid.New(), Limit: 10}
+	comments := []response.Comment{{ID: uuid.New(), Data: "nice post", ReplyCount: 2}}
+
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, commentsRequest.PostID, commentsRequest.UserID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().FetchComments(suite.goContext, commentsRequest, 2).Return(comments, nil).Times(1)
+
+	fetched, err := suite.postService.GetComments(suite.goContext, commentsRequest)
+
+	suite.Nil(err)
+	suite.Equal(comments, fetched)
+}
+
+func (suite *PostServiceTest) TestGetComments_WhenPostIsNotVisible() {
+	commentsRequest := request.FetchComments{PostID: uuid.New(), UserID: uuid.New(), Limit: 10}
+
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, commentsRequest.PostID, commentsRequest.UserID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+
+	fetched, err := suite.postService.GetComments(suite.goContext, commentsRequest)
+
+	suite.Nil(fetched)
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostServiceTest) TestGetReplies_WhenCommentIsAboveDisplayDepth() {
+	repliesRequest := request.FetchReplies{PostID: uuid.New(), CommentID: uuid.New(), UserID: uuid.New(), Limit: 10}
+	replies := []response.Comment{{ID: uuid.New(), Data: "agreed", ParentID: &repliesRequest.CommentID, Depth: 2}}
+
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, repliesRequest.PostID, repliesRequest.UserID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetComment(suite.goContext, repliesRequest.CommentID, repliesRequest.PostID).Return(response.Comment{ID: repliesRequest.CommentID, Depth: 1}, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().FetchReplies(suite.goContext, repliesRequest, 2).Return(replies, nil).Times(1)
+
+	fetched, err := suite.postService.GetReplies(suite.goContext, repliesRequest)
+
+	suite.Nil(err)
+	suite.Equal(replies, fetched)
+}
+
+func (suite *PostServiceTest) TestGetReplies_WhenCommentIsAtDisplayDepth() {
+	repliesRequest := request.FetchReplies{PostID: uuid.New(), CommentID: uuid.New(), UserID: uuid.New(), Limit: 10}
+	replyTo := "sam"
+	replies := []response.Comment{{ID: uuid.New(), Data: "deeper", Depth: 4, ReplyTo: &replyTo}}
+
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, repliesRequest.PostID, repliesRequest.UserID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetComment(suite.goContext, repliesRequest.CommentID, repliesRequest.PostID).Return(response.Comment{ID: repliesRequest.CommentID, Depth: 2}, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().FetchThreadReplies(suite.goContext, repliesRequest).Return(replies, nil).Times(1)
+
+	fetched, err := suite.postService.GetReplies(suite.goContext, repliesRequest)
+
+	suite.Nil(err)
+	suite.Equal(replies, fetched)
+}
+
+func (suite *PostServiceTest) TestGetReplies_WhenCommentIsNotFound() {
+	repliesRequest := request.FetchReplies{PostID: uuid.New(), CommentID: uuid.New(), UserID: uuid.New(), Limit: 10}
+
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, repliesRequest.PostID, repliesRequest.UserID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetComment(suite.goContext, repliesRequest.CommentID, repliesRequest.PostID).Return(response.Comment{}, sql.ErrNoRows).Times(1)
+
+	fetched, err := suite.postService.GetReplies(suite.goContext, repliesRequest)
+
+	suite.Nil(fetched)
+	suite.Equal(&constants.NoCommentFoundError, err)
+}
+
+func (suite *PostServiceTest) TestGetReplies_WhenPostIsDeleted() {
+	repliesRequest := request.FetchReplies{PostID: uuid.New(), CommentID: uuid.New(), UserID: uuid.New(), Limit: 10}
+
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, repliesRequest.PostID, repliesRequest.UserID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+
+	fetched, err := suite.postService.GetReplies(suite.goContext, repliesRequest)
+
+	suite.Nil(fetched)
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostServiceTest) TestDeleteComment_WhenSuccess() {
+	commentID, postID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().DeleteComment(suite.goContext, suite.mockTransaction, commentID, postID, userID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.postService.DeleteComment(suite.goContext, commentID, postID, userID)
+
+	suite.Nil(err)
+}
+
+func (suite *PostServiceTest) TestDeleteComment_WhenCommentIsNotOwned() {
+	commentID, postID, userID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().DeleteComment(suite.goContext, suite.mockTransaction, commentID, postID, userID).Return(sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.postService.DeleteComment(suite.goContext, commentID, postID, userID)
+
+	suite.Equal(&constants.NoCommentFoundError, err)
+}
+
+func (suite *PostServiceTest) TestSetSlug_WhenSuccess() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().SetCustomSlug(suite.goContext, suite.mockTransaction, postUUID, userUUID, "my-post").Return("author/my-post", nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().AddURL(suite.goContext, suite.mockTransaction, postUUID, "author/my-post").Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	url, err := suite.postService.SetSlug(suite.goContext, postUUID, userUUID, "my-post")
+
+	suite.Nil(err)
+	suite.Equal("author/my-post", url)
+}
+
+func (suite *PostServiceTest) TestSetSlug_WhenSlugIsUsedByAnotherPostOfAuthor() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().SetCustomSlug(suite.goContext, suite.mockTransaction, postUUID, userUUID, "my-post").Return("author/my-post", nil).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().AddURL(suite.goContext, suite.mockTransaction, postUUID, "author/my-post").Return(sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	_, err := suite.postService.SetSlug(suite.goContext, postUUID, userUUID, "my-post")
+
+	suite.Equal(&constants.SlugAlreadyTakenError, err)
+}
+
+func (suite *PostServiceTest) TestSetSlug_WhenPostNotOwnedByUser() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockAbstractPostRepository.EXPECT().SetCustomSlug(suite.goContext, suite.mockTransaction, postUUID, userUUID, "my-post").Return("", sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	_, err := suite.postService.SetSlug(suite.goContext, postUUID, userUUID, "my-post")
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostServiceTest) TestResolvePost_WhenURLIsAnOldURLOfPost() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockAbstractPostRepository.EXPECT().ResolveURL(suite.goContext, []string{"author/old-slug", "old-slug"}, userUUID).Return(postUUID, "author/new-slug", nil).Times(1)
+	suite.mockPostsRepository.EXPECT().FetchPost(suite.goContext, postUUID, userUUID).Return(response.Post{PostID: postUUID.String(), URL: "author/new-slug", TableOfContents: models.TableOfContents{}}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, models.JSONString{}).Return(models.JSONString{}, nil, nil).Times(1)
+	suite.mockSeriesService.EXPECT().GetNavigation(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	mockAwsServices := mocks.NewMockAwsServices(suite.mockController)
+	mockAwsServices.EXPECT().GetObjectInS3("", gomock.Any()).Return("", nil).Times(1)
+	postService := NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockContentSanitizer, suite.mockAbstractPostRepository, suite.mockPostRevisionRepository, suite.mockSeriesService, suite.mockInterestsRepository, suite.mockTransactionManager, mockAwsServices, suite.mockFingerprintRepository, suite.duplicateContent, suite.contentFilter, suite.mockReviewRepository, suite.commentThreads)
+
+	resolved, err := postService.ResolvePost(suite.goContext, "https://inclusi.blog/author/old-slug?ref=feed", userUUID, false)
+
+	suite.Nil(err)
+	suite.True(resolved.Redirect)
+	suite.Equal("author/new-slug", resolved.Post.URL)
+}
+
+func (suite *PostServiceTest) TestGetPostRevisions_WhenUserIsTheAuthor() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	data := models.JSONString{JSONText: types.JSONText(`{"blocks":[]}`)}
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, postUUID, userUUID).Return(userUUID, nil).Times(1)
+	suite.mockPostRevisionRepository.EXPECT().GetRevisions(suite.goContext, postUUID).Return([]db.PostRevision{{PostID: postUUID, Revision: 1, Data: data}}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, data).Return(data, nil, nil).Times(1)
+
+	revisions, err := suite.postService.GetPostRevisions(suite.goContext, postUUID, userUUID)
+
+	suite.Nil(err)
+	suite.Equal([]db.PostRevision{{PostID: postUUID, Revision: 1, Data: data}}, revisions)
+}
+
+func (suite *PostServiceTest) TestGetPostRevisions_WhenUserIsAReader() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	data := models.JSONString{JSONText: types.JSONText(`{"blocks":[]}`)}
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, postUUID, userUUID).Return(uuid.New(), nil).Times(1)
+	suite.mockPostRevisionRepository.EXPECT().GetRevisions(suite.goContext, postUUID).Return([]db.PostRevision{{PostID: postUUID, Revision: 1, Data: data}}, nil).Times(1)
+
+	revisions, err := suite.postService.GetPostRevisions(suite.goContext, postUUID, userUUID)
+
+	suite.Nil(err)
+	suite.Equal([]db.PostRevision{{PostID: postUUID, Revision: 1}}, revisions)
+}
+
+func (suite *PostServiceTest) TestGetPostRevisions_WhenPostIsNotVisible() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	suite.mockPostsRepository.EXPECT().GetVisibleAuthorID(suite.goContext, postUUID, userUUID).Return(uuid.Nil, sql.ErrNoRows).Times(1)
+
+	revisions, err := suite.postService.GetPostRevisions(suite.goContext, postUUID, userUUID)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+	suite.Nil(revisions)
+}
+
+func (suite *PostServiceTest) TestResolvePost_WhenURLIsNotFound() {
+	userUUID := uuid.New()
+	suite.mockAbstractPostRepository.EXPECT().ResolveURL(suite.goContext, []string{"missing"}, userUUID).Return(uuid.Nil, "", sql.ErrNoRows).Times(1)
+
+	_, err := suite.postService.ResolvePost(suite.goContext, "/missing", userUUID, false)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostServiceTest) TestGetPost_WhenTableOfContentsIsNotStored() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	data := `{"blocks":[{"id":"a","type":"header","data":{"text":"Setup","level":2}},{"id":"b","type":"paragraph","data":{"text":"body"}}]}`
+	suite.mockPostsRepository.EXPECT().FetchPost(suite.goContext, postUUID, userUUID).Return(response.Post{PostID: postUUID.String(), PostData: models.JSONString{JSONText: types.JSONText(data)}}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, models.JSONString{JSONText: types.JSONText(data)}).Return(models.JSONString{JSONText: types.JSONText(data)}, nil, nil).Times(1)
+	suite.mockSeriesService.EXPECT().GetNavigation(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	mockAwsServices := mocks.NewMockAwsServices(suite.mockController)
+	mockAwsServices.EXPECT().GetObjectInS3("", gomock.Any()).Return("", nil).Times(1)
+	postService := NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockContentSanitizer, suite.mockAbstractPostRepository, suite.mockPostRevisionRepository, suite.mockSeriesService, suite.mockInterestsRepository, suite.mockTransactionManager, mockAwsServices, suite.mockFingerprintRepository, suite.duplicateContent, suite.contentFilter, suite.mockReviewRepository, suite.commentThreads)
+
+	post, err := postService.GetPost(suite.goContext, postUUID, userUUID, true)
+
+	suite.Nil(err)
+	suite.Equal(models.TableOfContents{{Anchor: "setup", Text: "Setup", Level: 2}}, post.TableOfContents)
+	suite.Equal(`<h2 id="setup">Setup</h2><p>body</p>`, post.HTML)
+}
+
+func (suite *PostServiceTest) TestGetPost_SanitizesStoredContent() {
+	postUUID := uuid.New()
+	userUUID := uuid.New()
+	raw := models.JSONString{JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"paragraph","data":{"text":"<img src=x onerror=alert(1)>body"}}]}`)}
+	clean := models.JSONString{JSONText: types.JSONText(`{"blocks":[{"id":"a","type":"paragraph","data":{"text":"body"}}]}`)}
+	suite.mockPostsRepository.EXPECT().FetchPost(suite.goContext, postUUID, userUUID).Return(response.Post{PostID: postUUID.String(), PostData: raw, TableOfContents: models.TableOfContents{}}, nil).Times(1)
+	suite.mockContentSanitizer.EXPECT().Sanitize(suite.goContext, raw).Return(clean, nil, nil).Times(1)
+	suite.mockSeriesService.EXPECT().GetNavigation(suite.goContext, postUUID).Return(nil, nil).Times(1)
+	mockAwsServices := mocks.NewMockAwsServices(suite.mockController)
+	mockAwsServices.EXPECT().GetObjectInS3("", gomock.Any()).Return("", nil).Times(1)
+	postService := NewPostService(suite.mockPostsRepository, suite.mockDraftsRepository, suite.mockPostValidator, suite.mockContentSanitizer, suite.mockAbstractPostRepository, suite.mockPostRevisionRepository, suite.mockSeriesService, suite.mockInterestsRepository, suite.mockTransactionManager, mockAwsServices, suite.mockFingerprintRepository, suite.duplicateContent, suite.contentFilter, suite.mockReviewRepository, suite.commentThreads)
+
+	post, err := postService.GetPost(suite.goContext, postUUID, userUUID, false)
+
+	suite.Nil(err)
+	suite.Equal(clean, post.PostData)
+}
+
 func (suite *PostServiceTest) TestLikePost_WhenSuccess() {
 	postUUID := uuid.New()
 	userUUID := uuid.New()
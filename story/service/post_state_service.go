@@ -0,0 +1,100 @@
+package service
+
+//go:generate mockgen -source=post_state_service.go -destination=./../mocks/mock_post_state_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/models/db"
+	"post-api/story/repository"
+	"time"
+)
+
+type PostStateService interface {
+	SetState(ctx context.Context, postID, userID uuid.UUID, state string) *golaerror.Error
+	RestorePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
+	GetPosts(ctx context.Context, userID uuid.UUID, state string) ([]db.AuthorPost, *golaerror.Error)
+}
+
+type postStateService struct {
+	postsRepository repository.PostsRepository
+	retention       time.Duration
+}
+
+// SetState publishes, unlists or archives a post of the author. Deleted posts have to be restored first.
+func (service postStateService) SetState(ctx context.Context, postID, userID uuid.UUID, state string) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostStateService").WithField("method", "SetState")
+
+	err := service.postsRepository.SetState(ctx, postID, userID, state)
+	if err != nil {
+		logger.Errorf("unable to move post %v to state %v. Error %v", postID, state, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully moved post %v to state %v", postID, state)
+	return nil
+}
+
+// RestorePost brings a deleted post back in the state it was deleted from, as long as its retention has
+// not run out.
+func (service postStateService) RestorePost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostStateService").WithField("method", "RestorePost")
+
+	err := service.postsRepository.Restore(ctx, postID, userID, service.retention)
+	if err != nil {
+		logger.Errorf("unable to restore post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully restored post %v", postID)
+	return nil
+}
+
+// GetPosts lists the posts of the author in the state. Deleted posts are listed with the time they can be
+// restored until, leaving out those whose retention has run out.
+func (service postStateService) GetPosts(ctx context.Context, userID uuid.UUID, state string) ([]db.AuthorPost, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PostStateService").WithField("method", "GetPosts")
+
+	posts, err := service.postsRepository.GetPostsByState(ctx, userID, state)
+	if err != nil {
+		logger.Errorf("unable to fetch %v posts of user %v. Error %v", state, userID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	if state != db.PostDeleted {
+		return posts, nil
+	}
+
+	restorable := []db.AuthorPost{}
+	now := time.Now()
+	for _, post := range posts {
+		if post.DeletedAt == nil {
+			continue
+		}
+		restoreUntil := post.DeletedAt.Add(service.retention)
+		if restoreUntil.Before(now) {
+			continue
+		}
+		post.RestoreUntil = &restoreUntil
+		restorable = append(restorable, post)
+	}
+	return restorable, nil
+}
+
+func NewPostStateService(postsRepository repository.PostsRepository, config configuration.PostTrash) PostStateService {
+	return postStateService{
+		postsRepository: postsRepository,
+		retention:       trashRetention(config.RetentionInDays),
+	}
+}
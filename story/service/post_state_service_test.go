@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"testing"
+	"time"
+)
+
+type PostStateServiceTest struct {
+	suite.Suite
+	mockController      *gomock.Controller
+	goContext           context.Context
+	mockPostsRepository *mocks.MockPostsRepository
+	postStateService    PostStateService
+}
+
+func TestPostStateServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PostStateServiceTest))
+}
+
+func (suite *PostStateServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockPostsRepository = mocks.NewMockPostsRepository(suite.mockController)
+	suite.postStateService = NewPostStateService(suite.mockPostsRepository, configuration.PostTrash{RetentionInDays: 7})
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *PostStateServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *PostStateServiceTest) TestSetState_WhenSuccess() {
+	postID, userID := uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().SetState(suite.goContext, postID, userID, db.PostArchived).Return(nil).Times(1)
+
+	err := suite.postStateService.SetState(suite.goContext, postID, userID, db.PostArchived)
+
+	suite.Nil(err)
+}
+
+func (suite *PostStateServiceTest) TestSetState_WhenPostIsDeletedOrNotOwned() {
+	postID, userID := uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().SetState(suite.goContext, postID, userID, db.PostUnlisted).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.postStateService.SetState(suite.goContext, postID, userID, db.PostUnlisted)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostStateServiceTest) TestRestorePost_WhenSuccess() {
+	postID, userID := uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().Restore(suite.goContext, postID, userID, 7*24*time.Hour).Return(nil).Times(1)
+
+	err := suite.postStateService.RestorePost(suite.goContext, postID, userID)
+
+	suite.Nil(err)
+}
+
+func (suite *PostStateServiceTest) TestRestorePost_WhenRetentionHasRunOut() {
+	postID, userID := uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().Restore(suite.goContext, postID, userID, 7*24*time.Hour).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.postStateService.RestorePost(suite.goContext, postID, userID)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *PostStateServiceTest) TestGetPosts_WhenDeletedLeavesOutExpiredPosts() {
+	userID := uuid.New()
+	recent := time.Now().Add(-24 * time.Hour)
+	expired := time.Now().Add(-8 * 24 * time.Hour)
+	posts := []db.AuthorPost{
+		{PostID: uuid.New(), Title: "recent", State: db.PostDeleted, DeletedAt: &recent},
+		{PostID: uuid.New(), Title: "expired", State: db.PostDeleted, DeletedAt: &expired},
+	}
+
+	suite.mockPostsRepository.EXPECT().GetPostsByState(suite.goContext, userID, db.PostDeleted).Return(posts, nil).Times(1)
+
+	deleted, err := suite.postStateService.GetPosts(suite.goContext, userID, db.PostDeleted)
+
+	suite.Nil(err)
+	suite.Len(deleted, 1)
+	suite.Equal("recent", deleted[0].Title)
+	suite.Equal(recent.Add(7*24*time.Hour), *deleted[0].RestoreUntil)
+}
+
+func (suite *PostStateServiceTest) TestGetPosts_WhenArchived() {
+	userID := uuid.New()
+	posts := []db.AuthorPost{{PostID: uuid.New(), Title: "archived", State: db.PostArchived}}
+
+	suite.mockPostsRepository.EXPECT().GetPostsByState(suite.goContext, userID, db.PostArchived).Return(posts, nil).Times(1)
+
+	archived, err := suite.postStateService.GetPosts(suite.goContext, userID, db.PostArchived)
+
+	suite.Nil(err)
+	suite.Equal(posts, archived)
+}
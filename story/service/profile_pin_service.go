@@ -0,0 +1,167 @@
+package service
+
+//go:generate mockgen -source=profile_pin_service.go -destination=./../mocks/mock_profile_pin_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/configuration"
+	"post-api/helper"
+	"post-api/story/constants"
+	"post-api/story/repository"
+)
+
+const defaultMaxPins = 3
+
+type ProfilePinService interface {
+	SetPins(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) *golaerror.Error
+	PinPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
+	UnpinPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error
+}
+
+type profilePinService struct {
+	postsRepository    repository.PostsRepository
+	transactionManager helper.TransactionManager
+	maxPins            int
+}
+
+// SetPins replaces the pinned posts of the author with the posts in the given order, so the same call
+// pins, unpins and reorders. An empty list unpins every post.
+func (service profilePinService) SetPins(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinService").WithField("method", "SetPins")
+
+	if len(postIDs) > service.maxPins {
+		logger.Errorf("author %v tried to pin %v posts", userID, len(postIDs))
+		return constants.TooManyPinnedPostsError(service.maxPins)
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, postID := range postIDs {
+		if seen[postID] {
+			logger.Errorf("post %v is pinned more than once", postID)
+			return &constants.InvalidPinnedPostsError
+		}
+		seen[postID] = true
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	err := service.postsRepository.LockPins(ctx, txn, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to lock pinned posts of author %v. Error %v", userID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.postsRepository.ClearPins(ctx, txn, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to clear pinned posts of author %v. Error %v", userID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	for i, postID := range postIDs {
+		if apiErr := service.pin(ctx, txn, postID, userID, i+1); apiErr != nil {
+			_ = txn.Rollback()
+			return apiErr
+		}
+	}
+
+	_ = txn.Commit()
+	logger.Infof("Successfully pinned %v posts of author %v", len(postIDs), userID)
+	return nil
+}
+
+// PinPost pins a published post of the author below the posts already pinned.
+func (service profilePinService) PinPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinService").WithField("method", "PinPost")
+
+	txn := service.transactionManager.NewTransaction()
+	err := service.postsRepository.LockPins(ctx, txn, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to lock pinned posts of author %v. Error %v", userID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	pinned, err := service.postsRepository.GetPinnedPostIDs(ctx, txn, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to fetch pinned posts of author %v. Error %v", userID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	for _, pinnedID := range pinned {
+		if pinnedID == postID {
+			_ = txn.Rollback()
+			return nil
+		}
+	}
+
+	if len(pinned) >= service.maxPins {
+		_ = txn.Rollback()
+		logger.Errorf("author %v already pinned %v posts", userID, len(pinned))
+		return constants.TooManyPinnedPostsError(service.maxPins)
+	}
+
+	// unpinning leaves gaps, so the next position follows the last one taken rather than the count
+	lastPosition, err := service.postsRepository.GetLastPinnedPosition(ctx, txn, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to fetch last pinned position of author %v. Error %v", userID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	if apiErr := service.pin(ctx, txn, postID, userID, lastPosition+1); apiErr != nil {
+		_ = txn.Rollback()
+		return apiErr
+	}
+
+	_ = txn.Commit()
+	logger.Infof("Successfully pinned post %v", postID)
+	return nil
+}
+
+func (service profilePinService) UnpinPost(ctx context.Context, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinService").WithField("method", "UnpinPost")
+
+	err := service.postsRepository.Unpin(ctx, postID, userID)
+	if err != nil {
+		logger.Errorf("unable to unpin post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully unpinned post %v", postID)
+	return nil
+}
+
+func (service profilePinService) pin(ctx context.Context, txn helper.Transaction, postID, userID uuid.UUID, position int) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "ProfilePinService").WithField("method", "pin")
+
+	err := service.postsRepository.Pin(ctx, txn, postID, userID, position)
+	if err != nil {
+		logger.Errorf("unable to pin post %v. Error %v", postID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func NewProfilePinService(postsRepository repository.PostsRepository, manager helper.TransactionManager, config configuration.PinnedPosts) ProfilePinService {
+	maxPins := config.MaxPins
+	if maxPins <= 0 {
+		maxPins = defaultMaxPins
+	}
+	return profilePinService{
+		postsRepository:    postsRepository,
+		transactionManager: manager,
+		maxPins:            maxPins,
+	}
+}
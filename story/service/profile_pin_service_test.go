@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"testing"
+)
+
+type ProfilePinServiceTest struct {
+	suite.Suite
+	mockController         *gomock.Controller
+	goContext              context.Context
+	mockPostsRepository    *mocks.MockPostsRepository
+	mockTransaction        *mocks.MockTransaction
+	mockTransactionManager *mocks.MockTransactionManager
+	profilePinService      ProfilePinService
+}
+
+func TestProfilePinServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProfilePinServiceTest))
+}
+
+func (suite *ProfilePinServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockPostsRepository = mocks.NewMockPostsRepository(suite.mockController)
+	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
+	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
+	suite.profilePinService = NewProfilePinService(suite.mockPostsRepository, suite.mockTransactionManager, configuration.PinnedPosts{MaxPins: 2})
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *ProfilePinServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *ProfilePinServiceTest) TestSetPins_WhenSuccess() {
+	userID, firstPost, secondPost := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().LockPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().ClearPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().Pin(suite.goContext, suite.mockTransaction, firstPost, userID, 1).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().Pin(suite.goContext, suite.mockTransaction, secondPost, userID, 2).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.profilePinService.SetPins(suite.goContext, userID, []uuid.UUID{firstPost, secondPost})
+
+	suite.Nil(err)
+}
+
+func (suite *ProfilePinServiceTest) TestSetPins_WhenMorePostsThanAllowed() {
+	err := suite.profilePinService.SetPins(suite.goContext, uuid.New(), []uuid.UUID{uuid.New(), uuid.New(), uuid.New()})
+
+	suite.Equal(constants.TooManyPinnedPostsError(2), err)
+}
+
+func (suite *ProfilePinServiceTest) TestSetPins_WhenPostIsRepeated() {
+	postID := uuid.New()
+
+	err := suite.profilePinService.SetPins(suite.goContext, uuid.New(), []uuid.UUID{postID, postID})
+
+	suite.Equal(&constants.InvalidPinnedPostsError, err)
+}
+
+func (suite *ProfilePinServiceTest) TestSetPins_WhenPostIsNotPublished() {
+	userID, postID := uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().LockPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().ClearPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().Pin(suite.goContext, suite.mockTransaction, postID, userID, 1).Return(sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.profilePinService.SetPins(suite.goContext, userID, []uuid.UUID{postID})
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *ProfilePinServiceTest) TestPinPost_WhenSuccess() {
+	userID, pinnedPost, postID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().LockPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPinnedPostIDs(suite.goContext, suite.mockTransaction, userID).Return([]uuid.UUID{pinnedPost}, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetLastPinnedPosition(suite.goContext, suite.mockTransaction, userID).Return(1, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().Pin(suite.goContext, suite.mockTransaction, postID, userID, 2).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.profilePinService.PinPost(suite.goContext, postID, userID)
+
+	suite.Nil(err)
+}
+
+func (suite *ProfilePinServiceTest) TestPinPost_WhenAnEarlierPinWasRemoved() {
+	userID, pinnedPost, postID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().LockPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPinnedPostIDs(suite.goContext, suite.mockTransaction, userID).Return([]uuid.UUID{pinnedPost}, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetLastPinnedPosition(suite.goContext, suite.mockTransaction, userID).Return(2, nil).Times(1)
+	suite.mockPostsRepository.EXPECT().Pin(suite.goContext, suite.mockTransaction, postID, userID, 3).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.profilePinService.PinPost(suite.goContext, postID, userID)
+
+	suite.Nil(err)
+}
+
+func (suite *ProfilePinServiceTest) TestPinPost_WhenAlreadyPinned() {
+	userID, postID := uuid.New(), uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().LockPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPinnedPostIDs(suite.goContext, suite.mockTransaction, userID).Return([]uuid.UUID{postID}, nil).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.profilePinService.PinPost(suite.goContext, postID, userID)
+
+	suite.Nil(err)
+}
+
+func (suite *ProfilePinServiceTest) TestPinPost_WhenLimitIsReached() {
+	userID := uuid.New()
+
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockPostsRepository.EXPECT().LockPins(suite.goContext, suite.mockTransaction, userID).Return(nil).Times(1)
+	suite.mockPostsRepository.EXPECT().GetPinnedPostIDs(suite.goContext, suite.mockTransaction, userID).Return([]uuid.UUID{uuid.New(), uuid.New()}, nil).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.profilePinService.PinPost(suite.goContext, uuid.New(), userID)
+
+	suite.Equal(constants.TooManyPinnedPostsError(2), err)
+}
+
+func (suite *ProfilePinServiceTest) TestUnpinPost_WhenPostIsNotPinned() {
+	userID, postID := uuid.New(), uuid.New()
+
+	suite.mockPostsRepository.EXPECT().Unpin(suite.goContext, postID, userID).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.profilePinService.UnpinPost(suite.goContext, postID, userID)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
@@ -0,0 +1,342 @@
+package service
+
+//go:generate mockgen -source=publication_service.go -destination=./../mocks/mock_publication_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/service"
+	"post-api/story/constants"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"post-api/story/models/response"
+	"post-api/story/repository"
+	"time"
+)
+
+type PublicationService interface {
+	CreatePublication(ctx context.Context, publicationRequest request.CreatePublicationRequest) (uuid.UUID, *golaerror.Error)
+	GetPublication(ctx context.Context, publicationID uuid.UUID) (response.Publication, *golaerror.Error)
+	GetPublicationPosts(ctx context.Context, publicationID uuid.UUID, limit, offset int) ([]response.PublishedPost, *golaerror.Error)
+	InviteMember(ctx context.Context, publicationID, inviterID uuid.UUID, inviteRequest request.InviteMemberRequest) *golaerror.Error
+	AcceptInvite(ctx context.Context, publicationID, userID uuid.UUID) *golaerror.Error
+	SubmitDraft(ctx context.Context, publicationID, userID, draftID uuid.UUID) (uuid.UUID, *golaerror.Error)
+	GetSubmissions(ctx context.Context, publicationID, userID uuid.UUID, status string) ([]db.PublicationSubmission, *golaerror.Error)
+	GetSubmissionDraft(ctx context.Context, publicationID, submissionID, userID uuid.UUID) (db.Draft, *golaerror.Error)
+	GetSubmissionsByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.PublicationSubmission, *golaerror.Error)
+	ReviewSubmission(ctx context.Context, publicationID, submissionID, reviewerID uuid.UUID, reviewRequest request.ReviewSubmissionRequest) *golaerror.Error
+}
+
+type publicationService struct {
+	repository  repository.PublicationRepository
+	postService PostService
+	awsServices service.AwsServices
+}
+
+func (service publicationService) CreatePublication(ctx context.Context, publicationRequest request.CreatePublicationRequest) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "CreatePublication")
+
+	publicationID, err := service.repository.Create(ctx, db.Publication{
+		Name:        publicationRequest.Name,
+		Description: publicationRequest.Description,
+		OwnerID:     publicationRequest.OwnerID,
+	})
+	if err != nil {
+		logger.Errorf("unable to create publication for user %v. Error %v", publicationRequest.OwnerID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return publicationID, nil
+}
+
+func (service publicationService) GetPublication(ctx context.Context, publicationID uuid.UUID) (response.Publication, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "GetPublication")
+
+	publication, err := service.repository.GetPublication(ctx, publicationID)
+	if err != nil {
+		logger.Errorf("unable to fetch publication %v. Error %v", publicationID, err)
+		if err == sql.ErrNoRows {
+			return response.Publication{}, &constants.NoPublicationFoundError
+		}
+		return response.Publication{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	members, err := service.repository.GetMembers(ctx, publicationID)
+	if err != nil {
+		logger.Errorf("unable to fetch members of publication %v. Error %v", publicationID, err)
+		return response.Publication{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	return response.Publication{Publication: publication, Members: members}, nil
+}
+
+func (service publicationService) GetPublicationPosts(ctx context.Context, publicationID uuid.UUID, limit, offset int) ([]response.PublishedPost, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "GetPublicationPosts")
+
+	posts, err := service.repository.GetPublicationPosts(ctx, publicationID, limit, offset)
+	if err != nil {
+		logger.Errorf("unable to fetch posts of publication %v. Error %v", publicationID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	for i := range posts {
+		posts[i].PreviewImage, err = service.awsServices.GetObjectInS3(posts[i].PreviewImage, time.Hour*time.Duration(6))
+		if err != nil {
+			logger.Errorf("unable to fetch preview image from s3 %v", err)
+			return nil, &constants.InternalServerError
+		}
+	}
+
+	return posts, nil
+}
+
+// InviteMember invites a user as an editor or writer. Editors can invite writers, only the owner can
+// invite editors.
+func (service publicationService) InviteMember(ctx context.Context, publicationID, inviterID uuid.UUID, inviteRequest request.InviteMemberRequest) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "InviteMember")
+
+	inviter, apiErr := service.activeMember(ctx, publicationID, inviterID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	if !inviter.IsEditor() || (inviteRequest.Role == db.PublicationEditor && inviter.Role != db.PublicationOwner) {
+		logger.Errorf("user %v cannot invite %v to publication %v", inviterID, inviteRequest.Role, publicationID)
+		return &constants.PublicationAccessDeniedError
+	}
+
+	_, err := service.repository.GetMember(ctx, publicationID, inviteRequest.UserID)
+	if err == nil {
+		logger.Errorf("user %v is already a member of publication %v", inviteRequest.UserID, publicationID)
+		return &constants.AlreadyPublicationMemberError
+	}
+	if err != sql.ErrNoRows {
+		logger.Errorf("unable to fetch member %v of publication %v. Error %v", inviteRequest.UserID, publicationID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.repository.InviteMember(ctx, db.PublicationMember{
+		PublicationID: publicationID,
+		UserID:        inviteRequest.UserID,
+		Role:          inviteRequest.Role,
+		InvitedBy:     &inviterID,
+	})
+	if err != nil {
+		logger.Errorf("unable to invite user %v to publication %v. Error %v", inviteRequest.UserID, publicationID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+func (service publicationService) AcceptInvite(ctx context.Context, publicationID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "AcceptInvite")
+
+	err := service.repository.AcceptInvite(ctx, publicationID, userID)
+	if err != nil {
+		logger.Errorf("unable to accept invite of user %v to publication %v. Error %v", userID, publicationID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoPublicationInviteFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	return nil
+}
+
+// SubmitDraft queues one of the member's unpublished drafts for review by the publication editors. The
+// source draft of a post already in the publication can be submitted too, to have an edit of the post
+// reviewed before it replaces the published post.
+func (service publicationService) SubmitDraft(ctx context.Context, publicationID, userID, draftID uuid.UUID) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "SubmitDraft")
+
+	if _, apiErr := service.activeMember(ctx, publicationID, userID); apiErr != nil {
+		return uuid.Nil, apiErr
+	}
+
+	pending, err := service.repository.HasPendingSubmission(ctx, draftID)
+	if err != nil {
+		logger.Errorf("unable to check submissions of draft %v. Error %v", draftID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+	if pending {
+		logger.Errorf("draft %v is already waiting for review", draftID)
+		return uuid.Nil, &constants.DraftAlreadySubmittedError
+	}
+
+	submissionID, err := service.repository.CreateSubmission(ctx, db.PublicationSubmission{
+		PublicationID: publicationID,
+		DraftID:       draftID,
+		AuthorID:      userID,
+	})
+	if err != nil {
+		logger.Errorf("unable to submit draft %v to publication %v. Error %v", draftID, publicationID, err)
+		if err == sql.ErrNoRows {
+			return uuid.Nil, &constants.NoDraftFoundError
+		}
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("Successfully submitted draft %v to publication %v", draftID, publicationID)
+	return submissionID, nil
+}
+
+func (service publicationService) GetSubmissions(ctx context.Context, publicationID, userID uuid.UUID, status string) ([]db.PublicationSubmission, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "GetSubmissions")
+
+	if apiErr := service.checkEditor(ctx, publicationID, userID); apiErr != nil {
+		return nil, apiErr
+	}
+
+	if status == "" {
+		status = db.SubmissionPending
+	}
+
+	submissions, err := service.repository.GetSubmissions(ctx, publicationID, status)
+	if err != nil {
+		logger.Errorf("unable to fetch submissions of publication %v. Error %v", publicationID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return submissions, nil
+}
+
+// GetSubmissionDraft returns the draft of a submission for the editors to review. The draft version tells
+// whether the writer edited the draft after submitting it.
+func (service publicationService) GetSubmissionDraft(ctx context.Context, publicationID, submissionID, userID uuid.UUID) (db.Draft, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "GetSubmissionDraft")
+
+	if apiErr := service.checkEditor(ctx, publicationID, userID); apiErr != nil {
+		return db.Draft{}, apiErr
+	}
+
+	draft, err := service.repository.GetSubmissionDraft(ctx, publicationID, submissionID)
+	if err != nil {
+		logger.Errorf("unable to fetch draft of submission %v. Error %v", submissionID, err)
+		if err == sql.ErrNoRows {
+			return db.Draft{}, &constants.NoSubmissionFoundError
+		}
+		return db.Draft{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	return draft, nil
+}
+
+func (service publicationService) GetSubmissionsByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.PublicationSubmission, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "GetSubmissionsByAuthor")
+
+	submissions, err := service.repository.GetSubmissionsByAuthor(ctx, authorID)
+	if err != nil {
+		logger.Errorf("unable to fetch submissions of author %v. Error %v", authorID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return submissions, nil
+}
+
+// ReviewSubmission records an editor decision on a pending submission. An approval claims the
+// submission before publishing, and if the draft then fails to publish, or was edited after it was
+// submitted, the submission goes back to the writer as changes requested with the publish error as
+// feedback.
+func (service publicationService) ReviewSubmission(ctx context.Context, publicationID, submissionID, reviewerID uuid.UUID, reviewRequest request.ReviewSubmissionRequest) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "ReviewSubmission")
+
+	if apiErr := service.checkEditor(ctx, publicationID, reviewerID); apiErr != nil {
+		return apiErr
+	}
+
+	submission, err := service.repository.GetSubmission(ctx, publicationID, submissionID)
+	if err != nil {
+		logger.Errorf("unable to fetch submission %v. Error %v", submissionID, err)
+		if err == sql.ErrNoRows {
+			return &constants.NoSubmissionFoundError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	status := map[string]string{
+		request.ApproveSubmission:        db.SubmissionApproved,
+		request.RequestSubmissionChanges: db.SubmissionChangesRequested,
+		request.RejectSubmission:         db.SubmissionRejected,
+	}[reviewRequest.Decision]
+
+	if apiErr := service.moveSubmission(ctx, submission.ID, db.SubmissionPending, status, reviewerID, reviewRequest.Feedback); apiErr != nil {
+		return apiErr
+	}
+
+	if status != db.SubmissionApproved {
+		logger.Infof("Submission %v moved to %v", submissionID, status)
+		return nil
+	}
+
+	url, publishErr := service.postService.PublishToPublication(ctx, submission.DraftID, submission.AuthorID, publicationID, submission.DraftVersion)
+	if publishErr != nil {
+		logger.Errorf("unable to publish submission %v. Error %v", submissionID, publishErr)
+		feedback := describePublishError(publishErr)
+		if apiErr := service.moveSubmission(ctx, submission.ID, db.SubmissionApproved, db.SubmissionChangesRequested, reviewerID, &feedback); apiErr != nil {
+			logger.Errorf("unable to return submission %v to the writer. Error %v", submissionID, apiErr)
+		}
+		return publishErr
+	}
+
+	logger.Infof("Successfully published submission %v as %v", submissionID, url)
+	return nil
+}
+
+func (service publicationService) moveSubmission(ctx context.Context, submissionID uuid.UUID, fromStatus, toStatus string, reviewerID uuid.UUID, feedback *string) *golaerror.Error {
+	err := service.repository.UpdateSubmissionStatus(ctx, submissionID, fromStatus, toStatus, reviewerID, feedback)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &constants.SubmissionAlreadyReviewedError
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func (service publicationService) activeMember(ctx context.Context, publicationID, userID uuid.UUID) (db.PublicationMember, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "activeMember")
+
+	member, err := service.repository.GetMember(ctx, publicationID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Errorf("user %v is not a member of publication %v", userID, publicationID)
+			return db.PublicationMember{}, &constants.PublicationAccessDeniedError
+		}
+		return db.PublicationMember{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	if member.Status != db.MemberActive {
+		logger.Errorf("user %v has not accepted the invite to publication %v", userID, publicationID)
+		return db.PublicationMember{}, &constants.PublicationAccessDeniedError
+	}
+
+	return member, nil
+}
+
+func (service publicationService) checkEditor(ctx context.Context, publicationID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PublicationService").WithField("method", "checkEditor")
+
+	member, apiErr := service.activeMember(ctx, publicationID, userID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	if !member.IsEditor() {
+		logger.Errorf("user %v is not an editor of publication %v", userID, publicationID)
+		return &constants.PublicationAccessDeniedError
+	}
+
+	return nil
+}
+
+func NewPublicationService(publicationRepository repository.PublicationRepository, postService PostService, services service.AwsServices) PublicationService {
+	return publicationService{
+		repository:  publicationRepository,
+		postService: postService,
+		awsServices: services,
+	}
+}
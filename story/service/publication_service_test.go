@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"testing"
+)
+
+type PublicationServiceTest struct {
+	suite.Suite
+	mockController            *gomock.Controller
+	goContext                 context.Context
+	mockPublicationRepository *mocks.MockPublicationRepository
+	mockPostService           *mocks.MockPostService
+	publicationService        PublicationService
+}
+
+func TestPublicationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PublicationServiceTest))
+}
+
+func (suite *PublicationServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockPublicationRepository = mocks.NewMockPublicationRepository(suite.mockController)
+	suite.mockPostService = mocks.NewMockPostService(suite.mockController)
+	suite.publicationService = NewPublicationService(suite.mockPublicationRepository, suite.mockPostService, nil)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *PublicationServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *PublicationServiceTest) member(publicationID, userID uuid.UUID, role string) db.PublicationMember {
+	return db.PublicationMember{PublicationID: publicationID, UserID: userID, Role: role, Status: db.MemberActive}
+}
+
+func (suite *PublicationServiceTest) TestInviteMember_WhenEditorInvitesWriter() {
+	publicationID, editorID, writerID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationEditor), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, writerID).Return(db.PublicationMember{}, sql.ErrNoRows).Times(1)
+	suite.mockPublicationRepository.EXPECT().InviteMember(suite.goContext, db.PublicationMember{
+		PublicationID: publicationID,
+		UserID:        writerID,
+		Role:          db.PublicationWriter,
+		InvitedBy:     &editorID,
+	}).Return(nil).Times(1)
+
+	err := suite.publicationService.InviteMember(suite.goContext, publicationID, editorID, request.InviteMemberRequest{UserID: writerID, Role: db.PublicationWriter})
+
+	suite.Nil(err)
+}
+
+func (suite *PublicationServiceTest) TestInviteMember_WhenEditorInvitesEditor() {
+	publicationID, editorID := uuid.New(), uuid.New()
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationEditor), nil).Times(1)
+
+	err := suite.publicationService.InviteMember(suite.goContext, publicationID, editorID, request.InviteMemberRequest{UserID: uuid.New(), Role: db.PublicationEditor})
+
+	suite.Equal(&constants.PublicationAccessDeniedError, err)
+}
+
+func (suite *PublicationServiceTest) TestSubmitDraft_WhenDraftIsAlreadyPending() {
+	publicationID, writerID, draftID := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, writerID).Return(suite.member(publicationID, writerID, db.PublicationWriter), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().HasPendingSubmission(suite.goContext, draftID).Return(true, nil).Times(1)
+
+	_, err := suite.publicationService.SubmitDraft(suite.goContext, publicationID, writerID, draftID)
+
+	suite.Equal(&constants.DraftAlreadySubmittedError, err)
+}
+
+func (suite *PublicationServiceTest) TestSubmitDraft_WhenUserHasNotAcceptedInvite() {
+	publicationID, writerID := uuid.New(), uuid.New()
+	member := suite.member(publicationID, writerID, db.PublicationWriter)
+	member.Status = db.MemberInvited
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, writerID).Return(member, nil).Times(1)
+
+	_, err := suite.publicationService.SubmitDraft(suite.goContext, publicationID, writerID, uuid.New())
+
+	suite.Equal(&constants.PublicationAccessDeniedError, err)
+}
+
+func (suite *PublicationServiceTest) TestReviewSubmission_WhenApproved() {
+	publicationID, editorID := uuid.New(), uuid.New()
+	submission := db.PublicationSubmission{ID: uuid.New(), PublicationID: publicationID, DraftID: uuid.New(), DraftVersion: 3, AuthorID: uuid.New(), Status: db.SubmissionPending}
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationEditor), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().GetSubmission(suite.goContext, publicationID, submission.ID).Return(submission, nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().UpdateSubmissionStatus(suite.goContext, submission.ID, db.SubmissionPending, db.SubmissionApproved, editorID, nil).Return(nil).Times(1)
+	suite.mockPostService.EXPECT().PublishToPublication(suite.goContext, submission.DraftID, submission.AuthorID, publicationID, int64(3)).Return("some-url", nil).Times(1)
+
+	err := suite.publicationService.ReviewSubmission(suite.goContext, publicationID, submission.ID, editorID, request.ReviewSubmissionRequest{Decision: request.ApproveSubmission})
+
+	suite.Nil(err)
+}
+
+func (suite *PublicationServiceTest) TestReviewSubmission_WhenApprovedDraftFailsToPublish() {
+	publicationID, editorID := uuid.New(), uuid.New()
+	submission := db.PublicationSubmission{ID: uuid.New(), PublicationID: publicationID, DraftID: uuid.New(), DraftVersion: 3, AuthorID: uuid.New(), Status: db.SubmissionPending}
+	feedback := "read time requirement not meet: Please Enter some more content to the draft before publishing"
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationOwner), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().GetSubmission(suite.goContext, publicationID, submission.ID).Return(submission, nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().UpdateSubmissionStatus(suite.goContext, submission.ID, db.SubmissionPending, db.SubmissionApproved, editorID, nil).Return(nil).Times(1)
+	suite.mockPostService.EXPECT().PublishToPublication(suite.goContext, submission.DraftID, submission.AuthorID, publicationID, int64(3)).Return("", &constants.ReadTimeNotMeetError).Times(1)
+	suite.mockPublicationRepository.EXPECT().UpdateSubmissionStatus(suite.goContext, submission.ID, db.SubmissionApproved, db.SubmissionChangesRequested, editorID, &feedback).Return(nil).Times(1)
+
+	err := suite.publicationService.ReviewSubmission(suite.goContext, publicationID, submission.ID, editorID, request.ReviewSubmissionRequest{Decision: request.ApproveSubmission})
+
+	suite.Equal(&constants.ReadTimeNotMeetError, err)
+}
+
+func (suite *PublicationServiceTest) TestReviewSubmission_WhenDraftChangedAfterSubmit() {
+	publicationID, editorID := uuid.New(), uuid.New()
+	submission := db.PublicationSubmission{ID: uuid.New(), PublicationID: publicationID, DraftID: uuid.New(), DraftVersion: 3, AuthorID: uuid.New(), Status: db.SubmissionPending}
+	feedback := constants.DraftChangedAfterSubmitError.ErrorMessage
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationEditor), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().GetSubmission(suite.goContext, publicationID, submission.ID).Return(submission, nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().UpdateSubmissionStatus(suite.goContext, submission.ID, db.SubmissionPending, db.SubmissionApproved, editorID, nil).Return(nil).Times(1)
+	suite.mockPostService.EXPECT().PublishToPublication(suite.goContext, submission.DraftID, submission.AuthorID, publicationID, int64(3)).Return("", &constants.DraftChangedAfterSubmitError).Times(1)
+	suite.mockPublicationRepository.EXPECT().UpdateSubmissionStatus(suite.goContext, submission.ID, db.SubmissionApproved, db.SubmissionChangesRequested, editorID, &feedback).Return(nil).Times(1)
+
+	err := suite.publicationService.ReviewSubmission(suite.goContext, publicationID, submission.ID, editorID, request.ReviewSubmissionRequest{Decision: request.ApproveSubmission})
+
+	suite.Equal(&constants.DraftChangedAfterSubmitError, err)
+}
+
+func (suite *PublicationServiceTest) TestGetSubmissionDraft_WhenUserIsEditor() {
+	publicationID, editorID, submissionID := uuid.New(), uuid.New(), uuid.New()
+	draft := db.Draft{DraftID: uuid.New(), Version: 3}
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationEditor), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().GetSubmissionDraft(suite.goContext, publicationID, submissionID).Return(draft, nil).Times(1)
+
+	actualDraft, err := suite.publicationService.GetSubmissionDraft(suite.goContext, publicationID, submissionID, editorID)
+
+	suite.Nil(err)
+	suite.Equal(draft, actualDraft)
+}
+
+func (suite *PublicationServiceTest) TestGetSubmissionDraft_WhenUserIsWriter() {
+	publicationID, writerID := uuid.New(), uuid.New()
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, writerID).Return(suite.member(publicationID, writerID, db.PublicationWriter), nil).Times(1)
+
+	_, err := suite.publicationService.GetSubmissionDraft(suite.goContext, publicationID, uuid.New(), writerID)
+
+	suite.Equal(&constants.PublicationAccessDeniedError, err)
+}
+
+func (suite *PublicationServiceTest) TestReviewSubmission_WhenAlreadyReviewed() {
+	publicationID, editorID := uuid.New(), uuid.New()
+	submission := db.PublicationSubmission{ID: uuid.New(), PublicationID: publicationID, Status: db.SubmissionRejected}
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, editorID).Return(suite.member(publicationID, editorID, db.PublicationEditor), nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().GetSubmission(suite.goContext, publicationID, submission.ID).Return(submission, nil).Times(1)
+	suite.mockPublicationRepository.EXPECT().UpdateSubmissionStatus(suite.goContext, submission.ID, db.SubmissionPending, db.SubmissionRejected, editorID, nil).Return(sql.ErrNoRows).Times(1)
+
+	err := suite.publicationService.ReviewSubmission(suite.goContext, publicationID, submission.ID, editorID, request.ReviewSubmissionRequest{Decision: request.RejectSubmission})
+
+	suite.Equal(&constants.SubmissionAlreadyReviewedError, err)
+}
+
+func (suite *PublicationServiceTest) TestReviewSubmission_WhenReviewerIsWriter() {
+	publicationID, writerID := uuid.New(), uuid.New()
+
+	suite.mockPublicationRepository.EXPECT().GetMember(suite.goContext, publicationID, writerID).Return(suite.member(publicationID, writerID, db.PublicationWriter), nil).Times(1)
+
+	err := suite.publicationService.ReviewSubmission(suite.goContext, publicationID, uuid.New(), writerID, request.ReviewSubmissionRequest{Decision: request.ApproveSubmission})
+
+	suite.Equal(&constants.PublicationAccessDeniedError, err)
+}
@@ -0,0 +1,151 @@
+package service
+
+//go:generate mockgen -source=publish_scheduler.go -destination=./../mocks/mock_publish_scheduler.go -package=mocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/models/db"
+	"post-api/story/repository"
+	"time"
+
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+)
+
+const (
+	defaultPublishInterval  = 30 * time.Second
+	defaultPublishBatchSize = 20
+	defaultPublishLease     = 5 * time.Minute
+	defaultPublishAttempts  = 5
+	defaultPublishBackoff   = time.Minute
+)
+
+type PublishScheduler interface {
+	Start(ctx context.Context)
+	PublishDue(ctx context.Context)
+}
+
+// publishScheduler publishes drafts whose publish_at has passed. The schedule lives on the drafts
+// table, so nothing is lost on restart: due drafts are simply picked up on the next tick. Failures the
+// author has to act on, like a draft that does not validate or content held for review, are recorded
+// on the draft right away, while server errors are retried with a growing delay up to max attempts.
+type publishScheduler struct {
+	draftRepository repository.DraftRepository
+	postService     PostService
+	interval        time.Duration
+	batchSize       int
+	lease           time.Duration
+	maxAttempts     int
+	backoff         time.Duration
+}
+
+func (scheduler publishScheduler) Start(ctx context.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublishScheduler").WithField("method", "Start")
+	logger.Infof("Starting publish scheduler with interval %v", scheduler.interval)
+
+	ticker := time.NewTicker(scheduler.interval)
+	defer ticker.Stop()
+
+	for {
+		scheduler.PublishDue(ctx)
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping publish scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (scheduler publishScheduler) PublishDue(ctx context.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublishScheduler").WithField("method", "PublishDue")
+
+	drafts, err := scheduler.draftRepository.GetDueScheduledDrafts(ctx, scheduler.batchSize)
+	if err != nil {
+		logger.Errorf("unable to fetch due scheduled drafts %v", err)
+		return
+	}
+
+	for _, draft := range drafts {
+		claimed, err := scheduler.draftRepository.ClaimScheduledDraft(ctx, draft.DraftID, scheduler.lease)
+		if err != nil {
+			logger.Errorf("unable to claim scheduled draft %v. Error %v", draft.DraftID, err)
+			continue
+		}
+		if !claimed {
+			logger.Infof("scheduled draft %v already claimed by another instance", draft.DraftID)
+			continue
+		}
+
+		url, publishErr := scheduler.postService.PublishPost(ctx, draft.DraftID, draft.UserID)
+		if publishErr != nil {
+			logger.Errorf("unable to publish scheduled draft %v. Error %v", draft.DraftID, publishErr)
+			scheduler.recordFailure(ctx, draft, publishErr)
+			continue
+		}
+
+		logger.Infof("Successfully published scheduled draft %v as %v", draft.DraftID, url)
+	}
+}
+
+func (scheduler publishScheduler) recordFailure(ctx context.Context, draft db.ScheduledDraft, publishErr *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "PublishScheduler").WithField("method", "recordFailure")
+
+	attempts := draft.PublishAttempts + 1
+	if isTransientPublishError(publishErr) && attempts < scheduler.maxAttempts {
+		delay := scheduler.backoff << (attempts - 1)
+		if err := scheduler.draftRepository.DelayPublish(ctx, draft.DraftID, delay); err != nil {
+			logger.Errorf("unable to delay publish of draft %v. Error %v", draft.DraftID, err)
+		}
+		return
+	}
+
+	if err := scheduler.draftRepository.UpdatePublishError(ctx, draft.DraftID, describePublishError(publishErr)); err != nil {
+		logger.Errorf("unable to record publish failure for draft %v. Error %v", draft.DraftID, err)
+	}
+}
+
+// isTransientPublishError reports whether a publish failed on the server side, so trying again later
+// can succeed without the author changing anything.
+func isTransientPublishError(err *golaerror.Error) bool {
+	return constants.GetGolaHttpCode(err.ErrorCode) >= http.StatusInternalServerError
+}
+
+func describePublishError(err *golaerror.Error) string {
+	if additionalData, ok := err.AdditionalData.(string); ok && additionalData != "" {
+		return fmt.Sprintf("%s: %s", err.ErrorMessage, additionalData)
+	}
+	return err.ErrorMessage
+}
+
+func NewPublishScheduler(draftRepository repository.DraftRepository, postService PostService, config configuration.PublishScheduler) PublishScheduler {
+	scheduler := publishScheduler{
+		draftRepository: draftRepository,
+		postService:     postService,
+		interval:        time.Duration(config.IntervalInSeconds) * time.Second,
+		batchSize:       config.BatchSize,
+		lease:           time.Duration(config.LeaseInSeconds) * time.Second,
+		maxAttempts:     config.MaxAttempts,
+		backoff:         time.Duration(config.RetryBackoffInSeconds) * time.Second,
+	}
+	if scheduler.interval <= 0 {
+		scheduler.interval = defaultPublishInterval
+	}
+	if scheduler.batchSize <= 0 {
+		scheduler.batchSize = defaultPublishBatchSize
+	}
+	if scheduler.lease <= 0 {
+		scheduler.lease = defaultPublishLease
+	}
+	if scheduler.maxAttempts <= 0 {
+		scheduler.maxAttempts = defaultPublishAttempts
+	}
+	if scheduler.backoff <= 0 {
+		scheduler.backoff = defaultPublishBackoff
+	}
+	return scheduler
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"testing"
+	"time"
+)
+
+type PublishSchedulerTest struct {
+	suite.Suite
+	mockController      *gomock.Controller
+	goContext           context.Context
+	mockDraftRepository *mocks.MockDraftRepository
+	mockPostService     *mocks.MockPostService
+	publishScheduler    PublishScheduler
+}
+
+func TestPublishSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(PublishSchedulerTest))
+}
+
+func (suite *PublishSchedulerTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockDraftRepository = mocks.NewMockDraftRepository(suite.mockController)
+	suite.mockPostService = mocks.NewMockPostService(suite.mockController)
+	suite.publishScheduler = NewPublishScheduler(suite.mockDraftRepository, suite.mockPostService, configuration.PublishScheduler{BatchSize: 10, LeaseInSeconds: 60})
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *PublishSchedulerTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenDraftIsPublished() {
+	draft := db.ScheduledDraft{DraftID: uuid.New(), UserID: uuid.New(), PublishAt: time.Now()}
+
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return([]db.ScheduledDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(suite.goContext, draft.DraftID, time.Minute).Return(true, nil).Times(1)
+	suite.mockPostService.EXPECT().PublishPost(suite.goContext, draft.DraftID, draft.UserID).Return("some-url", nil).Times(1)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenValidationFailsRecordsError() {
+	draft := db.ScheduledDraft{DraftID: uuid.New(), UserID: uuid.New(), PublishAt: time.Now()}
+
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return([]db.ScheduledDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(suite.goContext, draft.DraftID, time.Minute).Return(true, nil).Times(1)
+	suite.mockPostService.EXPECT().PublishPost(suite.goContext, draft.DraftID, draft.UserID).Return("", &constants.ReadTimeNotMeetError).Times(1)
+	suite.mockDraftRepository.EXPECT().UpdatePublishError(suite.goContext, draft.DraftID, "read time requirement not meet: Please Enter some more content to the draft before publishing").Return(nil).Times(1)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenContentIsHeldRecordsError() {
+	draft := db.ScheduledDraft{DraftID: uuid.New(), UserID: uuid.New(), PublishAt: time.Now()}
+
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return([]db.ScheduledDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(suite.goContext, draft.DraftID, time.Minute).Return(true, nil).Times(1)
+	suite.mockPostService.EXPECT().PublishPost(suite.goContext, draft.DraftID, draft.UserID).Return("", &constants.ContentHeldForReviewError).Times(1)
+	suite.mockDraftRepository.EXPECT().UpdatePublishError(suite.goContext, draft.DraftID, gomock.Any()).Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().DelayPublish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenServerFailsDelaysRetry() {
+	draft := db.ScheduledDraft{DraftID: uuid.New(), UserID: uuid.New(), PublishAt: time.Now(), PublishAttempts: 2}
+
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return([]db.ScheduledDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(suite.goContext, draft.DraftID, time.Minute).Return(true, nil).Times(1)
+	suite.mockPostService.EXPECT().PublishPost(suite.goContext, draft.DraftID, draft.UserID).Return("", constants.StoryInternalServerError("connection reset")).Times(1)
+	suite.mockDraftRepository.EXPECT().DelayPublish(suite.goContext, draft.DraftID, 4*time.Minute).Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().UpdatePublishError(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenRetriesAreExhaustedRecordsError() {
+	draft := db.ScheduledDraft{DraftID: uuid.New(), UserID: uuid.New(), PublishAt: time.Now(), PublishAttempts: 4}
+
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return([]db.ScheduledDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(suite.goContext, draft.DraftID, time.Minute).Return(true, nil).Times(1)
+	suite.mockPostService.EXPECT().PublishPost(suite.goContext, draft.DraftID, draft.UserID).Return("", constants.StoryInternalServerError("connection reset")).Times(1)
+	suite.mockDraftRepository.EXPECT().UpdatePublishError(suite.goContext, draft.DraftID, gomock.Any()).Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().DelayPublish(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenDraftClaimedElsewhere() {
+	draft := db.ScheduledDraft{DraftID: uuid.New(), UserID: uuid.New(), PublishAt: time.Now()}
+
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return([]db.ScheduledDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(suite.goContext, draft.DraftID, time.Minute).Return(false, nil).Times(1)
+	suite.mockPostService.EXPECT().PublishPost(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
+
+func (suite *PublishSchedulerTest) TestPublishDue_WhenFetchingDueDraftsFails() {
+	suite.mockDraftRepository.EXPECT().GetDueScheduledDrafts(suite.goContext, 10).Return(nil, errors.New("something went wrong")).Times(1)
+	suite.mockDraftRepository.EXPECT().ClaimScheduledDraft(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	suite.publishScheduler.PublishDue(suite.goContext)
+}
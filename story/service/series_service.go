@@ -0,0 +1,310 @@
+package service
+
+//go:generate mockgen -source=series_service.go -destination=./../mocks/mock_series_service.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/helper"
+	"post-api/story/constants"
+	"post-api/story/models/db"
+	"post-api/story/models/request"
+	"post-api/story/models/response"
+	"post-api/story/repository"
+)
+
+type SeriesService interface {
+	CreateSeries(ctx context.Context, seriesRequest request.CreateSeriesRequest) (uuid.UUID, *golaerror.Error)
+	GetSeries(ctx context.Context, seriesID uuid.UUID) (response.Series, *golaerror.Error)
+	GetSeriesByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.Series, *golaerror.Error)
+	AddPost(ctx context.Context, seriesID, postID, userID uuid.UUID) *golaerror.Error
+	RemovePost(ctx context.Context, seriesID, postID, userID uuid.UUID) *golaerror.Error
+	ReorderPosts(ctx context.Context, seriesID, userID uuid.UUID, postIDs []uuid.UUID) *golaerror.Error
+	GetNavigation(ctx context.Context, postID uuid.UUID) (*response.SeriesNavigation, *golaerror.Error)
+}
+
+type seriesService struct {
+	repository         repository.SeriesRepository
+	transactionManager helper.TransactionManager
+}
+
+func (service seriesService) CreateSeries(ctx context.Context, seriesRequest request.CreateSeriesRequest) (uuid.UUID, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "CreateSeries")
+
+	seriesID, err := service.repository.Create(ctx, db.Series{
+		AuthorID:    seriesRequest.AuthorID,
+		Title:       seriesRequest.Title,
+		Description: seriesRequest.Description,
+	})
+	if err != nil {
+		logger.Errorf("unable to create series for author %v. Error %v", seriesRequest.AuthorID, err)
+		return uuid.Nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return seriesID, nil
+}
+
+func (service seriesService) GetSeries(ctx context.Context, seriesID uuid.UUID) (response.Series, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "GetSeries")
+
+	series, apiErr := service.fetchSeries(ctx, seriesID)
+	if apiErr != nil {
+		return response.Series{}, apiErr
+	}
+
+	posts, err := service.repository.GetSeriesPosts(ctx, seriesID)
+	if err != nil {
+		logger.Errorf("unable to fetch posts of series %v. Error %v", seriesID, err)
+		return response.Series{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	return response.Series{
+		ID:          series.ID,
+		AuthorID:    series.AuthorID,
+		Title:       series.Title,
+		Description: series.Description,
+		CreatedAt:   series.CreatedAt,
+		Posts:       seriesEntries(posts),
+	}, nil
+}
+
+func (service seriesService) GetSeriesByAuthor(ctx context.Context, authorID uuid.UUID) ([]db.Series, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "GetSeriesByAuthor")
+
+	series, err := service.repository.GetSeriesByAuthor(ctx, authorID)
+	if err != nil {
+		logger.Errorf("unable to fetch series for author %v. Error %v", authorID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	return series, nil
+}
+
+func (service seriesService) AddPost(ctx context.Context, seriesID, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "AddPost")
+
+	if apiErr := service.checkSeriesOwner(ctx, seriesID, userID); apiErr != nil {
+		return apiErr
+	}
+
+	_, err := service.repository.GetSeriesForPost(ctx, postID)
+	if err == nil {
+		logger.Errorf("post %v is already part of a series", postID)
+		return &constants.PostAlreadyInSeriesError
+	}
+	if err != sql.ErrNoRows {
+		logger.Errorf("unable to fetch series of post %v. Error %v", postID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	if err = service.repository.Lock(ctx, txn, seriesID); err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to lock series %v. Error %v", seriesID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	err = service.repository.AddPost(ctx, txn, seriesID, postID, userID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to add post %v to series %v. Error %v", postID, seriesID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	_ = txn.Commit()
+	logger.Infof("Successfully added post %v to series %v", postID, seriesID)
+	return nil
+}
+
+func (service seriesService) RemovePost(ctx context.Context, seriesID, postID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "RemovePost")
+
+	if apiErr := service.checkSeriesOwner(ctx, seriesID, userID); apiErr != nil {
+		return apiErr
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	err := service.repository.RemovePost(ctx, txn, seriesID, postID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to remove post %v from series %v. Error %v", postID, seriesID, err)
+		if err == sql.ErrNoRows {
+			return &constants.PostNotFoundErr
+		}
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	_ = txn.Commit()
+	logger.Infof("Successfully removed post %v from series %v", postID, seriesID)
+	return nil
+}
+
+// ReorderPosts stores the given order as the series order. The order has to name every published post
+// of the series exactly once, so a stale client cannot silently drop a post. Posts that are no longer
+// published keep their positions and the published posts are moved between the positions they hold.
+func (service seriesService) ReorderPosts(ctx context.Context, seriesID, userID uuid.UUID, postIDs []uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "ReorderPosts")
+
+	if apiErr := service.checkSeriesOwner(ctx, seriesID, userID); apiErr != nil {
+		return apiErr
+	}
+
+	txn := service.transactionManager.NewTransaction()
+	if err := service.repository.Lock(ctx, txn, seriesID); err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to lock series %v. Error %v", seriesID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	posts, err := service.repository.GetPositions(ctx, txn, seriesID)
+	if err != nil {
+		_ = txn.Rollback()
+		logger.Errorf("unable to fetch posts of series %v. Error %v", seriesID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+
+	var published []db.SeriesPost
+	for _, post := range posts {
+		if post.State == db.PostPublished {
+			published = append(published, post)
+		}
+	}
+
+	if !isPermutation(published, postIDs) {
+		_ = txn.Rollback()
+		logger.Errorf("order %v does not match posts of series %v", postIDs, seriesID)
+		return &constants.InvalidSeriesOrderError
+	}
+
+	for i, postID := range postIDs {
+		err = service.repository.UpdatePosition(ctx, txn, seriesID, postID, published[i].Position)
+		if err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to reorder series %v. Error %v", seriesID, err)
+			return constants.StoryInternalServerError(err.Error())
+		}
+	}
+
+	if err = txn.Commit(); err != nil {
+		logger.Errorf("unable to commit order of series %v. Error %v", seriesID, err)
+		return constants.StoryInternalServerError(err.Error())
+	}
+	logger.Infof("Successfully reordered series %v", seriesID)
+	return nil
+}
+
+// GetNavigation returns the series navigation for a post, or nil when the post is not part of a series.
+func (service seriesService) GetNavigation(ctx context.Context, postID uuid.UUID) (*response.SeriesNavigation, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "GetNavigation")
+
+	series, err := service.repository.GetSeriesForPost(ctx, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logger.Errorf("unable to fetch series of post %v. Error %v", postID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	posts, err := service.repository.GetSeriesPosts(ctx, series.ID)
+	if err != nil {
+		logger.Errorf("unable to fetch posts of series %v. Error %v", series.ID, err)
+		return nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	contents := seriesEntries(posts)
+	navigation := &response.SeriesNavigation{
+		ID:       series.ID,
+		Title:    series.Title,
+		Contents: contents,
+	}
+	for i, entry := range contents {
+		if entry.PostID != postID {
+			continue
+		}
+		if i > 0 {
+			navigation.Previous = &contents[i-1]
+		}
+		if i < len(contents)-1 {
+			navigation.Next = &contents[i+1]
+		}
+	}
+
+	return navigation, nil
+}
+
+func (service seriesService) fetchSeries(ctx context.Context, seriesID uuid.UUID) (db.Series, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "fetchSeries")
+
+	series, err := service.repository.GetSeries(ctx, seriesID)
+	if err != nil {
+		logger.Errorf("unable to fetch series %v. Error %v", seriesID, err)
+		if err == sql.ErrNoRows {
+			return db.Series{}, &constants.NoSeriesFoundError
+		}
+		return db.Series{}, constants.StoryInternalServerError(err.Error())
+	}
+
+	return series, nil
+}
+
+func (service seriesService) checkSeriesOwner(ctx context.Context, seriesID, userID uuid.UUID) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "SeriesService").WithField("method", "checkSeriesOwner")
+
+	series, apiErr := service.fetchSeries(ctx, seriesID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	if series.AuthorID != userID {
+		logger.Errorf("user %v is not the author of series %v", userID, seriesID)
+		return &constants.NoSeriesFoundError
+	}
+
+	return nil
+}
+
+func seriesEntries(posts []db.SeriesPost) []response.SeriesEntry {
+	entries := make([]response.SeriesEntry, 0, len(posts))
+	for _, post := range posts {
+		entries = append(entries, response.SeriesEntry{
+			PostID:   post.PostID,
+			Position: post.Position,
+			Title:    post.Title,
+			URL:      post.URL,
+		})
+	}
+	return entries
+}
+
+func isPermutation(posts []db.SeriesPost, postIDs []uuid.UUID) bool {
+	if len(posts) != len(postIDs) {
+		return false
+	}
+
+	remaining := map[uuid.UUID]bool{}
+	for _, post := range posts {
+		remaining[post.PostID] = true
+	}
+	for _, postID := range postIDs {
+		if !remaining[postID] {
+			return false
+		}
+		delete(remaining, postID)
+	}
+
+	return true
+}
+
+func NewSeriesService(seriesRepository repository.SeriesRepository, manager helper.TransactionManager) SeriesService {
+	return seriesService{
+		repository:         seriesRepository,
+		transactionManager: manager,
+	}
+}
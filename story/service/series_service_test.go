@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/story/constants"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"testing"
+)
+
+type SeriesServiceTest struct {
+	suite.Suite
+	mockController         *gomock.Controller
+	goContext              context.Context
+	mockSeriesRepository   *mocks.MockSeriesRepository
+	mockTransaction        *mocks.MockTransaction
+	mockTransactionManager *mocks.MockTransactionManager
+	seriesService          SeriesService
+}
+
+func TestSeriesServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SeriesServiceTest))
+}
+
+func (suite *SeriesServiceTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockSeriesRepository = mocks.NewMockSeriesRepository(suite.mockController)
+	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
+	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
+	suite.seriesService = NewSeriesService(suite.mockSeriesRepository, suite.mockTransactionManager)
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *SeriesServiceTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *SeriesServiceTest) TestAddPost_WhenSuccess() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+	postID := uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetSeriesForPost(suite.goContext, postID).Return(db.Series{}, sql.ErrNoRows).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockSeriesRepository.EXPECT().Lock(suite.goContext, suite.mockTransaction, series.ID).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().AddPost(suite.goContext, suite.mockTransaction, series.ID, postID, series.AuthorID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.seriesService.AddPost(suite.goContext, series.ID, postID, series.AuthorID)
+
+	suite.Nil(err)
+}
+
+func (suite *SeriesServiceTest) TestAddPost_WhenUserIsNotTheAuthor() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+
+	err := suite.seriesService.AddPost(suite.goContext, series.ID, uuid.New(), uuid.New())
+
+	suite.Equal(&constants.NoSeriesFoundError, err)
+}
+
+func (suite *SeriesServiceTest) TestAddPost_WhenPostIsAlreadyInASeries() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+	postID := uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetSeriesForPost(suite.goContext, postID).Return(db.Series{ID: uuid.New()}, nil).Times(1)
+
+	err := suite.seriesService.AddPost(suite.goContext, series.ID, postID, series.AuthorID)
+
+	suite.Equal(&constants.PostAlreadyInSeriesError, err)
+}
+
+func (suite *SeriesServiceTest) TestAddPost_WhenPostIsNotOwnedByAuthor() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+	postID := uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetSeriesForPost(suite.goContext, postID).Return(db.Series{}, sql.ErrNoRows).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockSeriesRepository.EXPECT().Lock(suite.goContext, suite.mockTransaction, series.ID).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().AddPost(suite.goContext, suite.mockTransaction, series.ID, postID, series.AuthorID).Return(sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.seriesService.AddPost(suite.goContext, series.ID, postID, series.AuthorID)
+
+	suite.Equal(&constants.PostNotFoundErr, err)
+}
+
+func (suite *SeriesServiceTest) TestReorderPosts_WhenSuccess() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+	first, second := uuid.New(), uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockSeriesRepository.EXPECT().Lock(suite.goContext, suite.mockTransaction, series.ID).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetPositions(suite.goContext, suite.mockTransaction, series.ID).Return([]db.SeriesPost{
+		{PostID: first, Position: 1, State: db.PostPublished},
+		{PostID: second, Position: 2, State: db.PostPublished},
+	}, nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().UpdatePosition(suite.goContext, suite.mockTransaction, series.ID, second, 1).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().UpdatePosition(suite.goContext, suite.mockTransaction, series.ID, first, 2).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.seriesService.ReorderPosts(suite.goContext, series.ID, series.AuthorID, []uuid.UUID{second, first})
+
+	suite.Nil(err)
+}
+
+func (suite *SeriesServiceTest) TestReorderPosts_WhenSeriesHasUnpublishedPosts() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+	first, archived, third := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockSeriesRepository.EXPECT().Lock(suite.goContext, suite.mockTransaction, series.ID).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetPositions(suite.goContext, suite.mockTransaction, series.ID).Return([]db.SeriesPost{
+		{PostID: first, Position: 1, State: db.PostPublished},
+		{PostID: archived, Position: 2, State: db.PostArchived},
+		{PostID: third, Position: 3, State: db.PostPublished},
+	}, nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().UpdatePosition(suite.goContext, suite.mockTransaction, series.ID, third, 1).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().UpdatePosition(suite.goContext, suite.mockTransaction, series.ID, first, 3).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().UpdatePosition(suite.goContext, suite.mockTransaction, series.ID, archived, gomock.Any()).Times(0)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	err := suite.seriesService.ReorderPosts(suite.goContext, series.ID, series.AuthorID, []uuid.UUID{third, first})
+
+	suite.Nil(err)
+}
+
+func (suite *SeriesServiceTest) TestReorderPosts_WhenOrderDropsAPost() {
+	series := db.Series{ID: uuid.New(), AuthorID: uuid.New()}
+	first, second := uuid.New(), uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeries(suite.goContext, series.ID).Return(series, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockSeriesRepository.EXPECT().Lock(suite.goContext, suite.mockTransaction, series.ID).Return(nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetPositions(suite.goContext, suite.mockTransaction, series.ID).Return([]db.SeriesPost{
+		{PostID: first, Position: 1, State: db.PostPublished},
+		{PostID: second, Position: 2, State: db.PostPublished},
+	}, nil).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+
+	err := suite.seriesService.ReorderPosts(suite.goContext, series.ID, series.AuthorID, []uuid.UUID{second, second})
+
+	suite.Equal(&constants.InvalidSeriesOrderError, err)
+}
+
+func (suite *SeriesServiceTest) TestGetNavigation_WhenPostIsInTheMiddle() {
+	series := db.Series{ID: uuid.New(), Title: "Go tutorial"}
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeriesForPost(suite.goContext, second).Return(series, nil).Times(1)
+	suite.mockSeriesRepository.EXPECT().GetSeriesPosts(suite.goContext, series.ID).Return([]db.SeriesPost{
+		{PostID: first, Position: 1, Title: "Part 1"},
+		{PostID: second, Position: 2, Title: "Part 2"},
+		{PostID: third, Position: 3, Title: "Part 3"},
+	}, nil).Times(1)
+
+	navigation, err := suite.seriesService.GetNavigation(suite.goContext, second)
+
+	suite.Nil(err)
+	suite.Equal("Go tutorial", navigation.Title)
+	suite.Len(navigation.Contents, 3)
+	suite.Equal(first, navigation.Previous.PostID)
+	suite.Equal(third, navigation.Next.PostID)
+}
+
+func (suite *SeriesServiceTest) TestGetNavigation_WhenPostIsNotInASeries() {
+	postID := uuid.New()
+
+	suite.mockSeriesRepository.EXPECT().GetSeriesForPost(suite.goContext, postID).Return(db.Series{}, sql.ErrNoRows).Times(1)
+
+	navigation, err := suite.seriesService.GetNavigation(suite.goContext, postID)
+
+	suite.Nil(err)
+	suite.Nil(navigation)
+}
@@ -0,0 +1,135 @@
+package service
+
+//go:generate mockgen -source=trash_purger.go -destination=./../mocks/mock_trash_purger.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"post-api/configuration"
+	"post-api/helper"
+	"post-api/service"
+	"post-api/story/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inclusi-blog/gola-utils/logging"
+)
+
+const (
+	defaultPurgeInterval  = time.Hour
+	defaultPurgeBatchSize = 20
+	defaultPurgeAttempts  = 5
+)
+
+type TrashPurger interface {
+	Start(ctx context.Context)
+	PurgeExpired(ctx context.Context)
+}
+
+// trashPurger permanently removes drafts that stayed in the trash past their retention, along with
+// their revisions, images and the S3 objects no other draft refers to. A draft that fails to purge is
+// retried on later runs until it has failed maxAttempts times, after which it is left for an operator.
+type trashPurger struct {
+	draftRepository    repository.DraftRepository
+	awsServices        service.AwsServices
+	transactionManager helper.TransactionManager
+	retention          time.Duration
+	interval           time.Duration
+	batchSize          int
+	maxAttempts        int
+}
+
+func (purger trashPurger) Start(ctx context.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "TrashPurger").WithField("method", "Start")
+	logger.Infof("Starting trash purger with interval %v", purger.interval)
+
+	ticker := time.NewTicker(purger.interval)
+	defer ticker.Stop()
+
+	for {
+		purger.PurgeExpired(ctx)
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping trash purger")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (purger trashPurger) PurgeExpired(ctx context.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "TrashPurger").WithField("method", "PurgeExpired")
+
+	drafts, err := purger.draftRepository.GetExpiredTrashedDrafts(ctx, purger.retention, purger.maxAttempts, purger.batchSize)
+	if err != nil {
+		logger.Errorf("unable to fetch expired trashed drafts %v", err)
+		return
+	}
+
+	for _, draft := range drafts {
+		keys, err := purger.draftRepository.GetUnsharedImageKeys(ctx, draft.DraftID)
+		if err != nil {
+			logger.Errorf("unable to fetch image keys of draft %v. Error %v", draft.DraftID, err)
+			purger.recordFailure(ctx, draft.DraftID, err)
+			continue
+		}
+		if draft.PreviewImage != nil && *draft.PreviewImage != "" {
+			keys = append(keys, *draft.PreviewImage)
+		}
+
+		txn := purger.transactionManager.NewTransaction()
+		if err = purger.draftRepository.PurgeDraft(ctx, txn, draft.DraftID); err != nil {
+			_ = txn.Rollback()
+			logger.Errorf("unable to purge draft %v. Error %v", draft.DraftID, err)
+			// a draft restored since it was fetched is not in the trash anymore
+			if err != sql.ErrNoRows {
+				purger.recordFailure(ctx, draft.DraftID, err)
+			}
+			continue
+		}
+		if err = txn.Commit(); err != nil {
+			logger.Errorf("unable to commit purge of draft %v. Error %v", draft.DraftID, err)
+			purger.recordFailure(ctx, draft.DraftID, err)
+			continue
+		}
+
+		// objects are removed once the rows are gone, so a failed purge never leaves a draft without its images
+		for _, key := range keys {
+			if err = purger.awsServices.DeleteObjectInS3(key); err != nil {
+				logger.Errorf("unable to delete object %v of purged draft %v. Error %v", key, draft.DraftID, err)
+			}
+		}
+
+		logger.Infof("Successfully purged draft %v", draft.DraftID)
+	}
+}
+
+func (purger trashPurger) recordFailure(ctx context.Context, draftID uuid.UUID, purgeErr error) {
+	logger := logging.GetLogger(ctx).WithField("class", "TrashPurger").WithField("method", "recordFailure")
+
+	if err := purger.draftRepository.RecordPurgeFailure(ctx, draftID, purgeErr.Error()); err != nil {
+		logger.Errorf("unable to record purge failure of draft %v. Error %v", draftID, err)
+	}
+}
+
+func NewTrashPurger(draftRepository repository.DraftRepository, awsServices service.AwsServices, manager helper.TransactionManager, config configuration.DraftTrash) TrashPurger {
+	purger := trashPurger{
+		draftRepository:    draftRepository,
+		awsServices:        awsServices,
+		transactionManager: manager,
+		retention:          trashRetention(config.RetentionInDays),
+		interval:           time.Duration(config.IntervalInSeconds) * time.Second,
+		batchSize:          config.BatchSize,
+		maxAttempts:        config.MaxPurgeAttempts,
+	}
+	if purger.interval <= 0 {
+		purger.interval = defaultPurgeInterval
+	}
+	if purger.batchSize <= 0 {
+		purger.batchSize = defaultPurgeBatchSize
+	}
+	if purger.maxAttempts <= 0 {
+		purger.maxAttempts = defaultPurgeAttempts
+	}
+	return purger
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"post-api/configuration"
+	"post-api/story/mocks"
+	"post-api/story/models/db"
+	"testing"
+	"time"
+)
+
+type TrashPurgerTest struct {
+	suite.Suite
+	mockController         *gomock.Controller
+	goContext              context.Context
+	mockDraftRepository    *mocks.MockDraftRepository
+	mockAwsServices        *mocks.MockAwsServices
+	mockTransaction        *mocks.MockTransaction
+	mockTransactionManager *mocks.MockTransactionManager
+	trashPurger            TrashPurger
+}
+
+func TestTrashPurgerTestSuite(t *testing.T) {
+	suite.Run(t, new(TrashPurgerTest))
+}
+
+func (suite *TrashPurgerTest) SetupTest() {
+	suite.mockController = gomock.NewController(suite.T())
+	suite.mockDraftRepository = mocks.NewMockDraftRepository(suite.mockController)
+	suite.mockAwsServices = mocks.NewMockAwsServices(suite.mockController)
+	suite.mockTransaction = mocks.NewMockTransaction(suite.mockController)
+	suite.mockTransactionManager = mocks.NewMockTransactionManager(suite.mockController)
+	suite.trashPurger = NewTrashPurger(suite.mockDraftRepository, suite.mockAwsServices, suite.mockTransactionManager, configuration.DraftTrash{RetentionInDays: 7, BatchSize: 10, MaxPurgeAttempts: 3})
+	suite.goContext = context.WithValue(context.Background(), "someKey", "someValue")
+}
+
+func (suite *TrashPurgerTest) TearDownTest() {
+	suite.mockController.Finish()
+}
+
+func (suite *TrashPurgerTest) TestPurgeExpired_WhenDraftIsPurgedRemovesItsObjects() {
+	previewImage := "draft/some-draft/preview.png"
+	draft := db.TrashedDraft{DraftID: uuid.New(), PreviewImage: &previewImage}
+
+	suite.mockDraftRepository.EXPECT().GetExpiredTrashedDrafts(suite.goContext, 7*24*time.Hour, 3, 10).Return([]db.TrashedDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetUnsharedImageKeys(suite.goContext, draft.DraftID).Return([]string{"draft/some-draft/image.png"}, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(1)
+	suite.mockDraftRepository.EXPECT().PurgeDraft(suite.goContext, suite.mockTransaction, draft.DraftID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+	suite.mockAwsServices.EXPECT().DeleteObjectInS3("draft/some-draft/image.png").Return(nil).Times(1)
+	suite.mockAwsServices.EXPECT().DeleteObjectInS3(previewImage).Return(nil).Times(1)
+
+	suite.trashPurger.PurgeExpired(suite.goContext)
+}
+
+func (suite *TrashPurgerTest) TestPurgeExpired_WhenDraftWasRestoredKeepsItsObjects() {
+	draft := db.TrashedDraft{DraftID: uuid.New()}
+	other := db.TrashedDraft{DraftID: uuid.New()}
+
+	suite.mockDraftRepository.EXPECT().GetExpiredTrashedDrafts(suite.goContext, 7*24*time.Hour, 3, 10).Return([]db.TrashedDraft{draft, other}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetUnsharedImageKeys(suite.goContext, draft.DraftID).Return([]string{"draft/some-draft/image.png"}, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(2)
+	suite.mockDraftRepository.EXPECT().PurgeDraft(suite.goContext, suite.mockTransaction, draft.DraftID).Return(sql.ErrNoRows).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetUnsharedImageKeys(suite.goContext, other.DraftID).Return(nil, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().PurgeDraft(suite.goContext, suite.mockTransaction, other.DraftID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	suite.trashPurger.PurgeExpired(suite.goContext)
+}
+
+func (suite *TrashPurgerTest) TestPurgeExpired_WhenPurgeFailsRecordsTheFailureAndMovesOn() {
+	draft := db.TrashedDraft{DraftID: uuid.New()}
+	other := db.TrashedDraft{DraftID: uuid.New()}
+
+	suite.mockDraftRepository.EXPECT().GetExpiredTrashedDrafts(suite.goContext, 7*24*time.Hour, 3, 10).Return([]db.TrashedDraft{draft, other}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetUnsharedImageKeys(suite.goContext, draft.DraftID).Return(nil, nil).Times(1)
+	suite.mockTransactionManager.EXPECT().NewTransaction().Return(suite.mockTransaction).Times(2)
+	suite.mockDraftRepository.EXPECT().PurgeDraft(suite.goContext, suite.mockTransaction, draft.DraftID).Return(errors.New("violates foreign key constraint")).Times(1)
+	suite.mockTransaction.EXPECT().Rollback().Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().RecordPurgeFailure(suite.goContext, draft.DraftID, "violates foreign key constraint").Return(nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetUnsharedImageKeys(suite.goContext, other.DraftID).Return(nil, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().PurgeDraft(suite.goContext, suite.mockTransaction, other.DraftID).Return(nil).Times(1)
+	suite.mockTransaction.EXPECT().Commit().Return(nil).Times(1)
+
+	suite.trashPurger.PurgeExpired(suite.goContext)
+}
+
+func (suite *TrashPurgerTest) TestPurgeExpired_WhenFetchingImageKeysFailsRecordsTheFailure() {
+	draft := db.TrashedDraft{DraftID: uuid.New()}
+
+	suite.mockDraftRepository.EXPECT().GetExpiredTrashedDrafts(suite.goContext, 7*24*time.Hour, 3, 10).Return([]db.TrashedDraft{draft}, nil).Times(1)
+	suite.mockDraftRepository.EXPECT().GetUnsharedImageKeys(suite.goContext, draft.DraftID).Return(nil, errors.New("something went wrong")).Times(1)
+	suite.mockDraftRepository.EXPECT().RecordPurgeFailure(suite.goContext, draft.DraftID, "something went wrong").Return(nil).Times(1)
+
+	suite.trashPurger.PurgeExpired(suite.goContext)
+}
+
+func (suite *TrashPurgerTest) TestPurgeExpired_WhenFetchingDraftsFails() {
+	suite.mockDraftRepository.EXPECT().GetExpiredTrashedDrafts(suite.goContext, 7*24*time.Hour, 3, 10).Return(nil, errors.New("something went wrong")).Times(1)
+
+	suite.trashPurger.PurgeExpired(suite.goContext)
+}
@@ -0,0 +1,319 @@
+package utils
+
+//go:generate mockgen -source=content_filter.go -destination=./../mocks/mock_content_filter.go -package=mocks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/models"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	FilterAllow  = ""
+	FilterMask   = "mask"
+	FilterHold   = "hold"
+	FilterReject = "reject"
+)
+
+var filterActionSeverity = map[string]int{FilterAllow: 0, FilterMask: 1, FilterHold: 2, FilterReject: 3}
+
+// urlFields are the block data keys that hold a link, such as file.url of images and link of link tools.
+var urlFields = map[string]bool{"url": true, "link": true}
+
+var linkHostPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)([^\s/?#"'<>]+)`)
+
+// FilterResult is the most severe action of the rules that matched, along with the names of those rules.
+type FilterResult struct {
+	Action string
+	Rules  []string
+}
+
+type ContentFilter interface {
+	FilterText(ctx context.Context, text string) (string, FilterResult)
+	FilterEditor(ctx context.Context, content models.JSONString) (models.JSONString, FilterResult, *golaerror.Error)
+}
+
+type filterRule struct {
+	name     string
+	action   string
+	words    [][]string
+	patterns []*regexp.Regexp
+	domains  []string
+}
+
+type textSpan struct {
+	start int
+	end   int
+}
+
+type wordToken struct {
+	text string
+	span textSpan
+}
+
+type contentFilter struct {
+	rules []filterRule
+}
+
+// FilterText matches the text against every rule. Spans matched by rules that mask are replaced with
+// asterisks, while the text is returned unchanged for rules that reject or hold it.
+func (filter contentFilter) FilterText(ctx context.Context, text string) (string, FilterResult) {
+	text, result := filter.apply(text)
+	if result.Action != FilterAllow {
+		logging.GetLogger(ctx).WithField("class", "ContentFilter").WithField("method", "FilterText").Infof("text matched rules %v", result.Rules)
+	}
+	return text, result
+}
+
+func (filter contentFilter) apply(text string) (string, FilterResult) {
+	var result FilterResult
+	for _, rule := range filter.rules {
+		spans := rule.match(text)
+		if len(spans) == 0 {
+			continue
+		}
+		result.Rules = append(result.Rules, rule.name)
+		if filterActionSeverity[rule.action] > filterActionSeverity[result.Action] {
+			result.Action = rule.action
+		}
+		if rule.action == FilterMask {
+			text = maskSpans(text, spans)
+		}
+	}
+	return text, result
+}
+
+// FilterEditor filters the text of every block of the editor content. Every field counts towards the
+// result, links included, but only the text between tags is masked and fields holding a URL are never
+// changed, so masking cannot break markup or links.
+func (filter contentFilter) FilterEditor(ctx context.Context, content models.JSONString) (models.JSONString, FilterResult, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentFilter").WithField("method", "FilterEditor")
+	if len(filter.rules) == 0 {
+		return content, FilterResult{}, nil
+	}
+
+	var editor models.Editor
+	if err := content.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse editor content %v", err)
+		return content, FilterResult{}, &constants.DraftValidationFailedError
+	}
+
+	var result FilterResult
+	for _, block := range editor.Blocks {
+		for key, value := range block.Data {
+			block.Data[key] = filter.filterValue(key, value, &result)
+		}
+	}
+	if result.Action != FilterAllow {
+		logger.Infof("editor content matched rules %v", result.Rules)
+	}
+
+	if result.Action != FilterMask {
+		return content, result, nil
+	}
+
+	data, err := json.Marshal(editor)
+	if err != nil {
+		logger.Errorf("unable to marshal filtered content %v", err)
+		return content, result, constants.StoryInternalServerError(err.Error())
+	}
+	return models.JSONString{JSONText: data}, result, nil
+}
+
+func (filter contentFilter) filterValue(key string, value interface{}, result *FilterResult) interface{} {
+	switch value := value.(type) {
+	case string:
+		_, textResult := filter.apply(value)
+		result.merge(textResult)
+		if textResult.Action != FilterMask || urlFields[key] {
+			return value
+		}
+		return models.MapText(value, func(text string) string {
+			masked, _ := filter.apply(text)
+			return masked
+		})
+	case map[string]interface{}:
+		for nestedKey, nested := range value {
+			value[nestedKey] = filter.filterValue(nestedKey, nested, result)
+		}
+	case []interface{}:
+		for i, nested := range value {
+			value[i] = filter.filterValue(key, nested, result)
+		}
+	}
+	return value
+}
+
+// MergeFilterResults combines the results of filtering the parts of the same content.
+func MergeFilterResults(results ...FilterResult) FilterResult {
+	var merged FilterResult
+	for _, result := range results {
+		merged.merge(result)
+	}
+	return merged
+}
+
+func (result *FilterResult) merge(other FilterResult) {
+	if filterActionSeverity[other.Action] > filterActionSeverity[result.Action] {
+		result.Action = other.Action
+	}
+	for _, rule := range other.Rules {
+		if !containsString(result.Rules, rule) {
+			result.Rules = append(result.Rules, rule)
+		}
+	}
+}
+
+func (rule filterRule) match(text string) []textSpan {
+	var spans []textSpan
+	if len(rule.words) > 0 {
+		tokens := wordTokens(text)
+		for _, phrase := range rule.words {
+			for i := 0; i+len(phrase) <= len(tokens); i++ {
+				if tokensMatch(tokens[i:i+len(phrase)], phrase) {
+					spans = append(spans, textSpan{start: tokens[i].span.start, end: tokens[i+len(phrase)-1].span.end})
+				}
+			}
+		}
+	}
+
+	for _, pattern := range rule.patterns {
+		for _, match := range pattern.FindAllStringIndex(text, -1) {
+			spans = append(spans, textSpan{start: match[0], end: match[1]})
+		}
+	}
+
+	if len(rule.domains) > 0 {
+		for _, match := range linkHostPattern.FindAllStringSubmatchIndex(text, -1) {
+			if rule.blocksHost(text[match[2]:match[3]]) {
+				spans = append(spans, textSpan{start: match[2], end: match[3]})
+			}
+		}
+	}
+	return spans
+}
+
+// blocksHost matches the domains and their subdomains, ignoring any credentials or port in the host.
+func (rule filterRule) blocksHost(host string) bool {
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		host = host[at+1:]
+	}
+	if colon := strings.Index(host, ":"); colon >= 0 {
+		host = host[:colon]
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	host = strings.TrimPrefix(host, "www.")
+	for _, domain := range rule.domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// wordTokens splits the text into runs of letters, marks and digits, so words of scripts like Tamil,
+// whose vowel signs are marks, are kept whole.
+func wordTokens(text string) []wordToken {
+	var tokens []wordToken
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r)
+		if isWord && start < 0 {
+			start = i
+		}
+		if !isWord && start >= 0 {
+			tokens = append(tokens, wordToken{text: strings.ToLower(text[start:i]), span: textSpan{start: start, end: i}})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, wordToken{text: strings.ToLower(text[start:]), span: textSpan{start: start, end: len(text)}})
+	}
+	return tokens
+}
+
+func tokensMatch(tokens []wordToken, phrase []string) bool {
+	for i, word := range phrase {
+		if tokens[i].text != word {
+			return false
+		}
+	}
+	return true
+}
+
+// maskSpans replaces every rune of the spans other than whitespace with an asterisk. Overlapping spans
+// are masked once.
+func maskSpans(text string, spans []textSpan) string {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var builder strings.Builder
+	position := 0
+	for _, span := range spans {
+		if span.end <= position {
+			continue
+		}
+		if span.start < position {
+			span.start = position
+		}
+		builder.WriteString(text[position:span.start])
+		for _, r := range text[span.start:span.end] {
+			if unicode.IsSpace(r) {
+				builder.WriteRune(r)
+				continue
+			}
+			builder.WriteRune('*')
+		}
+		position = span.end
+	}
+	builder.WriteString(text[position:])
+	return builder.String()
+}
+
+func containsString(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
+
+func NewContentFilter(rules []configuration.ContentFilterRule) (ContentFilter, error) {
+	var filter contentFilter
+	for _, configRule := range rules {
+		if _, ok := filterActionSeverity[configRule.Action]; !ok || configRule.Action == FilterAllow {
+			return nil, fmt.Errorf("content filter rule %v has unknown action %q", configRule.Name, configRule.Action)
+		}
+
+		rule := filterRule{name: configRule.Name, action: configRule.Action}
+		for _, word := range configRule.Words {
+			var phrase []string
+			for _, token := range wordTokens(word) {
+				phrase = append(phrase, token.text)
+			}
+			if len(phrase) > 0 {
+				rule.words = append(rule.words, phrase)
+			}
+		}
+		for _, pattern := range configRule.Patterns {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("content filter rule %v has invalid pattern %q: %v", configRule.Name, pattern, err)
+			}
+			rule.patterns = append(rule.patterns, compiled)
+		}
+		for _, domain := range configRule.Domains {
+			rule.domains = append(rule.domains, strings.TrimPrefix(strings.ToLower(domain), "www."))
+		}
+		filter.rules = append(filter.rules, rule)
+	}
+	return filter, nil
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/stretchr/testify/assert"
+	"post-api/configuration"
+	"post-api/story/models"
+	"testing"
+)
+
+func testContentFilter(t *testing.T) ContentFilter {
+	filter, err := NewContentFilter([]configuration.ContentFilterRule{
+		{Name: "profanity", Action: FilterMask, Words: []string{"darn", "முட்டாள்", "go away"}},
+		{Name: "phone_numbers", Action: FilterHold, Patterns: []string{`\b\d{5}[\s-]?\d{5}\b`}},
+		{Name: "spam_links", Action: FilterReject, Domains: []string{"spam.example"}},
+	})
+	assert.NoError(t, err)
+	return filter
+}
+
+func TestContentFilterMasksWordsOfAnyScript(t *testing.T) {
+	text, result := testContentFilter(t).FilterText(context.Background(), "Darn it, நீ ஒரு முட்டாள். Go  away! darning is fine")
+
+	assert.Equal(t, "**** it, நீ ஒரு ********. **  ****! darning is fine", text)
+	assert.Equal(t, FilterResult{Action: FilterMask, Rules: []string{"profanity"}}, result)
+}
+
+func TestContentFilterTakesTheMostSevereAction(t *testing.T) {
+	text := "darn, call me on 98765 43210 or see https://offers.spam.example:8080/win"
+	filtered, result := testContentFilter(t).FilterText(context.Background(), text)
+
+	assert.Equal(t, FilterReject, result.Action)
+	assert.Equal(t, []string{"profanity", "phone_numbers", "spam_links"}, result.Rules)
+	assert.Equal(t, "****"+text[4:], filtered)
+}
+
+func TestContentFilterMatchesOnlyBlockedDomains(t *testing.T) {
+	_, result := testContentFilter(t).FilterText(context.Background(), "see https://notspam.example and www.example.com")
+
+	assert.Equal(t, FilterResult{}, result)
+}
+
+func TestContentFilterFiltersEveryBlockOfEditor(t *testing.T) {
+	editor := models.Editor{Blocks: []models.Block{
+		{Type: models.Paragraph, Data: map[string]interface{}{"text": "what a <b>darn</b> day"}},
+		{Type: models.List, Data: map[string]interface{}{"style": "unordered", "items": []interface{}{"fine", "go away"}}},
+	}}
+	data, _ := json.Marshal(editor)
+
+	filtered, result, err := testContentFilter(t).FilterEditor(context.Background(), models.JSONString{JSONText: types.JSONText(data)})
+
+	assert.Nil(t, err)
+	assert.Equal(t, FilterResult{Action: FilterMask, Rules: []string{"profanity"}}, result)
+	var filteredEditor models.Editor
+	assert.NoError(t, json.Unmarshal(filtered.JSONText, &filteredEditor))
+	assert.Equal(t, "what a <b>****</b> day", filteredEditor.Blocks[0].Data["text"])
+	assert.Equal(t, []interface{}{"fine", "** ****"}, filteredEditor.Blocks[1].Data["items"])
+}
+
+func TestContentFilterMasksOnlyTextOfEditor(t *testing.T) {
+	content := models.JSONString{JSONText: types.JSONText(`{"blocks":[` +
+		`{"type":"paragraph","data":{"text":"a <a href=\"https://darn.example/darn\">darn &amp; co</a>"}},` +
+		`{"type":"image","data":{"file":{"url":"https://cdn.example/darn.png"},"caption":"darn"}},` +
+		`{"type":"linkTool","data":{"link":"https://darn.example","meta":{"title":"darn"}}}]}`)}
+
+	filtered, result, err := testContentFilter(t).FilterEditor(context.Background(), content)
+
+	assert.Nil(t, err)
+	assert.Equal(t, FilterResult{Action: FilterMask, Rules: []string{"profanity"}}, result)
+	var filteredEditor models.Editor
+	assert.NoError(t, json.Unmarshal(filtered.JSONText, &filteredEditor))
+	assert.Equal(t, "a <a href=\"https://darn.example/darn\">**** &amp; co</a>", filteredEditor.Blocks[0].Data["text"])
+	assert.Equal(t, map[string]interface{}{"url": "https://cdn.example/darn.png"}, filteredEditor.Blocks[1].Data["file"])
+	assert.Equal(t, "****", filteredEditor.Blocks[1].Data["caption"])
+	assert.Equal(t, "https://darn.example", filteredEditor.Blocks[2].Data["link"])
+	assert.Equal(t, map[string]interface{}{"title": "****"}, filteredEditor.Blocks[2].Data["meta"])
+}
+
+func TestContentFilterKeepsEditorWhenHeld(t *testing.T) {
+	content := models.JSONString{JSONText: types.JSONText(`{"blocks":[{"type":"paragraph","data":{"text":"darn, call 98765-43210"}}]}`)}
+
+	filtered, result, err := testContentFilter(t).FilterEditor(context.Background(), content)
+
+	assert.Nil(t, err)
+	assert.Equal(t, FilterHold, result.Action)
+	assert.Equal(t, content, filtered)
+}
+
+func TestNewContentFilterRejectsInvalidRules(t *testing.T) {
+	_, err := NewContentFilter([]configuration.ContentFilterRule{{Name: "broken", Action: FilterMask, Patterns: []string{"("}}})
+	assert.Error(t, err)
+
+	_, err = NewContentFilter([]configuration.ContentFilterRule{{Name: "unknown", Action: "delete"}})
+	assert.Error(t, err)
+}
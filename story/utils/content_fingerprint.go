@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"post-api/story/models"
+	"strings"
+	"unicode"
+)
+
+const shingleSize = 3
+
+// ContentFingerprint returns the simhash of the text of the post along with its number of words. The
+// text is normalized to lower case words, so markup, punctuation and spacing changes do not affect the
+// fingerprint, and posts that share most of their word sequences get fingerprints that differ in few bits.
+func ContentFingerprint(editor models.Editor) (uint64, int) {
+	var words []string
+	for _, block := range editor.Blocks {
+		words = append(words, strings.FieldsFunc(strings.ToLower(block.PlainText()), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+		})...)
+	}
+	if len(words) == 0 {
+		return 0, 0
+	}
+
+	var weights [64]int
+	for start := 0; start == 0 || start+shingleSize <= len(words); start++ {
+		end := start + shingleSize
+		if end > len(words) {
+			end = len(words)
+		}
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(strings.Join(words[start:end], " ")))
+		sum := hash.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+	return fingerprint, len(words)
+}
+
+// FingerprintSimilarity is the share of bits two fingerprints have in common, from 0 to 1.
+func FingerprintSimilarity(first, second uint64) float64 {
+	return 1 - float64(bits.OnesCount64(first^second))/64
+}
+
+// FingerprintDistance is the number of bits two fingerprints can differ in and still be at least as
+// similar as the given similarity.
+func FingerprintDistance(similarity float64) int {
+	return int((1 - similarity) * 64)
+}
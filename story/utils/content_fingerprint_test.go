@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"post-api/story/models"
+	"strings"
+	"testing"
+)
+
+const fingerprintText = "Kubernetes lets you run containers across a cluster of machines. Helm packages the manifests of an application into a chart, so the application can be installed, upgraded and rolled back with a single command. Charts are versioned and can be shared through repositories, and values files let each environment override the defaults without copying the templates."
+
+func fingerprintEditor(paragraphs ...string) models.Editor {
+	var editor models.Editor
+	for _, paragraph := range paragraphs {
+		editor.Blocks = append(editor.Blocks, models.Block{Type: models.Paragraph, Data: map[string]interface{}{"text": paragraph}})
+	}
+	return editor
+}
+
+func TestContentFingerprintIgnoresMarkupAndFormatting(t *testing.T) {
+	original, words := ContentFingerprint(fingerprintEditor(fingerprintText))
+	split := strings.Index(fingerprintText, " a cluster")
+	reformatted, _ := ContentFingerprint(fingerprintEditor("<b>"+strings.ToUpper(fingerprintText[:split])+"</b>", "  "+fingerprintText[split:]+"!!"))
+
+	assert.Equal(t, len(strings.Fields(fingerprintText)), words)
+	assert.Equal(t, original, reformatted)
+}
+
+func TestContentFingerprintOfNearDuplicatesIsSimilar(t *testing.T) {
+	original, _ := ContentFingerprint(fingerprintEditor(fingerprintText))
+	edited, _ := ContentFingerprint(fingerprintEditor(strings.Replace(fingerprintText, "single command", "one command", 1)))
+	unrelated, _ := ContentFingerprint(fingerprintEditor("Tamil Nadu recorded heavy rainfall this week, and the weather department expects the northeast monsoon to bring more showers to the coastal districts over the coming days, with schools in several districts closed as a precaution."))
+
+	assert.GreaterOrEqual(t, FingerprintSimilarity(original, edited), 0.85)
+	assert.Less(t, FingerprintSimilarity(original, unrelated), 0.85)
+}
+
+func TestFingerprintDistance(t *testing.T) {
+	assert.Equal(t, 6, FingerprintDistance(0.9))
+	assert.Equal(t, 0, FingerprintDistance(1))
+}
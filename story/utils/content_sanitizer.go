@@ -0,0 +1,57 @@
+package utils
+
+//go:generate mockgen -source=content_sanitizer.go -destination=./../mocks/mock_content_sanitizer.go -package=mocks
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/inclusi-blog/gola-utils/golaerror"
+	"github.com/inclusi-blog/gola-utils/logging"
+	"post-api/configuration"
+	"post-api/story/constants"
+	"post-api/story/models"
+)
+
+type ContentSanitizer interface {
+	Sanitize(ctx context.Context, content models.JSONString) (models.JSONString, []models.SanitizeReport, *golaerror.Error)
+}
+
+type contentSanitizer struct {
+	policies models.SanitizePolicies
+}
+
+// Sanitize strips the markup that the policy of each block type does not allow. Content that loses
+// nothing is returned as it was sent.
+func (sanitizer contentSanitizer) Sanitize(ctx context.Context, content models.JSONString) (models.JSONString, []models.SanitizeReport, *golaerror.Error) {
+	logger := logging.GetLogger(ctx).WithField("class", "ContentSanitizer").WithField("method", "Sanitize")
+
+	var editor models.Editor
+	if err := content.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse editor content %v", err)
+		return content, nil, &constants.DraftValidationFailedError
+	}
+
+	reports := editor.Sanitize(sanitizer.policies)
+	if len(reports) == 0 {
+		return content, nil, nil
+	}
+
+	data, err := json.Marshal(editor)
+	if err != nil {
+		logger.Errorf("unable to marshal sanitized content %v", err)
+		return content, nil, constants.StoryInternalServerError(err.Error())
+	}
+
+	logger.Infof("stripped disallowed markup from %v blocks", len(reports))
+	return models.JSONString{JSONText: data}, reports, nil
+}
+
+func NewContentSanitizer(configData *configuration.ConfigData) ContentSanitizer {
+	policies := models.DefaultSanitizePolicies()
+	for blockType, policy := range configData.HTMLSanitizer {
+		policies[models.ElementType(blockType)] = policy
+	}
+	return contentSanitizer{
+		policies: policies,
+	}
+}
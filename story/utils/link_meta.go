@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"golang.org/x/net/html"
+	"io"
+	"net/url"
+	"post-api/story/models"
+	"strings"
+)
+
+const (
+	maxLinkTitleLength       = 300
+	maxLinkDescriptionLength = 1000
+)
+
+// ParseLinkMeta reads the title, description and image of a page from its Open Graph tags, then its
+// Twitter Card tags, then its title and description. Only the head of the page is read. Relative image
+// urls are resolved against the url of the page.
+func ParseLinkMeta(page io.Reader, pageURL *url.URL) models.LinkMeta {
+	tags := map[string]string{}
+	var title strings.Builder
+	inTitle := false
+
+	tokenizer := html.NewTokenizer(page)
+	for done := false; !done; {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			done = true
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				inTitle = true
+			case "meta":
+				name, content := metaTag(token)
+				if _, seen := tags[name]; name != "" && !seen {
+					tags[name] = content
+				}
+			case "body":
+				done = true
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				done = true
+			}
+		case html.TextToken:
+			if inTitle {
+				title.Write(tokenizer.Text())
+			}
+		}
+	}
+
+	var meta models.LinkMeta
+	meta.Title = truncateText(firstNonEmpty(tags["og:title"], tags["twitter:title"], title.String()), maxLinkTitleLength)
+	meta.Description = truncateText(firstNonEmpty(tags["og:description"], tags["twitter:description"], tags["description"]), maxLinkDescriptionLength)
+	meta.Image.URL = resolveImageURL(firstNonEmpty(tags["og:image"], tags["og:image:url"], tags["twitter:image"], tags["twitter:image:src"]), pageURL)
+	return meta
+}
+
+// metaTag returns the lower cased property or name of a meta tag along with its content.
+func metaTag(token html.Token) (string, string) {
+	var name, content string
+	for _, attribute := range token.Attr {
+		switch attribute.Key {
+		case "property", "name":
+			if name == "" {
+				name = strings.ToLower(strings.TrimSpace(attribute.Val))
+			}
+		case "content":
+			content = attribute.Val
+		}
+	}
+	return name, content
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func truncateText(text string, length int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > length {
+		return string(runes[:length])
+	}
+	return text
+}
+
+func resolveImageURL(image string, pageURL *url.URL) string {
+	if image == "" {
+		return ""
+	}
+	imageURL, err := pageURL.Parse(image)
+	if err != nil || (imageURL.Scheme != "http" && imageURL.Scheme != "https") {
+		return ""
+	}
+	return imageURL.String()
+}
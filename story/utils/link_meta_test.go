@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseLinkMetaPrefersOpenGraphThenTwitterThenHTML(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/blog/post")
+	page := `<html><head>
+		<title>Page  title</title>
+		<meta name="description" content="html description">
+		<meta name="twitter:title" content="twitter title">
+		<meta name="twitter:description" content="twitter description">
+		<meta property="og:title" content="og title">
+		<meta name="twitter:image" content="/images/card.png">
+	</head><body><meta property="og:description" content="ignored in body"></body></html>`
+
+	meta := ParseLinkMeta(strings.NewReader(page), pageURL)
+
+	assert.Equal(t, "og title", meta.Title)
+	assert.Equal(t, "twitter description", meta.Description)
+	assert.Equal(t, "https://example.com/images/card.png", meta.Image.URL)
+}
+
+func TestParseLinkMetaFallsBackToTitleAndDropsUnsafeImages(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/")
+	page := `<title> Only
+	a title </title><meta property="og:image" content="javascript:alert(1)">`
+
+	meta := ParseLinkMeta(strings.NewReader(page), pageURL)
+
+	assert.Equal(t, "Only a title", meta.Title)
+	assert.Equal(t, "", meta.Description)
+	assert.Equal(t, "", meta.Image.URL)
+}
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"post-api/configuration"
+	"syscall"
+	"time"
+)
+
+const maxLinkPreviewRedirects = 5
+
+var (
+	ErrBlockedAddress   = errors.New("address is not publicly routable")
+	ErrTooManyRedirects = errors.New("too many redirects")
+	ErrUnsupportedLink  = errors.New("link must be an absolute http or https url")
+)
+
+// reservedNetworks are not covered by the net.IP classifiers but must not be reached from link previews
+// either: shared address space, protocol assignments, benchmarking, reserved and NAT64 ranges.
+var reservedNetworks = func() []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range []string{"0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15", "240.0.0.0/4", "64:ff9b::/96", "64:ff9b:1::/48"} {
+		_, network, _ := net.ParseCIDR(cidr)
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+// NewLinkPreviewClient returns a client for fetching pages of untrusted links. Every connection,
+// including the ones made for redirects, is checked after the host is resolved, so a host cannot point
+// the client to an internal address. Proxies from the environment are not used, as they would hide the
+// address being connected to.
+func NewLinkPreviewClient(config configuration.LinkPreview) *http.Client {
+	timeout := time.Duration(config.TimeoutInSeconds) * time.Second
+	dialer := &net.Dialer{Timeout: timeout}
+	if !config.AllowPrivateNetworks {
+		dialer.Control = blockNonPublicAddresses
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:            dialer.DialContext,
+			TLSHandshakeTimeout:    timeout,
+			ResponseHeaderTimeout:  timeout,
+			MaxResponseHeaderBytes: 64 << 10,
+			DisableKeepAlives:      true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxLinkPreviewRedirects {
+				return ErrTooManyRedirects
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrUnsupportedLink
+			}
+			return nil
+		},
+	}
+}
+
+func blockNonPublicAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return ErrBlockedAddress
+	}
+	return nil
+}
+
+// IsPublicIP reports whether the address is routable on the internet.
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"post-api/configuration"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for _, address := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1", "64:ff9b::a00:1"} {
+		assert.False(t, IsPublicIP(net.ParseIP(address)), address)
+	}
+	for _, address := range []string{"8.8.8.8", "93.184.216.34", "2606:4700:4700::1111"} {
+		assert.True(t, IsPublicIP(net.ParseIP(address)), address)
+	}
+}
+
+func TestLinkPreviewClientRefusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := NewLinkPreviewClient(configuration.LinkPreview{TimeoutInSeconds: 1}).Get(server.URL)
+	assert.True(t, errors.Is(err, ErrBlockedAddress), err)
+
+	resp, err := NewLinkPreviewClient(configuration.LinkPreview{TimeoutInSeconds: 1, AllowPrivateNetworks: true}).Get(server.URL)
+	assert.Nil(t, err)
+	_ = resp.Body.Close()
+}
+
+func TestLinkPreviewClientLimitsRedirects(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, server.URL+"/again", http.StatusFound)
+	}))
+	defer server.Close()
+
+	_, err := NewLinkPreviewClient(configuration.LinkPreview{TimeoutInSeconds: 1, AllowPrivateNetworks: true}).Get(server.URL)
+	assert.True(t, errors.Is(err, ErrTooManyRedirects), err)
+}
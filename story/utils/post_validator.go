@@ -32,23 +32,19 @@ func (validator postValidator) ValidateAndGetReadTime(draft db.Draft, ctx contex
 	id := draftID
 	logger.Infof("Validating draft to publish for draft id %v", id)
 
-	config := validator.configData.ContentReadTimeConfig
+	if blocksErr := ValidateBlocks(ctx, draft.Data); blocksErr != nil {
+		return models.MetaData{}, blocksErr
+	}
 
-	var postWordsCount int
-	var imageCount int
-	var readTime int
-	var extractedTagline string
-	var titleString string
-	var previewImage string
-	wordCountFetchErr := GetNumberOfWords(draft.Data, &postWordsCount, ctx, &imageCount, &extractedTagline, &titleString, &previewImage)
+	config := validator.configData.ContentReadTimeConfig
 
-	if wordCountFetchErr != nil {
-		logger.Errorf("invalid post data for draft id %v .%v", id, wordCountFetchErr)
+	metaData, metaDataErr := GetContentMetaData(ctx, draft.Data, validator.configData.ReadTime)
+	if metaDataErr != nil {
+		logger.Errorf("invalid post data for draft id %v .%v", id, metaDataErr)
 		return models.MetaData{}, &constants.DraftValidationFailedError
 	}
 
-	readTime = CountContentReadTime(postWordsCount)
-	CountImageReadTime(imageCount, &readTime)
+	readTime := metaData.ReadTime
 	for _, value := range draft.InterestTags {
 		configReadTime := config[value.Name]
 		if configReadTime != 0 {
@@ -67,12 +63,25 @@ func (validator postValidator) ValidateAndGetReadTime(draft db.Draft, ctx contex
 
 	logger.Infof("Successfully validated draft for id %v", draftID)
 
-	return models.MetaData{
-		Title:        titleString,
-		Tagline:      extractedTagline,
-		ReadTime:     readTime,
-		PreviewImage: previewImage,
-	}, nil
+	return metaData, nil
+}
+
+// ValidateBlocks checks every block of the editor content against the schema of its type and lists
+// each offending block in the error.
+func ValidateBlocks(ctx context.Context, content models.JSONString) *golaerror.Error {
+	logger := logging.GetLogger(ctx).WithField("class", "PostValidator").WithField("method", "ValidateBlocks")
+
+	var editor models.Editor
+	if err := content.Unmarshal(&editor); err != nil {
+		logger.Errorf("unable to parse editor content %v", err)
+		return &constants.DraftValidationFailedError
+	}
+
+	if blockErrors := editor.Validate(); len(blockErrors) > 0 {
+		logger.Errorf("editor content has %v invalid blocks", len(blockErrors))
+		return constants.InvalidBlocksError(blockErrors)
+	}
+	return nil
 }
 
 func NewPostValidator(data *configuration.ConfigData) PostValidator {
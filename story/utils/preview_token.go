@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+var ErrInvalidPreviewToken = errors.New("invalid preview token")
+
+// SignPreviewToken returns a url safe token for a preview link. The token carries the link id and its
+// expiry, signed with the secret, so forged or expired tokens are rejected before the link is looked up.
+func SignPreviewToken(secret []byte, linkID uuid.UUID, expiresAt time.Time) string {
+	payload := make([]byte, 24)
+	copy(payload, linkID[:])
+	binary.BigEndian.PutUint64(payload[16:], uint64(expiresAt.Unix()))
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(previewSignature(secret, payload))
+}
+
+// VerifyPreviewToken returns the link id of a token signed with the secret that has not expired yet.
+func VerifyPreviewToken(secret []byte, token string, now time.Time) (uuid.UUID, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return uuid.Nil, ErrInvalidPreviewToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != 24 {
+		return uuid.Nil, ErrInvalidPreviewToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, previewSignature(secret, payload)) {
+		return uuid.Nil, ErrInvalidPreviewToken
+	}
+	if now.Unix() >= int64(binary.BigEndian.Uint64(payload[16:])) {
+		return uuid.Nil, ErrInvalidPreviewToken
+	}
+
+	linkID, err := uuid.FromBytes(payload[:16])
+	if err != nil {
+		return uuid.Nil, ErrInvalidPreviewToken
+	}
+	return linkID, nil
+}
+
+func previewSignature(secret, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
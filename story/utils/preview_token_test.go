@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPreviewTokenRoundTrip(t *testing.T) {
+	secret := []byte("secret")
+	linkID := uuid.New()
+	now := time.Now()
+
+	linkIDFromToken, err := VerifyPreviewToken(secret, SignPreviewToken(secret, linkID, now.Add(time.Hour)), now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, linkID, linkIDFromToken)
+}
+
+func TestVerifyPreviewTokenRejectsOtherSecretsAndTampering(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	token := SignPreviewToken(secret, uuid.New(), now.Add(time.Hour))
+	payload, signature, _ := strings.Cut(token, ".")
+	otherPayload, _, _ := strings.Cut(SignPreviewToken(secret, uuid.New(), now.Add(time.Hour)), ".")
+
+	for _, invalid := range []string{"", "no-signature", payload + "." + signature[1:], otherPayload + "." + signature} {
+		_, err := VerifyPreviewToken(secret, invalid, now)
+		assert.Equal(t, ErrInvalidPreviewToken, err, invalid)
+	}
+	_, err := VerifyPreviewToken([]byte("other secret"), token, now)
+	assert.Equal(t, ErrInvalidPreviewToken, err)
+}
+
+func TestVerifyPreviewTokenRejectsExpiredTokens(t *testing.T) {
+	secret := []byte("secret")
+	expiresAt := time.Now().Add(time.Hour)
+	token := SignPreviewToken(secret, uuid.New(), expiresAt)
+
+	_, err := VerifyPreviewToken(secret, token, expiresAt)
+
+	assert.Equal(t, ErrInvalidPreviewToken, err)
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"math"
+	"post-api/configuration"
+	"post-api/story/models"
+	"unicode"
+)
+
+const defaultLanguage = "default"
+
+// defaultWordsPerMinute follows the IReST reading speed study for the languages it covers. Chinese
+// and Japanese are counted per character, as their words are.
+var defaultWordsPerMinute = map[string]int{
+	defaultLanguage: 200,
+	"en":            228,
+	"ta":            160,
+	"hi":            180,
+	"zh":            255,
+	"ja":            357,
+}
+
+// defaultBlockWeights slows down the blocks that take longer to read than prose and speeds up link
+// previews, which are mostly skimmed. Other blocks weigh 1.
+var defaultBlockWeights = map[models.ElementType]float64{
+	models.Code:     2,
+	models.Table:    1.5,
+	models.LinkTool: 0.5,
+}
+
+var languageScripts = []struct {
+	language string
+	script   *unicode.RangeTable
+}{
+	{"en", unicode.Latin},
+	{"ta", unicode.Tamil},
+	{"hi", unicode.Devanagari},
+	{"te", unicode.Telugu},
+	{"kn", unicode.Kannada},
+	{"ml", unicode.Malayalam},
+	{"zh", unicode.Han},
+	{"ja", unicode.Hiragana},
+	{"ja", unicode.Katakana},
+}
+
+// ContentReadTime returns the seconds it takes to read the text of every block. Each block is read at
+// the speed of its own language, so posts mixing languages are timed per block.
+func ContentReadTime(editor models.Editor, config configuration.ReadTimeConfig) int {
+	seconds := 0.0
+	for _, block := range editor.Blocks {
+		text := block.PlainText()
+		words := CountWords(text)
+		if words == 0 {
+			continue
+		}
+		seconds += float64(words) * blockWeight(block.Type, config) * 60 / float64(wordsPerMinute(DetectLanguage(text), config))
+	}
+	return int(math.Round(seconds))
+}
+
+// CountWords counts words following the Unicode word boundary rules for the scripts we publish in.
+// A word is a run of letters, combining marks and digits that may be joined by an apostrophe, hyphen,
+// period or comma, so "don't" and "2,000" are single words and Tamil vowel signs stay in their word.
+// Scripts written without spaces, like Han and kana, count every character as a word.
+func CountWords(text string) int {
+	runes := []rune(text)
+	count := 0
+	inWord := false
+	for i, r := range runes {
+		switch {
+		case isIdeographic(r):
+			count++
+			inWord = false
+		case isWordRune(r):
+			if !inWord {
+				count++
+				inWord = true
+			}
+		case inWord && isWordJoiner(r) && i+1 < len(runes) && isWordRune(runes[i+1]):
+		default:
+			inWord = false
+		}
+	}
+	return count
+}
+
+// DetectLanguage returns the language of the script most letters of the text are written in, or
+// "default" when the script is not one we know. Han text with any kana is read as Japanese.
+func DetectLanguage(text string) string {
+	letters := map[string]int{}
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		language := defaultLanguage
+		for _, candidate := range languageScripts {
+			if unicode.Is(candidate.script, r) {
+				language = candidate.language
+				break
+			}
+		}
+		letters[language]++
+	}
+	if letters["ja"] > 0 {
+		letters["ja"] += letters["zh"]
+		delete(letters, "zh")
+	}
+
+	detected := defaultLanguage
+	for language, count := range letters {
+		if count > letters[detected] || (count == letters[detected] && language < detected) {
+			detected = language
+		}
+	}
+	return detected
+}
+
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r)) && !isIdeographic(r)
+}
+
+func isIdeographic(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isWordJoiner(r rune) bool {
+	switch r {
+	case '\'', '’', '-', '.', ',', '\u200c', '\u200d':
+		return true
+	}
+	return false
+}
+
+func wordsPerMinute(language string, config configuration.ReadTimeConfig) int {
+	for _, key := range []string{language, defaultLanguage} {
+		if speed := config.WordsPerMinute[key]; speed > 0 {
+			return speed
+		}
+		if speed := defaultWordsPerMinute[key]; speed > 0 {
+			return speed
+		}
+	}
+	return defaultWordsPerMinute[defaultLanguage]
+}
+
+func blockWeight(blockType models.ElementType, config configuration.ReadTimeConfig) float64 {
+	if weight, ok := config.BlockWeights[string(blockType)]; ok && weight > 0 {
+		return weight
+	}
+	if weight, ok := defaultBlockWeights[blockType]; ok {
+		return weight
+	}
+	return 1
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"post-api/configuration"
+	"post-api/story/models"
+	"testing"
+)
+
+func TestCountWords(t *testing.T) {
+	assert.Equal(t, 0, CountWords(""))
+	assert.Equal(t, 6, CountWords("Don't split 2,000 well-known words, e.g."))
+	assert.Equal(t, 4, CountWords("சென்னைவாசிகள் உட்பட அனைத்து  மக்களின்"))
+	assert.Equal(t, 4, CountWords("日本語 test"))
+	assert.Equal(t, 3, CountWords("fmt.Println(value) // done"))
+}
+
+func TestDetectLanguage(t *testing.T) {
+	assert.Equal(t, "ta", DetectLanguage("தமிழக மக்களின் covid"))
+	assert.Equal(t, "en", DetectLanguage("covid in தமிழ் news today"))
+	assert.Equal(t, "ja", DetectLanguage("日本語のテキスト"))
+	assert.Equal(t, "zh", DetectLanguage("中文文本"))
+	assert.Equal(t, "default", DetectLanguage("Привет мир"))
+	assert.Equal(t, "default", DetectLanguage("2024"))
+}
+
+func TestContentReadTimeStripsMarkupAndWeighsBlocks(t *testing.T) {
+	editor := models.Editor{Blocks: []models.Block{
+		{Type: models.Paragraph, Data: map[string]interface{}{"text": "<b>one</b> two<br>three"}},
+		{Type: models.List, Data: map[string]interface{}{"style": "unordered", "items": []interface{}{"four", map[string]interface{}{"content": "five", "items": []interface{}{"six"}}}}},
+		{Type: models.Code, Data: map[string]interface{}{"code": "seven eight"}},
+		{Type: models.Separator, Data: map[string]interface{}{}},
+	}}
+	config := configuration.ReadTimeConfig{WordsPerMinute: map[string]int{"en": 60}, BlockWeights: map[string]float64{"code": 3}}
+
+	// six words of prose and two words of code weighing three times as much, at a word per second
+	assert.Equal(t, 12, ContentReadTime(editor, config))
+}
+
+func TestContentReadTimeReadsEachBlockInItsLanguage(t *testing.T) {
+	editor := models.Editor{Blocks: []models.Block{
+		{Type: models.Paragraph, Data: map[string]interface{}{"text": "one two"}},
+		{Type: models.Paragraph, Data: map[string]interface{}{"text": "தமிழக மக்களின்"}},
+		{Type: models.Paragraph, Data: map[string]interface{}{"text": "Привет мир"}},
+	}}
+	config := configuration.ReadTimeConfig{WordsPerMinute: map[string]int{"en": 120, "ta": 60, "default": 30}}
+
+	assert.Equal(t, 1+2+4, ContentReadTime(editor, config))
+}
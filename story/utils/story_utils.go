@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/inclusi-blog/gola-utils/logging"
 	"github.com/mitchellh/mapstructure"
+	"net/url"
+	"post-api/configuration"
 	"post-api/story/models"
 	"regexp"
 	"strings"
@@ -32,19 +34,19 @@ func CountImageReadTime(imageCount int, readTime *int) {
 	}
 }
 
-func CountContentReadTime(contentWordsCount int) int {
-	return int((0.0036 * float64(contentWordsCount)) * 60)
-}
-
-func GetNumberOfWords(content models.JSONString, wordsCount *int, ctx context.Context, imageCount *int, extractedTagline, titleString, previewImage *string) error {
-	logger := logging.GetLogger(ctx).WithField("class", "StoryUtils").WithField("method", "GetNumberOfWords")
+// GetContentMetaData extracts the title, tagline and preview image of the content and the time in
+// seconds it takes to read it, images included.
+func GetContentMetaData(ctx context.Context, content models.JSONString, readTimeConfig configuration.ReadTimeConfig) (models.MetaData, error) {
+	logger := logging.GetLogger(ctx).WithField("class", "StoryUtils").WithField("method", "GetContentMetaData")
 	var editor models.Editor
 	err := content.Unmarshal(&editor)
 	if err != nil {
 		logger.Errorf("unable to marshal post %v", err)
-		return err
+		return models.MetaData{}, err
 	}
 
+	var metaData models.MetaData
+	imageCount := 0
 	for _, data := range editor.Blocks {
 		value := data.Type
 		if value.IsEqual(models.Image) {
@@ -52,12 +54,12 @@ func GetNumberOfWords(content models.JSONString, wordsCount *int, ctx context.Co
 			err := mapstructure.Decode(data.Data, &image)
 			if err != nil {
 				logger.Errorf("unable to unmarshal image element %v", err)
-				return err
+				return models.MetaData{}, err
 			}
-			if image.File.Url != "" && *previewImage == "" {
-				*previewImage = image.File.Url
+			if image.File.Url != "" && metaData.PreviewImage == "" {
+				metaData.PreviewImage = image.File.Url
 			}
-			*imageCount = *imageCount + 1
+			imageCount++
 			continue
 		}
 
@@ -65,29 +67,29 @@ func GetNumberOfWords(content models.JSONString, wordsCount *int, ctx context.Co
 			text, err := data.GetText()
 			if err != nil {
 				logger.Errorf("unable to unmarshal %v data. Error %v", data.Type, err)
-				return err
+				return models.MetaData{}, err
 			}
-			if text != "" {
-				individual := strings.Split(text, " ")
-				*wordsCount = len(individual) + *wordsCount
-				if *titleString == "" {
-					if len([]rune(text)) > 100 {
-						*titleString = string([]rune(text)[:100])
-						continue
-					}
-					*titleString = text
-				} else if *extractedTagline == "" {
-					if len(text) > 100 {
-						tamilRunes := string([]rune(text))
-						*extractedTagline = tamilRunes[:100]
-						continue
-					}
-					*extractedTagline = text
-				}
+			if text == "" {
+				continue
+			}
+			if metaData.Title == "" {
+				metaData.Title = truncateRunes(text, 100)
+			} else if metaData.Tagline == "" {
+				metaData.Tagline = truncateRunes(text, 100)
 			}
 		}
 	}
-	return nil
+
+	metaData.ReadTime = ContentReadTime(editor, readTimeConfig)
+	CountImageReadTime(imageCount, &metaData.ReadTime)
+	return metaData, nil
+}
+
+func truncateRunes(text string, length int) string {
+	if runes := []rune(text); len(runes) > length {
+		return string(runes[:length])
+	}
+	return text
 }
 
 func GetTitleAndTaglineFromData(ctx context.Context, titleJson models.JSONString) (string, string, error) {
@@ -144,6 +146,25 @@ func GenerateUrl(titleString string) string {
 	return trimmedSpace
 }
 
+// PostURLCandidates returns the urls a link to a post can be stored under, most specific first. Links may
+// be full urls or paths, and end either with a generated url or with a custom slug under the username.
+func PostURLCandidates(link string) []string {
+	path := strings.TrimSpace(link)
+	if parsed, err := url.Parse(path); err == nil {
+		path = parsed.Path
+	}
+	segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+
+	var candidates []string
+	if len(segments) >= 2 {
+		candidates = append(candidates, strings.Join(segments[len(segments)-2:], "/"))
+	}
+	if len(segments) >= 1 {
+		candidates = append(candidates, segments[len(segments)-1])
+	}
+	return candidates
+}
+
 func spaceFieldJoin(str string) string {
 	return strings.Join(strings.Fields(str), "-")
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/jmoiron/sqlx/types"
 	"github.com/stretchr/testify/assert"
+	"post-api/configuration"
 	"post-api/story/models"
 	"post-api/story/service/test_helper"
 	"testing"
@@ -21,11 +22,6 @@ func TestCountImageReadTimeWhenImageCountLessThen10(t *testing.T) {
 	assert.Equal(t, 23, readTime)
 }
 
-func TestCountContentReadTime(t *testing.T) {
-	readTime := CountContentReadTime(500)
-	assert.Equal(t, 108, readTime)
-}
-
 func TestGetTitleFromSlateJson(t *testing.T) {
 	ctx := context.TODO()
 	titleString, tagline, err := GetTitleAndTaglineFromData(ctx, models.JSONString{
@@ -46,14 +42,18 @@ func TestGetTitleFromSlateJsonWhenTitleGreaterThan100Len(t *testing.T) {
 	assert.Equal(t, "", tagline)
 }
 
-func TestGetNumberOfWords(t *testing.T) {
+func TestGetContentMetaData(t *testing.T) {
 	ctx := context.TODO()
-	readTime := 0
-	imageCount := 0
-	extractedTagline := ""
-	titleString := ""
-	previewImage := ""
-	err := GetNumberOfWords(models.JSONString{JSONText: types.JSONText(test_helper.LargeTextData)}, &readTime, ctx, &imageCount, &extractedTagline, &titleString, &previewImage)
-	assert.Equal(t, 711, readTime)
+	metaData, err := GetContentMetaData(ctx, models.JSONString{JSONText: types.JSONText(test_helper.LargeTextData)}, configuration.ReadTimeConfig{})
 	assert.Nil(t, err)
+	// 711 tamil words at 160 words per minute
+	assert.Equal(t, 267, metaData.ReadTime)
+	assert.Equal(t, "படிப்படியாக உயர்ந்த எண்ணிக்கை", metaData.Tagline)
+}
+
+func TestPostURLCandidates(t *testing.T) {
+	assert.Equal(t, []string{"author/my-post", "my-post"}, PostURLCandidates("https://inclusi.blog/author/my-post?ref=feed"))
+	assert.Equal(t, []string{"post/my-post", "my-post"}, PostURLCandidates("/post/my-post/"))
+	assert.Equal(t, []string{"my-post"}, PostURLCandidates(" my-post "))
+	assert.Empty(t, PostURLCandidates("https://inclusi.blog/"))
 }
@@ -26,6 +26,7 @@ func (dbHelper DbHelper) ClearAll() error {
 		"likes",
 		"posts",
 		"abstract_post",
+		"draft_preview_links",
 		"drafts",
 		"users",
 	}
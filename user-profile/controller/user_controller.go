@@ -19,6 +19,7 @@ type UserProfileController struct {
 	service            service.UserInterestsService
 	userProfileService service.ProfileService
 	postService        storyApi.PostService
+	seriesService      storyApi.SeriesService
 	awsServices        commonService.AwsServices
 }
 
@@ -159,6 +160,26 @@ func (controller UserProfileController) GetPublishedPosts(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, posts)
 }
 
+func (controller UserProfileController) GetSeries(ctx *gin.Context) {
+	logger := logging.GetLogger(ctx).WithField("class", "UserProfileController").WithField("method", "GetSeries")
+	userID := ctx.Param("user_id")
+	authorUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.Errorf("unable to bind request path param %v", err)
+		ctx.JSON(http.StatusBadRequest, constants.PayloadValidationError)
+		return
+	}
+
+	series, fetchErr := controller.seriesService.GetSeriesByAuthor(ctx, authorUID)
+	if fetchErr != nil {
+		logger.Errorf("unable to get series of user %v. Error %v", authorUID, fetchErr)
+		constants.RespondWithGolaError(ctx, fetchErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, series)
+}
+
 func (controller UserProfileController) GetDetails(ctx *gin.Context) {
 	logger := logging.GetLogger(ctx).WithField("class", "UserController").WithField("method", "UnFollowInterest")
 	token, err := utils.GetIDToken(ctx)
@@ -288,11 +309,12 @@ func (controller UserProfileController) BlockUser(ctx *gin.Context) {
 	ctx.Status(200)
 }
 
-func NewUserProfileController(interestsService service.UserInterestsService, postService storyApi.PostService, profileService service.ProfileService, services commonService.AwsServices) UserProfileController {
+func NewUserProfileController(interestsService service.UserInterestsService, postService storyApi.PostService, seriesService storyApi.SeriesService, profileService service.ProfileService, services commonService.AwsServices) UserProfileController {
 	return UserProfileController{
 		service:            interestsService,
 		userProfileService: profileService,
 		postService:        postService,
+		seriesService:      seriesService,
 		awsServices:        services,
 	}
 }
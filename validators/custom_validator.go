@@ -3,6 +3,8 @@ package validators
 import (
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"regexp"
+	"strings"
 )
 
 func ValidPostUID(fl validator.FieldLevel) bool {
@@ -15,3 +17,14 @@ func ValidPostUID(fl validator.FieldLevel) bool {
 	}
 	return false
 }
+
+var slugPattern = regexp.MustCompile(`^[\p{L}\p{M}\p{N}]+(-[\p{L}\p{M}\p{N}]+)*$`)
+
+// ValidSlug accepts lower case words of letters, marks and digits of any script joined by single hyphens,
+// so slugs of titles written in scripts like Tamil keep their vowel signs.
+func ValidSlug(fl validator.FieldLevel) bool {
+	if info, ok := fl.Field().Interface().(string); ok {
+		return slugPattern.MatchString(info) && strings.ToLower(info) == info
+	}
+	return false
+}